@@ -0,0 +1,272 @@
+//go:build ignore
+
+// generate Builds timezones.geojson from a timezone-boundary-builder release, see README.md
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type feature struct {
+	Properties struct {
+		TZID string `json:"tzid"`
+	} `json:"properties"`
+	Geometry struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	} `json:"geometry"`
+}
+
+type outputFeature struct {
+	Type       string `json:"type"`
+	Properties struct {
+		TZID    string `json:"tzid"`
+		Country string `json:"country"`
+	} `json:"properties"`
+	Geometry outputGeometry `json:"geometry"`
+}
+
+type outputGeometry struct {
+	Type        string `json:"type"`
+	Coordinates any    `json:"coordinates"`
+}
+
+func main() {
+	in := flag.String("in", "timezones.geojson.zip", "timezone-boundary-builder release, the zip or the GeoJSON inside it")
+	zoneTab := flag.String("zonetab", "/usr/share/zoneinfo/zone.tab", "zone.tab of the tzdb the release was built from")
+	out := flag.String("out", "_resources/timezones/timezones.geojson", "output GeoJSON")
+	tolerance := flag.Float64("tolerance", 0.01, "Douglas-Peucker tolerance in degrees")
+	flag.Parse()
+
+	countries, err := readZoneTab(*zoneTab)
+	if err != nil {
+		fail(err)
+	}
+	features, err := readFeatures(*in)
+	if err != nil {
+		fail(err)
+	}
+
+	result := []*outputFeature{}
+	for _, f := range features {
+		country, ok := countries[f.Properties.TZID]
+		if !ok {
+			// Etc and the other zones without a country are left to the nautical fallback
+			fmt.Fprintf(os.Stderr, "skipping %s, zone.tab has no country for it\n", f.Properties.TZID)
+			continue
+		}
+		polygons := [][][][2]float64{}
+		switch f.Geometry.Type {
+		case "Polygon":
+			polygon := [][][2]float64{}
+			if err = json.Unmarshal(f.Geometry.Coordinates, &polygon); err != nil {
+				fail(fmt.Errorf("%s: %w", f.Properties.TZID, err))
+			}
+			polygons = append(polygons, polygon)
+			break
+		case "MultiPolygon":
+			if err = json.Unmarshal(f.Geometry.Coordinates, &polygons); err != nil {
+				fail(fmt.Errorf("%s: %w", f.Properties.TZID, err))
+			}
+			break
+		default:
+			fail(fmt.Errorf("%s: unexpected geometry %s", f.Properties.TZID, f.Geometry.Type))
+		}
+
+		simplified := [][][][2]float64{}
+		for _, polygon := range polygons {
+			rings := [][][2]float64{}
+			for i, ring := range polygon {
+				ring = simplifyRing(ring, *tolerance)
+				if len(ring) < 4 {
+					if i == 0 {
+						// The holes go with the exterior
+						break
+					}
+					continue
+				}
+				rings = append(rings, ring)
+			}
+			if len(rings) > 0 {
+				simplified = append(simplified, rings)
+			}
+		}
+		if len(simplified) == 0 {
+			fmt.Fprintf(os.Stderr, "skipping %s, nothing is left at this tolerance\n", f.Properties.TZID)
+			continue
+		}
+
+		geometry := outputGeometry{Type: "MultiPolygon", Coordinates: simplified}
+		if len(simplified) == 1 {
+			geometry = outputGeometry{Type: "Polygon", Coordinates: simplified[0]}
+		}
+		output := &outputFeature{Type: "Feature", Geometry: geometry}
+		output.Properties.TZID, output.Properties.Country = f.Properties.TZID, country
+		result = append(result, output)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Properties.Country != result[j].Properties.Country {
+			return result[i].Properties.Country < result[j].Properties.Country
+		}
+		return result[i].Properties.TZID < result[j].Properties.TZID
+	})
+
+	// A feature per line keeps the diffs between releases readable
+	lines := make([]string, 0, len(result))
+	for _, f := range result {
+		line, err := json.Marshal(f)
+		if err != nil {
+			fail(err)
+		}
+		lines = append(lines, string(line))
+	}
+	data := "{\"type\": \"FeatureCollection\", \"features\": [\n" + strings.Join(lines, ",\n") + "\n]}\n"
+	if err = os.WriteFile(*out, []byte(data), 0644); err != nil {
+		fail(err)
+	}
+	fmt.Fprintf(os.Stderr, "wrote %d features to %s\n", len(result), *out)
+}
+
+// readZoneTab Maps the zones of zone.tab to their country, the first column
+func readZoneTab(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.Open: %w", err)
+	}
+	defer file.Close()
+
+	result := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			continue
+		}
+		result[fields[2]] = fields[0]
+	}
+	return result, scanner.Err()
+}
+
+// readFeatures Reads the features of the release, from the first GeoJSON file in it if it is a zip
+func readFeatures(path string) ([]*feature, error) {
+	var reader io.Reader
+	if strings.EqualFold(filepath.Ext(path), ".zip") {
+		archive, err := zip.OpenReader(path)
+		if err != nil {
+			return nil, fmt.Errorf("zip.OpenReader: %w", err)
+		}
+		defer archive.Close()
+		for _, entry := range archive.File {
+			if ext := filepath.Ext(entry.Name); ext == ".json" || ext == ".geojson" {
+				file, err := entry.Open()
+				if err != nil {
+					return nil, fmt.Errorf("entry.Open: %w", err)
+				}
+				defer file.Close()
+				reader = file
+				break
+			}
+		}
+		if reader == nil {
+			return nil, fmt.Errorf("%s has no GeoJSON file", path)
+		}
+	} else {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("os.Open: %w", err)
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	collection := struct {
+		Features []*feature `json:"features"`
+	}{}
+	if err := json.NewDecoder(reader).Decode(&collection); err != nil {
+		return nil, fmt.Errorf("json.Decode: %w", err)
+	}
+	return collection.Features, nil
+}
+
+// simplifyRing Simplifies a closed ring with Douglas-Peucker and rounds it to 3 decimals, about 100 meters
+func simplifyRing(ring [][2]float64, tolerance float64) [][2]float64 {
+	if len(ring) < 4 {
+		return nil
+	}
+	keep := make([]bool, len(ring))
+	keep[0], keep[len(ring)-1] = true, true
+	// A closed ring starts and ends on the same point, split it at the point farthest from there so both halves have a chord
+	farthest, distance := 0, -1.0
+	for i, point := range ring {
+		if d := math.Hypot(point[0]-ring[0][0], point[1]-ring[0][1]); d > distance {
+			farthest, distance = i, d
+		}
+	}
+	keep[farthest] = true
+	douglasPeucker(ring, 0, farthest, tolerance, keep)
+	douglasPeucker(ring, farthest, len(ring)-1, tolerance, keep)
+
+	result := [][2]float64{}
+	for i, point := range ring {
+		if !keep[i] {
+			continue
+		}
+		point = [2]float64{math.Round(point[0]*1000) / 1000, math.Round(point[1]*1000) / 1000}
+		if len(result) > 0 && result[len(result)-1] == point {
+			continue
+		}
+		result = append(result, point)
+	}
+	if len(result) < 4 || result[0] != result[len(result)-1] {
+		return nil
+	}
+	return result
+}
+
+func douglasPeucker(ring [][2]float64, first, last int, tolerance float64, keep []bool) {
+	if last-first < 2 {
+		return
+	}
+	a, b := ring[first], ring[last]
+	index, distance := -1, tolerance
+	for i := first + 1; i < last; i++ {
+		if d := segmentDistance(ring[i], a, b); d > distance {
+			index, distance = i, d
+		}
+	}
+	if index < 0 {
+		return
+	}
+	keep[index] = true
+	douglasPeucker(ring, first, index, tolerance, keep)
+	douglasPeucker(ring, index, last, tolerance, keep)
+}
+
+// segmentDistance Is the planar distance in degrees from p to the segment from a to b
+func segmentDistance(p, a, b [2]float64) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	if dx == 0 && dy == 0 {
+		return math.Hypot(p[0]-a[0], p[1]-a[1])
+	}
+	t := math.Max(0, math.Min(1, ((p[0]-a[0])*dx+(p[1]-a[1])*dy)/(dx*dx+dy*dy)))
+	return math.Hypot(p[0]-a[0]-t*dx, p[1]-a[1]-t*dy)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,407 @@
+package device_utils
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var ErrStructuredHeaderMalformed = errors.New("the supplied structured header is malformed")
+
+var greaseBrandRegex = regexp.MustCompile(`^.?Not.A.Brand$`)
+
+// sfToken is a Token bare item, kept apart from String bare items since they serialize differently
+type sfToken string
+
+type sfParameter struct {
+	Key   string
+	Value any
+}
+
+// sfItem is a List member, Items holds the members of an Inner List and Value is nil for those
+type sfItem struct {
+	Value      any
+	Items      []*sfItem
+	Parameters []*sfParameter
+}
+
+func (i *sfItem) parameter(key string) (any, bool) {
+	for _, parameter := range i.Parameters {
+		if parameter.Key == key {
+			return parameter.Value, true
+		}
+	}
+	return nil, false
+}
+
+// sfParser Parses Structured Field Values
+// src: https://datatracker.ietf.org/doc/html/rfc8941#section-4.2
+type sfParser struct {
+	input string
+	index int
+}
+
+func (p *sfParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at %d", ErrStructuredHeaderMalformed, fmt.Sprintf(format, args...), p.index)
+}
+
+func (p *sfParser) peek() byte {
+	if p.index >= len(p.input) {
+		return 0
+	}
+	return p.input[p.index]
+}
+
+func (p *sfParser) skipSP() {
+	for p.peek() == ' ' {
+		p.index++
+	}
+}
+
+func (p *sfParser) skipOWS() {
+	for p.peek() == ' ' || p.peek() == '\t' {
+		p.index++
+	}
+}
+
+// parseList src: https://datatracker.ietf.org/doc/html/rfc8941#section-4.2.1
+func (p *sfParser) parseList() ([]*sfItem, error) {
+	p.skipSP()
+	result := []*sfItem{}
+	for p.index < len(p.input) {
+		var (
+			item *sfItem
+			err  error
+		)
+		if p.peek() == '(' {
+			item, err = p.parseInnerList()
+		} else {
+			item, err = p.parseItem()
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+
+		p.skipOWS()
+		if p.index >= len(p.input) {
+			return result, nil
+		}
+		if p.peek() != ',' {
+			return nil, p.errorf("expected a comma")
+		}
+		p.index++
+		p.skipOWS()
+		if p.index >= len(p.input) {
+			return nil, p.errorf("trailing comma")
+		}
+	}
+	return result, nil
+}
+
+func (p *sfParser) parseInnerList() (*sfItem, error) {
+	p.index++
+	result := &sfItem{Items: []*sfItem{}}
+	for p.index < len(p.input) {
+		p.skipSP()
+		if p.peek() == ')' {
+			p.index++
+			parameters, err := p.parseParameters()
+			if err != nil {
+				return nil, err
+			}
+			result.Parameters = parameters
+			return result, nil
+		}
+		item, err := p.parseItem()
+		if err != nil {
+			return nil, err
+		}
+		result.Items = append(result.Items, item)
+		if p.peek() != ' ' && p.peek() != ')' {
+			return nil, p.errorf("expected a space or the end of the inner list")
+		}
+	}
+	return nil, p.errorf("unterminated inner list")
+}
+
+func (p *sfParser) parseItem() (*sfItem, error) {
+	value, err := p.parseBareItem()
+	if err != nil {
+		return nil, err
+	}
+	parameters, err := p.parseParameters()
+	if err != nil {
+		return nil, err
+	}
+	return &sfItem{Value: value, Parameters: parameters}, nil
+}
+
+func (p *sfParser) parseParameters() ([]*sfParameter, error) {
+	result := []*sfParameter{}
+	for p.peek() == ';' {
+		p.index++
+		p.skipSP()
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+		var value any = true
+		if p.peek() == '=' {
+			p.index++
+			value, err = p.parseBareItem()
+			if err != nil {
+				return nil, err
+			}
+		}
+		// A repeated key overwrites the value but keeps its position
+		replaced := false
+		for _, parameter := range result {
+			if parameter.Key == key {
+				parameter.Value = value
+				replaced = true
+			}
+		}
+		if !replaced {
+			result = append(result, &sfParameter{Key: key, Value: value})
+		}
+	}
+	return result, nil
+}
+
+func (p *sfParser) parseKey() (string, error) {
+	start := p.index
+	c := p.peek()
+	if !(c >= 'a' && c <= 'z') && c != '*' {
+		return "", p.errorf("invalid key")
+	}
+	for c = p.peek(); (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || strings.IndexByte("_-.*", c) >= 0; c = p.peek() {
+		p.index++
+	}
+	return p.input[start:p.index], nil
+}
+
+func (p *sfParser) parseBareItem() (any, error) {
+	c := p.peek()
+	switch {
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	case c == '"':
+		return p.parseString()
+	case c == '*' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		return p.parseToken(), nil
+	case c == ':':
+		return p.parseByteSequence()
+	case c == '?':
+		return p.parseBoolean()
+	default:
+		return nil, p.errorf("invalid bare item")
+	}
+}
+
+// parseNumber src: https://datatracker.ietf.org/doc/html/rfc8941#section-4.2.4
+func (p *sfParser) parseNumber() (any, error) {
+	start := p.index
+	if p.peek() == '-' {
+		p.index++
+	}
+	digitsStart := p.index
+	if c := p.peek(); c < '0' || c > '9' {
+		return nil, p.errorf("expected a digit")
+	}
+	decimal := false
+	for c := p.peek(); (c >= '0' && c <= '9') || (c == '.' && !decimal); c = p.peek() {
+		if c == '.' {
+			if p.index-digitsStart > 12 {
+				return nil, p.errorf("decimal too long")
+			}
+			decimal = true
+		}
+		p.index++
+	}
+
+	number := p.input[start:p.index]
+	if !decimal {
+		if p.index-digitsStart > 15 {
+			return nil, p.errorf("integer too long")
+		}
+		result, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return nil, p.errorf("invalid integer")
+		}
+		return result, nil
+	}
+	fraction := number[strings.IndexByte(number, '.')+1:]
+	if len(fraction) == 0 || len(fraction) > 3 {
+		return nil, p.errorf("invalid decimal fraction")
+	}
+	result, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return nil, p.errorf("invalid decimal")
+	}
+	return result, nil
+}
+
+// parseString src: https://datatracker.ietf.org/doc/html/rfc8941#section-4.2.5
+func (p *sfParser) parseString() (string, error) {
+	p.index++
+	result := strings.Builder{}
+	for p.index < len(p.input) {
+		c := p.input[p.index]
+		p.index++
+		switch {
+		case c == '\\':
+			if p.index >= len(p.input) {
+				return "", p.errorf("unterminated escape")
+			}
+			next := p.input[p.index]
+			if next != '"' && next != '\\' {
+				return "", p.errorf("invalid escape")
+			}
+			result.WriteByte(next)
+			p.index++
+			break
+		case c == '"':
+			return result.String(), nil
+		case c < 0x20 || c > 0x7e:
+			return "", p.errorf("invalid string character")
+		default:
+			result.WriteByte(c)
+			break
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+// parseToken src: https://datatracker.ietf.org/doc/html/rfc8941#section-4.2.6
+func (p *sfParser) parseToken() sfToken {
+	start := p.index
+	p.index++
+	for c := p.peek(); isTChar(c) || c == ':' || c == '/'; c = p.peek() {
+		p.index++
+	}
+	return sfToken(p.input[start:p.index])
+}
+
+func (p *sfParser) parseByteSequence() ([]byte, error) {
+	p.index++
+	end := strings.IndexByte(p.input[p.index:], ':')
+	if end == -1 {
+		return nil, p.errorf("unterminated byte sequence")
+	}
+	result, err := base64.StdEncoding.DecodeString(p.input[p.index : p.index+end])
+	if err != nil {
+		return nil, p.errorf("invalid base64")
+	}
+	p.index += end + 1
+	return result, nil
+}
+
+func (p *sfParser) parseBoolean() (bool, error) {
+	p.index++
+	c := p.peek()
+	if c != '0' && c != '1' {
+		return false, p.errorf("invalid boolean")
+	}
+	p.index++
+	return c == '1', nil
+}
+
+// isTChar src: https://datatracker.ietf.org/doc/html/rfc9110#section-5.6.2
+func isTChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0
+}
+
+// formatSFString src: https://datatracker.ietf.org/doc/html/rfc8941#section-4.1.6
+func formatSFString(in string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(in) + `"`
+}
+
+// ParseBrandHeader Parses sec-ch-ua or sec-ch-ua-full-version-list, a Structured Field List of brand strings with a version parameter
+// src: https://wicg.github.io/ua-client-hints/#http-ua-hints
+func ParseBrandHeader(header string) ([]*Browser_HighEntropyValues_Brand, error) {
+	parser := &sfParser{input: header}
+	items, err := parser.parseList()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Browser_HighEntropyValues_Brand, 0, len(items))
+	for i, item := range items {
+		brand, ok := item.Value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: brand %d is not a string", ErrStructuredHeaderMalformed, i)
+		}
+		version, ok := item.parameter("v")
+		if !ok {
+			return nil, fmt.Errorf("%w: brand %d has no version", ErrStructuredHeaderMalformed, i)
+		}
+		versionString, ok := version.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: brand %d has a version that is not a string", ErrStructuredHeaderMalformed, i)
+		}
+		result = append(result, &Browser_HighEntropyValues_Brand{Brand: brand, Version: versionString})
+	}
+	return result, nil
+}
+
+// FormatBrandHeader Serializes brands the way Chromium sends sec-ch-ua and sec-ch-ua-full-version-list
+func FormatBrandHeader(brands []*Browser_HighEntropyValues_Brand) string {
+	result := make([]string, len(brands))
+	for i, brand := range brands {
+		result[i] = fmt.Sprintf("%s;v=%s", formatSFString(brand.Brand), formatSFString(brand.Version))
+	}
+	return strings.Join(result, ", ")
+}
+
+// IsGREASEBrand Reports whether a brand is one of the made up brands Chromium adds, with either algorithm
+func IsGREASEBrand(brand string) bool {
+	return greaseBrandRegex.MatchString(brand)
+}
+
+// MatchesChromiumGREASE Reports whether brands are what Chromium generates for the version of its Chromium brand, in both
+// GREASE brand and order. legacy tells which of the algorithms matched, versions only have to agree on the major version so
+// full version lists match too
+func MatchesChromiumGREASE(brands []*Browser_HighEntropyValues_Brand) (matches bool, legacy bool) {
+	majorVersion := -1
+	brandName := ""
+	for _, brand := range brands {
+		switch {
+		case brand.Brand == "Chromium":
+			majorVersion = versionMajor(brand.Version)
+			break
+		case IsGREASEBrand(brand.Brand):
+			break
+		default:
+			if brandName != "" {
+				return false, false
+			}
+			brandName = brand.Brand
+			break
+		}
+	}
+	if majorVersion < 0 {
+		return false, false
+	}
+
+	for _, useLegacy := range []bool{false, true} {
+		expected, err := ParseBrandHeader(GenerateBrandHeader(brandName, majorVersion, useLegacy))
+		if err != nil || len(expected) != len(brands) {
+			continue
+		}
+		matches = true
+		for i, brand := range brands {
+			if brand.Brand != expected[i].Brand || versionMajor(brand.Version) != versionMajor(expected[i].Version) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true, useLegacy
+		}
+	}
+	return false, false
+}
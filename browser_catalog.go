@@ -0,0 +1,430 @@
+package device_utils
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrBrowserUnsupported        = errors.New("the supplied browser is unsupported")
+	ErrBrowserVersionUnsupported = errors.New("the supplied browser version is unsupported")
+)
+
+var (
+	chromeVersionRegex = regexp.MustCompile(`Chrome/[\d.]+`)
+
+	// chromiumBrands are the brands Chromium based browsers put next to "Chromium" in sec-ch-ua
+	chromiumBrands = map[string]string{
+		"brave":    "Brave",
+		"chrome":   "Google Chrome",
+		"chromium": "",
+		"edge":     "Microsoft Edge",
+		"opera":    "Opera",
+	}
+
+	// chromiumChanges are the fingerprint changes between Chromium majors, applied when a profile is synthesized across them
+	chromiumChanges = []*chromiumChange{
+		{
+			// SETTINGS_MAX_CONCURRENT_STREAMS was dropped for SETTINGS_ENABLE_PUSH
+			MajorVersion: 106,
+			Apply: func(b *Browser, enabled bool) {
+				if len(b.HttpFingerprint.OrderedSettings()) == 0 {
+					return
+				}
+				if enabled {
+					b.HttpFingerprint.SetSetting(SettingEnablePush, 0)
+					b.HttpFingerprint.RemoveSetting(SettingMaxConcurrentStreams)
+				} else {
+					b.HttpFingerprint.RemoveSetting(SettingEnablePush)
+					b.HttpFingerprint.SetSetting(SettingMaxConcurrentStreams, 1000)
+				}
+			},
+		},
+		{
+			// GREASE Encrypted Client Hello
+			MajorVersion: 117,
+			Apply: func(b *Browser, enabled bool) {
+				if b.TlsFingerprint == nil {
+					return
+				}
+				if !enabled {
+					b.TlsFingerprint.removeExtension(Browser_TLSFingerprint_EXTENSION_ENCRYPTED_CLIENT_HELLO)
+					return
+				}
+				b.TlsFingerprint.addExtension(&Browser_TLSFingerprint_ExtensionData{
+					ExtensionId: Browser_TLSFingerprint_EXTENSION_ENCRYPTED_CLIENT_HELLO,
+					ExtensionEncryptedClientHello: &Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello{
+						CandidateCipherSuites: []*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite{
+							{
+								KdfId:  Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF_SHA256,
+								AeadId: Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD_AES_128_GCM,
+							},
+						},
+						CandidatePayloadLens: []uint32{
+							128, 160, 192, 224,
+						},
+					},
+				})
+			},
+		},
+		{
+			// X25519Kyber768Draft00 key share
+			MajorVersion: 124,
+			Apply: func(b *Browser, enabled bool) {
+				if b.TlsFingerprint == nil {
+					return
+				}
+				if enabled {
+					b.TlsFingerprint.addCurve(25497)
+				} else {
+					b.TlsFingerprint.removeCurve(25497)
+				}
+			},
+		},
+		{
+			// X25519Kyber768Draft00 was replaced by X25519MLKEM768
+			MajorVersion: 131,
+			Apply: func(b *Browser, enabled bool) {
+				if b.TlsFingerprint == nil {
+					return
+				}
+				if enabled {
+					b.TlsFingerprint.removeCurve(25497)
+					b.TlsFingerprint.addCurve(4588)
+				} else {
+					b.TlsFingerprint.removeCurve(4588)
+					b.TlsFingerprint.addCurve(25497)
+				}
+			},
+		},
+	}
+)
+
+type chromiumChange struct {
+	MajorVersion int
+	// Apply enables the change on a profile when moving up to MajorVersion and reverts it when moving below it
+	Apply func(b *Browser, enabled bool)
+}
+
+// AvailableBrowserNames Lists the browsers in the catalog, sorted
+func AvailableBrowserNames() []string {
+	browserDBLock.RLock()
+	defer browserDBLock.RUnlock()
+
+	result := make([]string, 0, len(availableBrowsers))
+	for name := range availableBrowsers {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// AvailableBrowserVersions Lists the known versions of a browser, oldest first
+func AvailableBrowserVersions(name string) []string {
+	browserDBLock.RLock()
+	defer browserDBLock.RUnlock()
+	return availableBrowserVersions(strings.ToLower(name))
+}
+
+// GetDBBrowser Returns a copy of a known browser profile
+func GetDBBrowser(name, version string) (*Browser, error) {
+	browserDBLock.RLock()
+	defer browserDBLock.RUnlock()
+	return getDBBrowser(strings.ToLower(name), version)
+}
+
+// GetClosestDBBrowser Returns a copy of the newest known profile that isn't newer than version, or the oldest one if they all are
+func GetClosestDBBrowser(name, version string) (*Browser, error) {
+	name = strings.ToLower(name)
+	browserDBLock.RLock()
+	defer browserDBLock.RUnlock()
+
+	versions := availableBrowserVersions(name)
+	if len(versions) == 0 {
+		return nil, ErrBrowserUnsupported
+	}
+
+	closest := versions[0]
+	for _, knownVersion := range versions {
+		if compareVersions(knownVersion, version) > 0 {
+			break
+		}
+		closest = knownVersion
+	}
+	return getDBBrowser(name, closest)
+}
+
+// GetRandomDBBrowser Returns a copy of a known profile weighted by the adoption of its version, limited to names if given
+func GetRandomDBBrowser(names ...string) (*Browser, error) {
+	browserDBLock.RLock()
+	defer browserDBLock.RUnlock()
+
+	if len(names) == 0 {
+		for name := range availableBrowsers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	type candidate struct {
+		name    string
+		version string
+	}
+	candidates := []candidate{}
+	weights := []float64{}
+	for _, name := range names {
+		name = strings.ToLower(name)
+		for _, version := range availableBrowserVersions(name) {
+			adoption, ok := browserAdoption[name][version]
+			if !ok {
+				adoption = -1
+			}
+			candidates = append(candidates, candidate{name: name, version: version})
+			weights = append(weights, adoption)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, ErrBrowserUnsupported
+	}
+
+	picked := candidates[randomWeighted(fillMissingWeights(weights))]
+	return getDBBrowser(picked.name, picked.version)
+}
+
+// SynthesizeChromiumBrowser Builds a profile for a Chromium major that isn't in the catalog from the known profile nearest to it
+// Version is set to the Chromium version since the version of the brand itself can't be derived
+func SynthesizeChromiumBrowser(name string, majorVersion int) (*Browser, error) {
+	name = strings.ToLower(name)
+	brand, ok := chromiumBrands[name]
+	if !ok {
+		return nil, ErrBrowserUnsupported
+	}
+
+	browserDBLock.RLock()
+	baseName := name
+	if len(availableBrowserVersions(baseName)) == 0 {
+		// Every Chromium based browser shares Chrome's network stack
+		baseName = "chrome"
+	}
+	var base *Browser
+	baseDistance := -1
+	for _, version := range availableBrowserVersions(baseName) {
+		browser := availableBrowsers[baseName][version]
+		distance := browser.GetMajorVersion() - majorVersion
+		if distance < 0 {
+			distance *= -1
+		}
+		if baseDistance == -1 || distance < baseDistance {
+			base = browser
+			baseDistance = distance
+		}
+	}
+	if base == nil {
+		browserDBLock.RUnlock()
+		return nil, ErrBrowserUnsupported
+	}
+	result := proto.Clone(base).(*Browser)
+	browserDBLock.RUnlock()
+	synthesizeChromiumVersion(result, name, brand, majorVersion)
+	return result, nil
+}
+
+// synthesizeChromiumVersion Turns a copy of a catalog profile into the browser and version
+func synthesizeChromiumVersion(result *Browser, name, brand string, majorVersion int) {
+	baseMajorVersion := result.GetMajorVersion()
+	result.Name = name
+	result.Version = fmt.Sprintf("%d.0.0.0", majorVersion)
+	result.UserAgent = chromeVersionRegex.ReplaceAllString(result.UserAgent, fmt.Sprintf("Chrome/%d.0.0.0", majorVersion))
+	result.AppVersion = chromeVersionRegex.ReplaceAllString(result.AppVersion, fmt.Sprintf("Chrome/%d.0.0.0", majorVersion))
+	result.BrandHeader = GenerateBrandHeader(brand, majorVersion)
+	if result.HighEntropyValues != nil {
+		synthesizeHighEntropyValues(result.HighEntropyValues, brand, majorVersion)
+	}
+
+	for _, change := range chromiumChanges {
+		if baseMajorVersion < change.MajorVersion && change.MajorVersion <= majorVersion {
+			change.Apply(result, true)
+		}
+	}
+	for i := len(chromiumChanges) - 1; i >= 0; i-- {
+		change := chromiumChanges[i]
+		if majorVersion < change.MajorVersion && change.MajorVersion <= baseMajorVersion {
+			change.Apply(result, false)
+		}
+	}
+}
+
+// synthesizeHighEntropyValues Regenerates the brands of navigator.userAgentData for the version, the full versions are the
+// reduced ones like in the User-Agent
+func synthesizeHighEntropyValues(values *Browser_HighEntropyValues, brand string, majorVersion int) {
+	values.Brands, _ = ParseBrandHeader(GenerateBrandHeader(brand, majorVersion))
+	values.FullVersionList, _ = ParseBrandHeader(GenerateBrandHeader(brand, majorVersion, false, true))
+	for _, fullVersion := range values.FullVersionList {
+		if IsGREASEBrand(fullVersion.Brand) {
+			// The GREASE brand gets a full version too, "8" becomes "8.0.0.0"
+			fullVersion.Version += ".0.0.0"
+		}
+	}
+	values.UsFullVersion = fmt.Sprintf("%d.0.0.0", majorVersion)
+}
+
+// availableBrowserVersions expects browserDBLock to be held
+func availableBrowserVersions(name string) []string {
+	versions, ok := availableBrowsers[name]
+	if !ok {
+		return []string{}
+	}
+
+	result := make([]string, 0, len(versions))
+	for version := range versions {
+		result = append(result, version)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return compareVersions(result[i], result[j]) < 0
+	})
+	return result
+}
+
+// getDBBrowser expects browserDBLock to be held
+func getDBBrowser(name, version string) (*Browser, error) {
+	versions, ok := availableBrowsers[name]
+	if !ok {
+		return nil, ErrBrowserUnsupported
+	}
+	browser, ok := versions[version]
+	if !ok {
+		return nil, ErrBrowserVersionUnsupported
+	}
+	return proto.Clone(browser).(*Browser), nil
+}
+
+// compareVersions Compares dotted versions numerically, missing parts count as 0
+func compareVersions(a, b string) int {
+	aSplit := strings.Split(a, ".")
+	bSplit := strings.Split(b, ".")
+	for i := 0; i < len(aSplit) || i < len(bSplit); i++ {
+		aPart, bPart := 0, 0
+		if i < len(aSplit) {
+			aPart = mustInt(aSplit[i])
+		}
+		if i < len(bSplit) {
+			bPart = mustInt(bSplit[i])
+		}
+		if aPart != bPart {
+			if aPart < bPart {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// addExtension Adds an extension and its data, in front of padding and pre_shared_key since those have to stay last
+func (fp *Browser_TLSFingerprint) addExtension(data *Browser_TLSFingerprint_ExtensionData) {
+	if _, ok := fp.getExtensionIndex(data.ExtensionId); ok {
+		return
+	}
+
+	index := len(fp.Extensions)
+	for i, extension := range fp.Extensions {
+		if extension == Browser_TLSFingerprint_PADDING || extension == Browser_TLSFingerprint_PRE_SHARED_KEY {
+			index = i
+			break
+		}
+	}
+	fp.Extensions = append(fp.Extensions[:index], append([]Browser_TLSFingerprint_Extension{data.ExtensionId}, fp.Extensions[index:]...)...)
+	fp.ExtensionData = append(fp.ExtensionData, data)
+}
+
+// removeExtension Removes an extension and its data
+func (fp *Browser_TLSFingerprint) removeExtension(extension Browser_TLSFingerprint_Extension) {
+	if index, ok := fp.getExtensionIndex(extension); ok {
+		fp.Extensions = append(fp.Extensions[:index], fp.Extensions[index+1:]...)
+	}
+	for i, data := range fp.ExtensionData {
+		if data.ExtensionId == extension {
+			fp.ExtensionData = append(fp.ExtensionData[:i], fp.ExtensionData[i+1:]...)
+			break
+		}
+	}
+}
+
+func (fp *Browser_TLSFingerprint) getExtensionIndex(extension Browser_TLSFingerprint_Extension) (int, bool) {
+	for i, existing := range fp.Extensions {
+		if existing == extension {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func (fp *Browser_TLSFingerprint) getExtensionData(extension Browser_TLSFingerprint_Extension) (*Browser_TLSFingerprint_ExtensionData, bool) {
+	for _, data := range fp.ExtensionData {
+		if data.ExtensionId == extension {
+			return data, true
+		}
+	}
+	return nil, false
+}
+
+// addCurve Adds a curve as the preferred one to the curves and supported_groups, along with a key share for it
+func (fp *Browser_TLSFingerprint) addCurve(curve Browser_TLSFingerprint_EllipticCurve) {
+	fp.EllipticCurves = withCurve(fp.EllipticCurves, curve)
+	for _, data := range fp.ExtensionData {
+		if data.GetSupportedGroups() != nil {
+			data.SupportedGroups.Groups = withCurve(data.SupportedGroups.Groups, curve)
+		}
+		if data.GetKeyShareExtension() != nil {
+			shared := false
+			for _, keyShare := range data.KeyShareExtension.KeyShares {
+				shared = shared || keyShare.Group == curve
+			}
+			if !shared {
+				data.KeyShareExtension.KeyShares = append([]*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare{{Group: curve}}, data.KeyShareExtension.KeyShares...)
+			}
+		}
+	}
+}
+
+// removeCurve Removes a curve from the curves and supported_groups, along with its key share
+func (fp *Browser_TLSFingerprint) removeCurve(curve Browser_TLSFingerprint_EllipticCurve) {
+	fp.EllipticCurves = withoutCurve(fp.EllipticCurves, curve)
+	for _, data := range fp.ExtensionData {
+		if data.GetSupportedGroups() != nil {
+			data.SupportedGroups.Groups = withoutCurve(data.SupportedGroups.Groups, curve)
+		}
+		if data.GetKeyShareExtension() == nil {
+			continue
+		}
+		for i, keyShare := range data.KeyShareExtension.KeyShares {
+			if keyShare.Group == curve {
+				data.KeyShareExtension.KeyShares = append(data.KeyShareExtension.KeyShares[:i], data.KeyShareExtension.KeyShares[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// withCurve Puts the curve in front of the curves, unless they already have it
+func withCurve(curves []Browser_TLSFingerprint_EllipticCurve, curve Browser_TLSFingerprint_EllipticCurve) []Browser_TLSFingerprint_EllipticCurve {
+	for _, existing := range curves {
+		if existing == curve {
+			return curves
+		}
+	}
+	return append([]Browser_TLSFingerprint_EllipticCurve{curve}, curves...)
+}
+
+func withoutCurve(curves []Browser_TLSFingerprint_EllipticCurve, curve Browser_TLSFingerprint_EllipticCurve) []Browser_TLSFingerprint_EllipticCurve {
+	for i, existing := range curves {
+		if existing == curve {
+			return append(curves[:i], curves[i+1:]...)
+		}
+	}
+	return curves
+}
@@ -0,0 +1,326 @@
+package device_utils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrBrowserInconsistent = errors.New("the supplied browser is inconsistent")
+
+var (
+	// navigatorPlatforms are the navigator.platform prefixes each sec-ch-ua-platform value reports
+	navigatorPlatforms = map[string][]string{
+		"Windows":   {"Win32"},
+		"macOS":     {"MacIntel"},
+		"Linux":     {"Linux"},
+		"Android":   {"Linux"},
+		"Chrome OS": {"Linux"},
+		"iOS":       {"iPhone", "iPad", "MacIntel"},
+	}
+	// firefoxAppVersionPlatforms are the platform tokens Firefox puts in navigator.appVersion
+	firefoxAppVersionPlatforms = map[string]string{
+		"Windows": "Windows",
+		"macOS":   "Macintosh",
+		"Linux":   "X11",
+		"Android": "Android",
+	}
+	// chromiumDeviceMemories are the only values Chromium reports, rounded down to a power of two and capped at 8
+	chromiumDeviceMemories = map[int64]bool{1: true, 2: true, 4: true, 8: true}
+)
+
+// ConsistencyError Collects every disagreement between the layers of a browser
+type ConsistencyError struct {
+	Errors []*FieldError
+}
+
+func (e *ConsistencyError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		messages[i] = fieldErr.Error()
+	}
+	return fmt.Sprintf("%d inconsistencies: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+func (e *ConsistencyError) Unwrap() []error {
+	result := make([]error, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		result[i] = fieldErr
+	}
+	return result
+}
+
+type consistencyChecker struct {
+	browser *Browser
+	errors  []*FieldError
+}
+
+func (c *consistencyChecker) add(path, format string, args ...any) {
+	c.errors = append(c.errors, &FieldError{Path: path, Err: fmt.Errorf("%w: %s", ErrBrowserInconsistent, fmt.Sprintf(format, args...))})
+}
+
+// CheckConsistency Compares the User-Agent with the client hints and navigator, checks that hardware and window sizes
+// are plausible and compares the TLS and HTTP/2 fingerprints with the known ones for the browser and version.
+// Returns a ConsistencyError listing every disagreement, fields the browser doesn't have are skipped
+func (b *Browser) CheckConsistency() error {
+	checker := &consistencyChecker{browser: b}
+	family := b.GetFamily()
+	majorVersion := b.GetMajorVersion()
+	platform := clientHintsPlatform(b.UserAgent)
+
+	checker.checkClientHints(family, majorVersion, platform)
+	checker.checkNavigator(family, platform)
+	checker.checkHardware(family)
+	checker.checkWindow()
+	checker.checkNetwork(family, majorVersion)
+
+	if len(checker.errors) == 0 {
+		return nil
+	}
+	return &ConsistencyError{Errors: checker.errors}
+}
+
+func (c *consistencyChecker) checkClientHints(family string, majorVersion int, platform string) {
+	b := c.browser
+	if family != BrowserFamilyChromium {
+		if b.BrandHeader != "" {
+			c.add("brandHeader", "%s doesn't send sec-ch-ua", family)
+		}
+		if b.HighEntropyValues != nil {
+			c.add("highEntropyValues", "%s doesn't have navigator.userAgentData", family)
+		}
+		return
+	}
+
+	if b.BrandHeader != "" {
+		brands, err := ParseBrandHeader(b.BrandHeader)
+		if err != nil {
+			c.add("brandHeader", "%s", err.Error())
+		}
+		brandNames := make([]string, 0, len(brands))
+		hasBrand := false
+		for _, brand := range brands {
+			brandNames = append(brandNames, brand.Brand)
+			if brand.Brand == "Chromium" && mustInt(brand.Version) != majorVersion {
+				c.add("brandHeader", "Chromium %s, the User-Agent says %d", brand.Version, majorVersion)
+			}
+			if brand.Brand == chromiumBrands[strings.ToLower(b.Name)] {
+				hasBrand = true
+			}
+		}
+		if brand := chromiumBrands[strings.ToLower(b.Name)]; brand != "" && err == nil && !hasBrand {
+			c.add("brandHeader", "%s is missing for %s, got %s", brand, b.Name, strings.Join(brandNames, ", "))
+		}
+		if matches, _ := MatchesChromiumGREASE(brands); err == nil && !matches {
+			c.add("brandHeader", "%s is not what Chromium generates", b.BrandHeader)
+		}
+	}
+
+	if b.HighEntropyValues == nil {
+		return
+	}
+	for i, brand := range b.HighEntropyValues.Brands {
+		if brand.Brand == "Chromium" && mustInt(brand.Version) != majorVersion {
+			c.add(fmt.Sprintf("highEntropyValues.brands[%d]", i), "Chromium %s, the User-Agent says %d", brand.Version, majorVersion)
+		}
+	}
+	for i, brand := range b.HighEntropyValues.FullVersionList {
+		if brand.Brand == "Chromium" && versionMajor(brand.Version) != majorVersion {
+			c.add(fmt.Sprintf("highEntropyValues.fullVersionList[%d]", i), "Chromium %s, the User-Agent says %d", brand.Version, majorVersion)
+		}
+	}
+	if b.HighEntropyValues.UsFullVersion != "" && versionMajor(b.HighEntropyValues.UsFullVersion) != majorVersion {
+		c.add("highEntropyValues.usFullVersion", "%s, the User-Agent says %d", b.HighEntropyValues.UsFullVersion, majorVersion)
+	}
+	if b.HighEntropyValues.Platform != "" && b.HighEntropyValues.Platform != platform {
+		c.add("highEntropyValues.platform", "%s, the User-Agent says %s", b.HighEntropyValues.Platform, platform)
+	}
+	if b.HighEntropyValues.Mobile != strings.Contains(b.UserAgent, "Mobile") {
+		c.add("highEntropyValues.mobile", "%t doesn't match the User-Agent", b.HighEntropyValues.Mobile)
+	}
+}
+
+func (c *consistencyChecker) checkNavigator(family, platform string) {
+	b := c.browser
+	if prefixes, ok := navigatorPlatforms[platform]; ok && b.Platform != "" {
+		matches := false
+		for _, prefix := range prefixes {
+			matches = matches || strings.HasPrefix(b.Platform, prefix)
+		}
+		if !matches {
+			c.add("platform", "%s, the User-Agent says %s", b.Platform, platform)
+		}
+	}
+
+	if b.AppVersion == "" {
+		return
+	}
+	if family == BrowserFamilyFirefox {
+		// Firefox only puts the platform in appVersion
+		if token, ok := firefoxAppVersionPlatforms[platform]; ok && !strings.HasPrefix(b.AppVersion, fmt.Sprintf("5.0 (%s", token)) {
+			c.add("appVersion", "%s, the User-Agent says %s", b.AppVersion, platform)
+		}
+		return
+	}
+	if "Mozilla/"+b.AppVersion != b.UserAgent {
+		c.add("appVersion", "%s isn't the User-Agent without Mozilla/", b.AppVersion)
+	}
+}
+
+func (c *consistencyChecker) checkHardware(family string) {
+	b := c.browser
+	if b.HardwareConcurrency < 0 || b.HardwareConcurrency > 256 {
+		c.add("hardwareConcurrency", "%d cores", b.HardwareConcurrency)
+	} else if family == BrowserFamilySafari && b.HardwareConcurrency > 8 {
+		// Safari reports at most 8 to limit fingerprinting
+		c.add("hardwareConcurrency", "%d cores, Safari reports at most 8", b.HardwareConcurrency)
+	}
+
+	if b.DeviceMemory == 0 {
+		return
+	}
+	if family != BrowserFamilyChromium {
+		c.add("deviceMemory", "%s doesn't have navigator.deviceMemory", family)
+	} else if !chromiumDeviceMemories[b.DeviceMemory] {
+		c.add("deviceMemory", "%d GB, Chromium reports 1, 2, 4 or 8", b.DeviceMemory)
+	}
+}
+
+func (c *consistencyChecker) checkWindow() {
+	b := c.browser
+	if b.InnerWidth > 0 && b.OuterWidth > 0 && b.InnerWidth > b.OuterWidth {
+		c.add("innerWidth", "%d is wider than outerWidth %d", b.InnerWidth, b.OuterWidth)
+	}
+	if b.InnerHeight > 0 && b.OuterHeight > 0 && b.InnerHeight > b.OuterHeight {
+		c.add("innerHeight", "%d is taller than outerHeight %d", b.InnerHeight, b.OuterHeight)
+	}
+
+	screen := b.Screen
+	if screen == nil || screen.Width == 0 || screen.Height == 0 {
+		return
+	}
+	if screen.AvailWidth > screen.Width {
+		c.add("screen.availWidth", "%d is wider than the screen %d", screen.AvailWidth, screen.Width)
+	}
+	if screen.AvailHeight > screen.Height {
+		c.add("screen.availHeight", "%d is taller than the screen %d", screen.AvailHeight, screen.Height)
+	}
+	// A window can span several screens
+	if screen.IsExtended {
+		return
+	}
+	if b.OuterWidth > screen.Width {
+		c.add("outerWidth", "%d is wider than the screen %d", b.OuterWidth, screen.Width)
+	}
+	if b.OuterHeight > screen.Height {
+		c.add("outerHeight", "%d is taller than the screen %d", b.OuterHeight, screen.Height)
+	}
+}
+
+// checkNetwork Compares the fingerprints with the closest known profile of the browser, extension order is ignored since Chrome shuffles it
+func (c *consistencyChecker) checkNetwork(family string, majorVersion int) {
+	b := c.browser
+	reference := consistencyReference(b, family, majorVersion)
+	if reference == nil {
+		return
+	}
+
+	if b.TlsFingerprint != nil && reference.TlsFingerprint != nil {
+		for _, field := range []struct {
+			path   string
+			sorted bool
+			values func(fp *Browser_TLSFingerprint) []int
+		}{
+			{"tlsFingerprint.cipherSuites", false, func(fp *Browser_TLSFingerprint) []int {
+				return enumInts(fp.CipherSuites)
+			}},
+			{"tlsFingerprint.extensions", true, func(fp *Browser_TLSFingerprint) []int {
+				// padding depends on the size of the ClientHello and pre_shared_key on resumption
+				result := []int{}
+				for _, extension := range enumInts(fp.Extensions) {
+					if extension != int(Browser_TLSFingerprint_PADDING) && extension != int(Browser_TLSFingerprint_PRE_SHARED_KEY) {
+						result = append(result, extension)
+					}
+				}
+				return result
+			}},
+			{"tlsFingerprint.ellipticCurves", false, func(fp *Browser_TLSFingerprint) []int {
+				return enumInts(fp.EllipticCurves)
+			}},
+		} {
+			got, want := formatIntList(field.values(b.TlsFingerprint), field.sorted), formatIntList(field.values(reference.TlsFingerprint), field.sorted)
+			if got != want {
+				c.add(field.path, "%s, %s %s has %s", got, reference.Name, reference.Version, want)
+			}
+		}
+	}
+
+	if b.HttpFingerprint != nil && reference.HttpFingerprint != nil && len(reference.HttpFingerprint.OrderedSettings()) > 0 {
+		got, want := b.HttpFingerprint.FormatAkamaiFingerprint(), reference.HttpFingerprint.FormatAkamaiFingerprint()
+		if got != want {
+			c.add("httpFingerprint", "%s, %s %s has %s", got, reference.Name, reference.Version, want)
+		}
+	}
+}
+
+// consistencyReference Returns the known profile closest to the browser, nil when there is none of the same major version
+func consistencyReference(b *Browser, family string, majorVersion int) *Browser {
+	var (
+		reference *Browser
+		err       error
+	)
+	switch family {
+	case BrowserFamilyChromium:
+		name := strings.ToLower(b.Name)
+		if _, ok := chromiumBrands[name]; !ok {
+			name = "chrome"
+		}
+		reference, err = SynthesizeChromiumBrowser(name, majorVersion)
+		break
+	case BrowserFamilyFirefox:
+		reference, err = GetClosestDBBrowser("firefox", b.Version)
+		break
+	case BrowserFamilySafari:
+		reference, err = GetClosestDBBrowser("safari", b.Version)
+		break
+	}
+	// Only Chromium profiles can be synthesized for any version, the others are only a reference for their own major
+	if err != nil || reference.GetMajorVersion() != majorVersion {
+		return nil
+	}
+	return reference
+}
+
+func versionMajor(version string) int {
+	return mustInt(strings.Split(version, ".")[0])
+}
+
+func enumInts[T ~int32](values []T) []int {
+	result := make([]int, 0, len(values))
+	for _, value := range values {
+		if isGREASE(int(value)) {
+			continue
+		}
+		result = append(result, int(value))
+	}
+	return result
+}
+
+// formatIntList Formats values like a JA3 field, sorted when only the set matters
+func formatIntList(values []int, sorted bool) string {
+	if sorted {
+		values = append([]int{}, values...)
+		sort.Ints(values)
+	}
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = mustString(value)
+	}
+	return strings.Join(result, "-")
+}
+
+// isGREASE src: https://datatracker.ietf.org/doc/html/rfc8701#section-2
+func isGREASE(value int) bool {
+	return value&0x0f0f == 0x0a0a && value>>8 == value&0xff
+}
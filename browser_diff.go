@@ -0,0 +1,460 @@
+package device_utils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type DiffSeverity int
+
+const (
+	// DiffSeverityInfo Doesn't affect how the browser is fingerprinted
+	DiffSeverityInfo DiffSeverity = iota
+	// DiffSeverityLow Only seen by thorough fingerprinting scripts
+	DiffSeverityLow
+	// DiffSeverityMedium Seen by common fingerprinting scripts
+	DiffSeverityMedium
+	// DiffSeverityHigh Seen on every request or by the first checks of any anti-bot
+	DiffSeverityHigh
+)
+
+func (s DiffSeverity) String() string {
+	switch s {
+	case DiffSeverityInfo:
+		return "INFO"
+	case DiffSeverityLow:
+		return "LOW"
+	case DiffSeverityMedium:
+		return "MEDIUM"
+	case DiffSeverityHigh:
+		return "HIGH"
+	default:
+		return fmt.Sprintf("DiffSeverity(%d)", int(s))
+	}
+}
+
+type DiffKind int
+
+const (
+	// DiffKindChanged The value differs
+	DiffKindChanged DiffKind = iota
+	// DiffKindAdded Only the second browser has the value
+	DiffKindAdded
+	// DiffKindRemoved Only the first browser has the value
+	DiffKindRemoved
+	// DiffKindReordered The list has the same elements in a different order
+	DiffKindReordered
+	// DiffKindElements The list has missing or extra elements
+	DiffKindElements
+)
+
+func (k DiffKind) String() string {
+	switch k {
+	case DiffKindChanged:
+		return "changed"
+	case DiffKindAdded:
+		return "added"
+	case DiffKindRemoved:
+		return "removed"
+	case DiffKindReordered:
+		return "reordered"
+	case DiffKindElements:
+		return "elements"
+	default:
+		return fmt.Sprintf("DiffKind(%d)", int(k))
+	}
+}
+
+// Difference Is a single difference between two browsers, Path uses the proto field names like tlsFingerprint.cipherSuites
+type Difference struct {
+	Path     string
+	Kind     DiffKind
+	Severity DiffSeverity
+	// Old and New are formatted values, empty when the kind doesn't have them
+	Old string
+	New string
+	// Missing and Extra are the list elements only the first or second browser has
+	Missing []string
+	Extra   []string
+}
+
+func (d *Difference) String() string {
+	switch d.Kind {
+	case DiffKindChanged:
+		return fmt.Sprintf("[%s] %s: %s -> %s", d.Severity, d.Path, d.Old, d.New)
+	case DiffKindAdded:
+		return fmt.Sprintf("[%s] %s: added %s", d.Severity, d.Path, d.New)
+	case DiffKindRemoved:
+		return fmt.Sprintf("[%s] %s: removed %s", d.Severity, d.Path, d.Old)
+	case DiffKindReordered:
+		return fmt.Sprintf("[%s] %s: order %s -> %s", d.Severity, d.Path, d.Old, d.New)
+	default:
+		parts := []string{}
+		if len(d.Missing) > 0 {
+			parts = append(parts, fmt.Sprintf("missing [%s]", strings.Join(d.Missing, ", ")))
+		}
+		if len(d.Extra) > 0 {
+			parts = append(parts, fmt.Sprintf("extra [%s]", strings.Join(d.Extra, ", ")))
+		}
+		return fmt.Sprintf("[%s] %s: %s", d.Severity, d.Path, strings.Join(parts, ", "))
+	}
+}
+
+// BrowserDiff Lists the differences between two browsers in field order
+type BrowserDiff struct {
+	Differences []*Difference
+}
+
+// MaxSeverity Returns the severity of the worst difference, -1 when there are none
+func (d *BrowserDiff) MaxSeverity() DiffSeverity {
+	result := DiffSeverity(-1)
+	for _, difference := range d.Differences {
+		if difference.Severity > result {
+			result = difference.Severity
+		}
+	}
+	return result
+}
+
+// Filter Returns the differences that are at least as severe as minSeverity
+func (d *BrowserDiff) Filter(minSeverity DiffSeverity) *BrowserDiff {
+	result := &BrowserDiff{Differences: []*Difference{}}
+	for _, difference := range d.Differences {
+		if difference.Severity >= minSeverity {
+			result.Differences = append(result.Differences, difference)
+		}
+	}
+	return result
+}
+
+// String Formats the differences one per line, the most severe first
+func (d *BrowserDiff) String() string {
+	differences := append([]*Difference{}, d.Differences...)
+	sort.SliceStable(differences, func(i, j int) bool {
+		return differences[i].Severity > differences[j].Severity
+	})
+	lines := make([]string, len(differences))
+	for i, difference := range differences {
+		lines[i] = difference.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+var (
+	// diffSeverities are keyed by field, a difference gets the severity of the innermost field on its path that has one
+	// and DiffSeverityMedium otherwise
+	diffSeverities = map[protoreflect.FullName]DiffSeverity{
+		"device_utils.Browser.version":         DiffSeverityInfo,
+		"device_utils.Browser.name":            DiffSeverityInfo,
+		"device_utils.Browser.userAgent":       DiffSeverityHigh,
+		"device_utils.Browser.brandHeader":     DiffSeverityHigh,
+		"device_utils.Browser.tlsFingerprint":  DiffSeverityHigh,
+		"device_utils.Browser.httpFingerprint": DiffSeverityHigh,
+		"device_utils.Browser.platform":        DiffSeverityHigh,
+		"device_utils.Browser.webdriver":       DiffSeverityHigh,
+		"device_utils.Browser.availableFonts":  DiffSeverityLow,
+		"device_utils.Browser.window":          DiffSeverityLow,
+		"device_utils.Browser.document":        DiffSeverityLow,
+		"device_utils.Browser.documentElement": DiffSeverityLow,
+		"device_utils.Browser.css":             DiffSeverityLow,
+		"device_utils.Browser.audioTypes":      DiffSeverityLow,
+		"device_utils.Browser.videoTypes":      DiffSeverityLow,
+		"device_utils.Browser.speechSynthesis": DiffSeverityLow,
+		"device_utils.Browser.mediaDevices":    DiffSeverityLow,
+		"device_utils.Browser.audioContext":    DiffSeverityLow,
+		"device_utils.Browser.stackNative":     DiffSeverityInfo,
+		"device_utils.Browser.stackWorker":     DiffSeverityInfo,
+		"device_utils.Browser.isBot":           DiffSeverityInfo,
+		"device_utils.Browser.status":          DiffSeverityInfo,
+		// Identify a capture, not a browser
+		"device_utils.Browser.BrowserCollection.id":   DiffSeverityInfo,
+		"device_utils.Browser.BrowserCollection.hash": DiffSeverityInfo,
+		// Mirrors of other fields
+		"device_utils.Browser.HTTPFingerprint.settingsFrame":  DiffSeverityInfo,
+		"device_utils.Browser.Plugin.supportedMIMETypes":      DiffSeverityInfo,
+		"device_utils.Browser.GLCapability.enumName":          DiffSeverityInfo,
+		"device_utils.Browser.HTTPFingerprint.headerOrder":    DiffSeverityMedium,
+		"device_utils.Browser.HTTPFingerprint.Frame.length":   DiffSeverityLow,
+		"device_utils.Browser.HTTPFingerprint.Frame.streamId": DiffSeverityLow,
+		// Key shares are random per connection and the padding depends on the rest of the ClientHello
+		"device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension.KeyShare.data": DiffSeverityInfo,
+		"device_utils.Browser.TLSFingerprint.ExtensionData.Padding.length":                  DiffSeverityLow,
+	}
+	// diffOrderSeverities override diffSeverities for lists that only differ in order
+	diffOrderSeverities = map[protoreflect.FullName]DiffSeverity{
+		// Chrome shuffles its extensions since 110
+		"device_utils.Browser.TLSFingerprint.extensions":    DiffSeverityLow,
+		"device_utils.Browser.TLSFingerprint.extensionData": DiffSeverityInfo,
+		"device_utils.Browser.BrowserCollection.listData":   DiffSeverityInfo,
+	}
+	// diffKeyFields identify the elements of repeated messages, so they are compared by key instead of by index
+	diffKeyFields = map[protoreflect.FullName]protoreflect.Name{
+		"device_utils.Browser.TLSFingerprint.ExtensionData":                            "extensionId",
+		"device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension.KeyShare": "group",
+		"device_utils.Browser.HTTPFingerprint.Setting":                                 "id",
+		"device_utils.Browser.HTTPFingerprint.PriorityFrameOpts":                       "streamId",
+		"device_utils.Browser.Plugin":                                                  "name",
+		"device_utils.Browser.SpeechSynthesis":                                         "name",
+		"device_utils.Browser.HighEntropyValues.Brand":                                 "brand",
+		"device_utils.Browser.WebRTC.HeaderExtension":                                  "uri",
+	}
+)
+
+// DiffBrowsers Compares every field of two browsers, from a to b
+func DiffBrowsers(a, b *Browser) *BrowserDiff {
+	differ := &browserDiffer{result: &BrowserDiff{Differences: []*Difference{}}}
+	differ.diffMessage("", nil, a.ProtoReflect(), b.ProtoReflect())
+	return differ.result
+}
+
+type browserDiffer struct {
+	result *BrowserDiff
+}
+
+// diffField carries the field a path went through, for severity lookups
+type diffField struct {
+	parent *diffField
+	field  protoreflect.FieldDescriptor
+}
+
+func (d *browserDiffer) add(path string, fields *diffField, difference *Difference) {
+	difference.Path = path
+	difference.Severity = DiffSeverityMedium
+	first := true
+	for current := fields; current != nil; current = current.parent {
+		name := current.field.FullName()
+		if severity, ok := diffOrderSeverities[name]; ok && first && difference.Kind == DiffKindReordered {
+			difference.Severity = severity
+			break
+		}
+		if severity, ok := diffSeverities[name]; ok {
+			difference.Severity = severity
+			break
+		}
+		first = false
+	}
+	d.result.Differences = append(d.result.Differences, difference)
+}
+
+func (d *browserDiffer) diffMessage(path string, parent *diffField, a, b protoreflect.Message) {
+	fields := a.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		fieldPath := string(field.Name())
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		current := &diffField{parent: parent, field: field}
+
+		switch {
+		case field.IsList():
+			d.diffList(fieldPath, current, a.Get(field).List(), b.Get(field).List())
+			break
+		case field.IsMap():
+			d.diffMap(fieldPath, current, a.Get(field).Map(), b.Get(field).Map())
+			break
+		case field.Message() != nil:
+			aHas, bHas := a.Has(field), b.Has(field)
+			if aHas && bHas {
+				d.diffMessage(fieldPath, current, a.Get(field).Message(), b.Get(field).Message())
+			} else if aHas {
+				d.add(fieldPath, current, &Difference{Kind: DiffKindRemoved, Old: "{...}"})
+			} else if bHas {
+				d.add(fieldPath, current, &Difference{Kind: DiffKindAdded, New: "{...}"})
+			}
+			break
+		default:
+			aValue, bValue := a.Get(field), b.Get(field)
+			if !aValue.Equal(bValue) {
+				d.add(fieldPath, current, &Difference{Kind: DiffKindChanged, Old: formatDiffValue(field, aValue), New: formatDiffValue(field, bValue)})
+			}
+			break
+		}
+	}
+}
+
+func (d *browserDiffer) diffList(path string, fields *diffField, a, b protoreflect.List) {
+	field := fields.field
+	if field.Message() == nil {
+		d.diffScalarList(path, fields, a, b)
+		return
+	}
+
+	keyName, hasKey := diffKeyFields[field.Message().FullName()]
+	if hasKey {
+		keyField := field.Message().Fields().ByName(keyName)
+		aKeys, aUnique := diffListKeys(keyField, a)
+		bKeys, bUnique := diffListKeys(keyField, b)
+		if aUnique && bUnique {
+			d.diffKeyedList(path, fields, keyField, a, b, aKeys, bKeys)
+			return
+		}
+	}
+
+	for i := 0; i < a.Len() || i < b.Len(); i++ {
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
+		if i >= b.Len() {
+			d.add(elementPath, fields, &Difference{Kind: DiffKindRemoved, Old: "{...}"})
+		} else if i >= a.Len() {
+			d.add(elementPath, fields, &Difference{Kind: DiffKindAdded, New: "{...}"})
+		} else {
+			d.diffMessage(elementPath, fields, a.Get(i).Message(), b.Get(i).Message())
+		}
+	}
+}
+
+// diffKeyedList Compares elements with the same key, then the order of the keys the lists share
+func (d *browserDiffer) diffKeyedList(path string, fields *diffField, keyField protoreflect.FieldDescriptor, a, b protoreflect.List, aKeys, bKeys []string) {
+	bIndexes := make(map[string]int, len(bKeys))
+	for i, key := range bKeys {
+		bIndexes[key] = i
+	}
+	aIndexes := make(map[string]int, len(aKeys))
+	for i, key := range aKeys {
+		aIndexes[key] = i
+	}
+
+	missing, extra := []string{}, []string{}
+	aShared, bShared := []string{}, []string{}
+	for i, key := range aKeys {
+		bIndex, ok := bIndexes[key]
+		if !ok {
+			missing = append(missing, key)
+			continue
+		}
+		aShared = append(aShared, key)
+		d.diffMessage(fmt.Sprintf("%s[%s]", path, key), fields, a.Get(i).Message(), b.Get(bIndex).Message())
+	}
+	for _, key := range bKeys {
+		if _, ok := aIndexes[key]; !ok {
+			extra = append(extra, key)
+			continue
+		}
+		bShared = append(bShared, key)
+	}
+
+	if len(missing) > 0 || len(extra) > 0 {
+		d.add(path, fields, &Difference{Kind: DiffKindElements, Missing: missing, Extra: extra})
+	}
+	if strings.Join(aShared, "\x00") != strings.Join(bShared, "\x00") {
+		d.add(path, fields, &Difference{Kind: DiffKindReordered, Old: strings.Join(aShared, ","), New: strings.Join(bShared, ",")})
+	}
+}
+
+// diffScalarList Reports missing and extra elements, or a different order when there are none
+func (d *browserDiffer) diffScalarList(path string, fields *diffField, a, b protoreflect.List) {
+	aValues := make([]string, a.Len())
+	for i := range aValues {
+		aValues[i] = formatDiffValue(fields.field, a.Get(i))
+	}
+	bValues := make([]string, b.Len())
+	for i := range bValues {
+		bValues[i] = formatDiffValue(fields.field, b.Get(i))
+	}
+	if strings.Join(aValues, "\x00") == strings.Join(bValues, "\x00") && len(aValues) == len(bValues) {
+		return
+	}
+
+	// Elements are counted so duplicates are compared too
+	counts := map[string]int{}
+	for _, value := range aValues {
+		counts[value]++
+	}
+	for _, value := range bValues {
+		counts[value]--
+	}
+	missing, extra := []string{}, []string{}
+	for _, value := range aValues {
+		if counts[value] > 0 {
+			missing = append(missing, value)
+			counts[value]--
+		}
+	}
+	for _, value := range bValues {
+		if counts[value] < 0 {
+			extra = append(extra, value)
+			counts[value]++
+		}
+	}
+
+	if len(missing) > 0 || len(extra) > 0 {
+		d.add(path, fields, &Difference{Kind: DiffKindElements, Missing: missing, Extra: extra})
+		return
+	}
+	d.add(path, fields, &Difference{Kind: DiffKindReordered, Old: strings.Join(aValues, ","), New: strings.Join(bValues, ",")})
+}
+
+func (d *browserDiffer) diffMap(path string, fields *diffField, a, b protoreflect.Map) {
+	valueField := fields.field.MapValue()
+	keys := map[string]protoreflect.MapKey{}
+	a.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[key.String()] = key
+		return true
+	})
+	b.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[key.String()] = key
+		return true
+	})
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	for _, keyString := range sortedKeys {
+		key := keys[keyString]
+		elementPath := fmt.Sprintf("%s[%s]", path, keyString)
+		aHas, bHas := a.Has(key), b.Has(key)
+		if !bHas {
+			d.add(elementPath, fields, &Difference{Kind: DiffKindRemoved, Old: formatDiffValue(valueField, a.Get(key))})
+			continue
+		}
+		if !aHas {
+			d.add(elementPath, fields, &Difference{Kind: DiffKindAdded, New: formatDiffValue(valueField, b.Get(key))})
+			continue
+		}
+		if valueField.Message() != nil {
+			d.diffMessage(elementPath, fields, a.Get(key).Message(), b.Get(key).Message())
+			continue
+		}
+		if !a.Get(key).Equal(b.Get(key)) {
+			d.add(elementPath, fields, &Difference{Kind: DiffKindChanged, Old: formatDiffValue(valueField, a.Get(key)), New: formatDiffValue(valueField, b.Get(key))})
+		}
+	}
+}
+
+func diffListKeys(keyField protoreflect.FieldDescriptor, list protoreflect.List) ([]string, bool) {
+	result := make([]string, list.Len())
+	seen := map[string]bool{}
+	for i := range result {
+		result[i] = formatDiffValue(keyField, list.Get(i).Message().Get(keyField))
+		if seen[result[i]] {
+			return result, false
+		}
+		seen[result[i]] = true
+	}
+	return result, true
+}
+
+// formatDiffValue Formats a scalar, enums by name when they have one
+func formatDiffValue(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return mustString(int(value.Enum()))
+	case protoreflect.BytesKind:
+		return hex.EncodeToString(value.Bytes())
+	case protoreflect.StringKind:
+		return fmt.Sprintf("%q", value.String())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return "{...}"
+	default:
+		return value.String()
+	}
+}
@@ -1,11 +1,11 @@
 package device_utils
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
-	"github.com/davecgh/go-spew/spew"
+	"sort"
 	"strconv"
-	"strings"
 )
 
 type DLFingerprint struct {
@@ -41,8 +41,8 @@ type DLFingerprint struct {
 	Darkmode            bool               `json:"darkmode"`
 	AvailabeFonts       []string           `json:"availabeFonts"`
 	StackNative         []float64          `json:"stack_native"`
-	TimingNative        int64              `json:"timing_native"`
-	Permissions         Permissions        `json:"permissions"`
+	TimingNative        float64            `json:"timing_native"`
+	Permissions         map[string]string  `json:"permissions"`
 	Navigator           []string           `json:"navigator"`
 	Window              []string           `json:"window"`
 	Document            []string           `json:"document"`
@@ -58,7 +58,7 @@ type DLFingerprint struct {
 	IsBot               bool               `json:"is_bot"`
 	Status              string             `json:"status"`
 	StackWorker         []float64          `json:"stack_worker"`
-	TimingWorker        int64              `json:"timing_worker"`
+	TimingWorker        float64            `json:"timing_worker"`
 	Gl                  map[string][]any   `json:"gl"`
 	Gl2                 map[string][]any   `json:"gl2"`
 	GlExperimental      map[string][]any   `json:"gl_experimental"`
@@ -66,11 +66,11 @@ type DLFingerprint struct {
 
 type AudioContext struct {
 	BaseLatency   float64             `json:"baseLatency"`
-	OutputLatency int64               `json:"outputLatency"`
+	OutputLatency float64             `json:"outputLatency"`
 	SinkID        string              `json:"sinkId"`
 	Destination   Destination         `json:"destination"`
-	CurrentTime   int64               `json:"currentTime"`
-	SampleRate    int64               `json:"sampleRate"`
+	CurrentTime   float64             `json:"currentTime"`
+	SampleRate    float64             `json:"sampleRate"`
 	Listener      map[string]Listener `json:"listener"`
 	State         string              `json:"state"`
 	AudioWorklet  AudioWorklet        `json:"audioWorklet"`
@@ -80,15 +80,15 @@ type AudioWorklet struct {
 }
 
 type Context struct {
-	BaseLatency   float64      `json:"baseLatency"`
-	OutputLatency int64        `json:"outputLatency"`
-	SinkID        string       `json:"sinkId"`
-	Destination   Destination  `json:"destination"`
-	CurrentTime   int64        `json:"currentTime"`
-	SampleRate    int64        `json:"sampleRate"`
-	Listener      AudioWorklet `json:"listener"`
-	State         string       `json:"state"`
-	AudioWorklet  AudioWorklet `json:"audioWorklet"`
+	BaseLatency   float64             `json:"baseLatency"`
+	OutputLatency float64             `json:"outputLatency"`
+	SinkID        string              `json:"sinkId"`
+	Destination   Destination         `json:"destination"`
+	CurrentTime   float64             `json:"currentTime"`
+	SampleRate    float64             `json:"sampleRate"`
+	Listener      map[string]Listener `json:"listener"`
+	State         string              `json:"state"`
+	AudioWorklet  AudioWorklet        `json:"audioWorklet"`
 }
 
 type Destination struct {
@@ -102,9 +102,9 @@ type Destination struct {
 }
 
 type Listener struct {
-	Value          int64   `json:"value"`
+	Value          float64 `json:"value"`
 	AutomationRate string  `json:"automationRate"`
-	DefaultValue   int64   `json:"defaultValue"`
+	DefaultValue   float64 `json:"defaultValue"`
 	MinValue       float64 `json:"minValue"`
 	MaxValue       float64 `json:"maxValue"`
 }
@@ -126,9 +126,9 @@ type AudioTypes struct {
 }
 
 type ChromeApp struct {
-	IsInstalled  bool         `json:"isInstalled"`
-	InstallState InstallState `json:"InstallState"`
-	RunningState RunningState `json:"RunningState"`
+	IsInstalled  bool              `json:"isInstalled"`
+	InstallState map[string]string `json:"InstallState"`
+	RunningState map[string]string `json:"RunningState"`
 }
 
 type InstallState struct {
@@ -193,6 +193,92 @@ type Plugin struct {
 	Filename    string `json:"filename"`
 	Description string `json:"description"`
 	Length      int64  `json:"length"`
+	// MIMETypes are all the indexed entries, The0 is the first of them
+	MIMETypes []Empty `json:"-"`
+}
+
+// UnmarshalJSON Reads the indexed MIME type entries, the entries keyed by type are copies of them
+func (p *Plugin) UnmarshalJSON(data []byte) error {
+	fields := map[string]json.RawMessage{}
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	type plainPlugin Plugin
+	plain := plainPlugin{}
+	err = json.Unmarshal(data, &plain)
+	if err != nil {
+		return err
+	}
+	*p = Plugin(plain)
+
+	p.MIMETypes = []Empty{}
+	for i := 0; ; i++ {
+		field, ok := fields[strconv.Itoa(i)]
+		if !ok {
+			break
+		}
+		mimeType := Empty{}
+		err = json.Unmarshal(field, &mimeType)
+		if err != nil {
+			return err
+		}
+		p.MIMETypes = append(p.MIMETypes, mimeType)
+	}
+	return nil
+}
+
+// MarshalJSON Writes the MIME types both indexed and keyed by type, the way the collector serializes a Plugin
+func (p Plugin) MarshalJSON() ([]byte, error) {
+	mimeTypes := p.MIMETypes
+	if mimeTypes == nil && p.Length > 0 {
+		mimeTypes = []Empty{p.The0}
+	}
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte('{')
+	writeField := func(key string, value any) error {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if buffer.Len() > 1 {
+			buffer.WriteByte(',')
+		}
+		keyData, _ := json.Marshal(key)
+		buffer.Write(keyData)
+		buffer.WriteByte(':')
+		buffer.Write(data)
+		return nil
+	}
+
+	var err error
+	for i, mimeType := range mimeTypes {
+		if err = writeField(strconv.Itoa(i), mimeType); err != nil {
+			return nil, err
+		}
+	}
+	written := map[string]bool{}
+	for _, mimeType := range mimeTypes {
+		if written[mimeType.Type] {
+			continue
+		}
+		written[mimeType.Type] = true
+		if err = writeField(mimeType.Type, mimeType); err != nil {
+			return nil, err
+		}
+	}
+	for _, field := range []struct {
+		key   string
+		value any
+	}{{"name", p.Name}, {"filename", p.Filename}, {"description", p.Description}, {"length", p.Length}} {
+		if err = writeField(field.key, field.value); err != nil {
+			return nil, err
+		}
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
 }
 
 type Empty struct {
@@ -297,13 +383,41 @@ type CompressedTextureFormat struct {
 }
 
 // FromDLFingerprint Imports and normalizes output from https://github.com/kaliiiiiiiiii/driverless-fp-collector
-func (b *Browser) FromDLFingerprint(response *DLFingerprint) error {
+// Every problem is collected into an *ImportError, lenient skips data that is well-formed but unknown instead of reporting it
+// The browser is only modified when the import succeeds
+func (b *Browser) FromDLFingerprint(response *DLFingerprint, lenient ...bool) error {
+	collector := newImportCollector(lenient...)
+	// Parse GL capabilities first, they are the only part that can be invalid
+	gl := parseGLCapabilities(collector, "gl", response.Gl)
+	gl2 := parseGLCapabilities(collector, "gl2", response.Gl2)
+	glExperimental := parseGLCapabilities(collector, "gl_experimental", response.GlExperimental)
+	doNotTrack := parseDoNotTrack(collector, response.DoNotTrack)
+	css := &Browser_BrowserCollection{MapData: make(map[string]string), NumberData: make(map[string]float64)}
+	for key, value := range response.CSS {
+		switch typedValue := value.(type) {
+		case string:
+			css.MapData[key] = typedValue
+			break
+		case float64:
+			css.NumberData[key] = typedValue
+			break
+		default:
+			collector.add(fmt.Sprintf("css.%s", key), fmt.Errorf("%w: %T", ErrImportUnknown, typedValue))
+			break
+		}
+	}
+	err := collector.err()
+	if err != nil {
+		return err
+	}
+
 	b.UserAgent = response.UserAgent
 	b.AppCodeName = response.AppCodeName
 	b.AppName = response.AppName
 	b.AppVersion = response.AppVersion
+	b.CookieEnabled = response.CookieEnabled
 	b.DeviceMemory = response.DeviceMemory
-	b.DoNotTrack = -1
+	b.DoNotTrack = doNotTrack
 	b.HardwareConcurrency = int32(response.HardwareConcurrency)
 	b.Language = response.Language
 	b.Languages = response.Languages
@@ -314,7 +428,7 @@ func (b *Browser) FromDLFingerprint(response *DLFingerprint) error {
 	b.ProductSub = response.ProductSub
 	b.Vendor = response.Vendor
 	b.VendorSub = response.VendorSub
-	b.Webdriver = false // response.Webdiver
+	b.Webdriver = response.Webdiver
 	b.DevicePixelRatio = response.DevicePixelRatio
 	b.InnerWidth = int32(response.InnerWidth)
 	b.InnerHeight = int32(response.InnerHeight)
@@ -356,12 +470,23 @@ func (b *Browser) FromDLFingerprint(response *DLFingerprint) error {
 			FileName:           plugin.Filename,
 			Description:        plugin.Description,
 			SupportedMIMETypes: make(map[string]*Browser_Plugin_MIMEType),
+			MimeTypes:          []*Browser_Plugin_MIMEType{},
 		}
 
-		pluginObj.SupportedMIMETypes[plugin.The0.Type] = &Browser_Plugin_MIMEType{
-			Type:        plugin.The0.Type,
-			Suffixes:    plugin.The0.Suffixes,
-			Description: plugin.The0.Description,
+		mimeTypes := plugin.MIMETypes
+		if mimeTypes == nil && plugin.Length > 0 {
+			mimeTypes = []Empty{plugin.The0}
+		}
+		for _, mimeType := range mimeTypes {
+			mimeTypeObj := &Browser_Plugin_MIMEType{
+				Type:        mimeType.Type,
+				Suffixes:    mimeType.Suffixes,
+				Description: mimeType.Description,
+			}
+			pluginObj.MimeTypes = append(pluginObj.MimeTypes, mimeTypeObj)
+			if _, ok := pluginObj.SupportedMIMETypes[mimeType.Type]; !ok {
+				pluginObj.SupportedMIMETypes[mimeType.Type] = mimeTypeObj
+			}
 		}
 
 		b.Plugins = append(b.Plugins, pluginObj)
@@ -386,10 +511,10 @@ func (b *Browser) FromDLFingerprint(response *DLFingerprint) error {
 	}
 	for _, videoCodec := range response.Webrtc.Video.Codecs {
 		b.WebRTC.Video.Codecs = append(b.WebRTC.Video.Codecs, &Browser_WebRTC_Codec{
-			Channels:   0,
+			Channels:   videoCodec.Channels,
 			ClockRate:  videoCodec.ClockRate,
 			MimeType:   videoCodec.MIMEType,
-			SdpFmtLine: "",
+			SdpFmtLine: videoCodec.SDPFmtpLine,
 		})
 	}
 	for _, audioCodec := range response.Webrtc.Audio.Codecs {
@@ -421,14 +546,34 @@ func (b *Browser) FromDLFingerprint(response *DLFingerprint) error {
 	b.AudioTypes = &Browser_BrowserCollection{MapData: response.AudioTypes}
 	b.VideoTypes = &Browser_BrowserCollection{MapData: response.VideoTypes}
 
-	b.Css = &Browser_BrowserCollection{MapData: make(map[string]string)}
-	for key, value := range response.CSS {
-		switch typedValue := value.(type) {
-		case string:
-			b.Css.MapData[key] = typedValue
-			break
-		}
+	b.Css = css
+
+	b.UserActivation = &Browser_UserActivation{
+		HasBeenActive: response.UserActivation.HasBeenActive,
+		IsActive:      response.UserActivation.IsActive,
+	}
+	b.ChromeApp = &Browser_ChromeApp{
+		IsInstalled:  response.ChromeApp.IsInstalled,
+		InstallState: response.ChromeApp.InstallState,
+		RunningState: response.ChromeApp.RunningState,
+	}
+	b.Wow64 = response.Wow64
+	b.DarkMode = response.Darkmode
+	b.StackNative = &Browser_StackProbe{Stack: response.StackNative, Timing: response.TimingNative}
+	b.StackWorker = &Browser_StackProbe{Stack: response.StackWorker, Timing: response.TimingWorker}
+	b.Permissions = response.Permissions
+	b.AudioContext = audioContextFromDL(&response.AudioContext)
+	b.MediaDevices = make([]*Browser_MediaDevice, 0, len(response.MediaDevices))
+	for _, mediaDevice := range response.MediaDevices {
+		b.MediaDevices = append(b.MediaDevices, &Browser_MediaDevice{
+			DeviceId: mediaDevice.DeviceID,
+			Kind:     mediaDevice.Kind,
+			Label:    mediaDevice.Label,
+			GroupId:  mediaDevice.GroupID,
+		})
 	}
+	b.IsBot = response.IsBot
+	b.Status = response.Status
 
 	if response.HighEntropyValues != nil {
 		b.HighEntropyValues = &Browser_HighEntropyValues{
@@ -442,16 +587,14 @@ func (b *Browser) FromDLFingerprint(response *DLFingerprint) error {
 			Brands:          nil,
 			FullVersionList: nil,
 		}
-		brandHeaderElements := []string{}
 		for _, brand := range response.HighEntropyValues.Brands {
 			b.HighEntropyValues.Brands = append(b.HighEntropyValues.Brands, &Browser_HighEntropyValues_Brand{
 				Brand:   brand.Brand,
 				Version: brand.Version,
 			})
-			brandHeaderElements = append(brandHeaderElements, fmt.Sprintf("\"%s\";v=\"%s\"", brand.Brand, brand.Version))
 		}
-		if len(brandHeaderElements) > 0 {
-			b.BrandHeader = strings.Join(brandHeaderElements, ",")
+		if len(b.HighEntropyValues.Brands) > 0 {
+			b.BrandHeader = FormatBrandHeader(b.HighEntropyValues.Brands)
 		}
 		for _, brand := range response.HighEntropyValues.FullVersionList {
 			b.HighEntropyValues.FullVersionList = append(b.HighEntropyValues.FullVersionList, &Browser_HighEntropyValues_Brand{
@@ -461,67 +604,59 @@ func (b *Browser) FromDLFingerprint(response *DLFingerprint) error {
 		}
 	}
 
-	// Parse GL capabilities
-	b.Gl = &Browser_BrowserCollection{GlCapabilities: make(map[string]*Browser_GLCapability)}
-	for key, value := range response.Gl {
-		valueLength := len(value)
-		enumElem := value[valueLength-1]
-
-		data := &Browser_GLCapability{
-			BoolValue:   []bool{},
-			IntValue:    []int64{},
-			FloatValue:  []float64{},
-			StringValue: []string{},
-			EnumValue:   int64(int32(enumElem.(float64))),
-			EnumName:    key,
-		}
+	b.Gl = gl
+	b.Gl2 = gl2
+	b.GlExperimental = glExperimental
 
-		buildGLCapabilities(key, data, value[:valueLength-1])
+	return nil
+}
 
-		b.Gl.GlCapabilities[key] = data
-	}
-	b.Gl2 = &Browser_BrowserCollection{GlCapabilities: make(map[string]*Browser_GLCapability)}
-	for key, value := range response.Gl2 {
+// parseGLCapabilities Parses capabilities in the [values..., enum] format
+func parseGLCapabilities(collector *importCollector, path string, capabilities map[string][]any) *Browser_BrowserCollection {
+	result := &Browser_BrowserCollection{GlCapabilities: make(map[string]*Browser_GLCapability)}
+	for key, value := range capabilities {
+		keyPath := fmt.Sprintf("%s.%s", path, key)
 		valueLength := len(value)
-		enumElem := value[valueLength-1]
+		if valueLength == 0 {
+			collector.add(keyPath, ErrImportMissing)
+			continue
+		}
+		enumElem, ok := value[valueLength-1].(float64)
+		if !ok {
+			collector.add(fmt.Sprintf("%s[%d]", keyPath, valueLength-1), fmt.Errorf("%w: enum %T is not a number", ErrImportMalformed, value[valueLength-1]))
+			continue
+		}
 
 		data := &Browser_GLCapability{
 			BoolValue:   []bool{},
 			IntValue:    []int64{},
 			FloatValue:  []float64{},
 			StringValue: []string{},
-			EnumValue:   int64(enumElem.(float64)),
+			EnumValue:   int64(enumElem),
 			EnumName:    key,
 		}
 
-		buildGLCapabilities(key, data, value[:valueLength-1])
-
-		b.Gl2.GlCapabilities[key] = data
-	}
-	b.GlExperimental = &Browser_BrowserCollection{GlCapabilities: make(map[string]*Browser_GLCapability)}
-	for key, value := range response.GlExperimental {
-		valueLength := len(value)
-		enumElem := value[valueLength-1]
-
-		data := &Browser_GLCapability{
-			BoolValue:   []bool{},
-			IntValue:    []int64{},
-			FloatValue:  []float64{},
-			StringValue: []string{},
-			EnumValue:   int64(enumElem.(float64)),
-			EnumName:    key,
+		if valueLength == 2 {
+			switch value[0].(type) {
+			case []any:
+				data.Shape = Browser_GLCapability_SEQUENCE
+				break
+			case map[string]any:
+				data.Shape = Browser_GLCapability_TYPED_ARRAY
+				break
+			}
 		}
 
-		buildGLCapabilities(key, data, value[:valueLength-1])
+		buildGLCapabilities(collector, keyPath, data, value[:valueLength-1])
 
-		b.GlExperimental.GlCapabilities[key] = data
+		result.GlCapabilities[key] = data
 	}
-
-	return nil
+	return result
 }
 
-func buildGLCapabilities(name string, data *Browser_GLCapability, elements []any) {
-	for _, element := range elements {
+func buildGLCapabilities(collector *importCollector, path string, data *Browser_GLCapability, elements []any) {
+	for i, element := range elements {
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
 		switch typedElement := element.(type) {
 		case string:
 			data.StringValue = append(data.StringValue, typedElement)
@@ -534,31 +669,367 @@ func buildGLCapabilities(name string, data *Browser_GLCapability, elements []any
 			break
 		case []any:
 			// Array of booleans
-			buildGLCapabilities(name, data, typedElement)
+			buildGLCapabilities(collector, elementPath, data, typedElement)
 			break
 		case map[string]any:
+			// Typed arrays get serialized as objects keyed by index
 			intermediate := make([]any, len(typedElement))
+			valid := true
 			for key, elementValue := range typedElement {
 				intKey, err := strconv.Atoi(key)
-				if err != nil {
-					panic(err)
+				if err != nil || intKey < 0 || intKey >= len(intermediate) {
+					collector.add(fmt.Sprintf("%s.%s", elementPath, key), fmt.Errorf("%w: %q is not an index", ErrImportMalformed, key))
+					valid = false
+					continue
 				}
 				intermediate[intKey] = elementValue
 			}
-			buildGLCapabilities(name, data, intermediate)
+			if valid {
+				buildGLCapabilities(collector, elementPath, data, intermediate)
+			}
 			break
 		default:
-			fmt.Println(spew.Sdump(typedElement))
+			collector.add(elementPath, fmt.Errorf("%w: %T", ErrImportUnknown, typedElement))
+			break
 		}
 	}
 }
 
-func (b *Browser) FromDLFingerprintRaw(data []byte) error {
+func (b *Browser) FromDLFingerprintRaw(data []byte, lenient ...bool) error {
 	response := &DLFingerprint{}
 	err := json.Unmarshal(data, response)
 	if err != nil {
 		return fmt.Errorf("json.Unmarshal: %w", err)
 	}
 
-	return b.FromDLFingerprint(response)
+	return b.FromDLFingerprint(response, lenient...)
+}
+
+// parseDoNotTrack Parses navigator.doNotTrack, null is stored as -1 and "unspecified" as -2
+func parseDoNotTrack(collector *importCollector, value any) int32 {
+	switch typedValue := value.(type) {
+	case nil:
+		return -1
+	case string:
+		if typedValue == "unspecified" {
+			return -2
+		}
+		result, err := parseInt(typedValue)
+		if err != nil {
+			collector.add("doNotTrack", err)
+			return -1
+		}
+		return int32(result)
+	default:
+		collector.add("doNotTrack", fmt.Errorf("%w: %T", ErrImportUnknown, typedValue))
+		return -1
+	}
+}
+
+func audioContextFromDL(audioContext *AudioContext) *Browser_AudioContext {
+	result := &Browser_AudioContext{
+		BaseLatency:   audioContext.BaseLatency,
+		OutputLatency: audioContext.OutputLatency,
+		SinkId:        audioContext.SinkID,
+		Destination: &Browser_AudioDestination{
+			MaxChannelCount:       audioContext.Destination.MaxChannelCount,
+			NumberOfInputs:        audioContext.Destination.NumberOfInputs,
+			NumberOfOutputs:       audioContext.Destination.NumberOfOutputs,
+			ChannelCount:          audioContext.Destination.ChannelCount,
+			ChannelCountMode:      audioContext.Destination.ChannelCountMode,
+			ChannelInterpretation: audioContext.Destination.ChannelInterpretation,
+		},
+		CurrentTime: audioContext.CurrentTime,
+		SampleRate:  audioContext.SampleRate,
+		Listener:    make(map[string]*Browser_AudioParam),
+		State:       audioContext.State,
+	}
+	// The destination points back at the context it belongs to
+	if audioContext.Destination.Context != nil {
+		context := AudioContext(*audioContext.Destination.Context)
+		result.Destination.Context = audioContextFromDL(&context)
+	}
+	for key, param := range audioContext.Listener {
+		result.Listener[key] = &Browser_AudioParam{
+			Value:          param.Value,
+			AutomationRate: param.AutomationRate,
+			DefaultValue:   param.DefaultValue,
+			MinValue:       param.MinValue,
+			MaxValue:       param.MaxValue,
+		}
+	}
+	return result
+}
+
+func audioContextToDL(audioContext *Browser_AudioContext) AudioContext {
+	destination := audioContext.GetDestination()
+	result := AudioContext{
+		BaseLatency:   audioContext.GetBaseLatency(),
+		OutputLatency: audioContext.GetOutputLatency(),
+		SinkID:        audioContext.GetSinkId(),
+		Destination: Destination{
+			MaxChannelCount:       destination.GetMaxChannelCount(),
+			NumberOfInputs:        destination.GetNumberOfInputs(),
+			NumberOfOutputs:       destination.GetNumberOfOutputs(),
+			ChannelCount:          destination.GetChannelCount(),
+			ChannelCountMode:      destination.GetChannelCountMode(),
+			ChannelInterpretation: destination.GetChannelInterpretation(),
+		},
+		CurrentTime: audioContext.GetCurrentTime(),
+		SampleRate:  audioContext.GetSampleRate(),
+		Listener:    make(map[string]Listener),
+		State:       audioContext.GetState(),
+	}
+	if destination.GetContext() != nil {
+		context := Context(audioContextToDL(destination.Context))
+		result.Destination.Context = &context
+	}
+	for key, param := range audioContext.GetListener() {
+		result.Listener[key] = Listener{
+			Value:          param.Value,
+			AutomationRate: param.AutomationRate,
+			DefaultValue:   param.DefaultValue,
+			MinValue:       param.MinValue,
+			MaxValue:       param.MaxValue,
+		}
+	}
+	return result
+}
+
+// glCapabilitiesToDL Formats capabilities back into the [values..., enum] format
+func glCapabilitiesToDL(collection *Browser_BrowserCollection) map[string][]any {
+	result := make(map[string][]any)
+	for key, capability := range collection.GetGlCapabilities() {
+		values := []any{}
+		for _, value := range capability.BoolValue {
+			values = append(values, value)
+		}
+		for _, value := range capability.IntValue {
+			values = append(values, value)
+		}
+		for _, value := range capability.FloatValue {
+			values = append(values, value)
+		}
+		for _, value := range capability.StringValue {
+			values = append(values, value)
+		}
+
+		switch capability.Shape {
+		case Browser_GLCapability_SEQUENCE:
+			result[key] = []any{values, capability.EnumValue}
+			break
+		case Browser_GLCapability_TYPED_ARRAY:
+			typedArray := make(map[string]any, len(values))
+			for i, value := range values {
+				typedArray[strconv.Itoa(i)] = value
+			}
+			result[key] = []any{typedArray, capability.EnumValue}
+			break
+		default:
+			result[key] = append(values, capability.EnumValue)
+			break
+		}
+	}
+	return result
+}
+
+// ToDLFingerprint Exports the browser in the format of https://github.com/kaliiiiiiiiii/driverless-fp-collector, the inverse of FromDLFingerprint
+func (b *Browser) ToDLFingerprint() *DLFingerprint {
+	result := &DLFingerprint{
+		AppCodeName:         b.AppCodeName,
+		AppName:             b.AppName,
+		AppVersion:          b.AppVersion,
+		CookieEnabled:       b.CookieEnabled,
+		DeviceMemory:        b.DeviceMemory,
+		HardwareConcurrency: int64(b.HardwareConcurrency),
+		Language:            b.Language,
+		Languages:           append([]string{}, b.Languages...),
+		MaxTouchPoints:      int64(b.MaxTouchPoints),
+		PDFViewerEnabled:    b.PdfViewerEnabled,
+		Platform:            b.Platform,
+		Product:             b.Product,
+		ProductSub:          b.ProductSub,
+		UserAgent:           b.UserAgent,
+		Vendor:              b.Vendor,
+		VendorSub:           b.VendorSub,
+		Webdiver:            b.Webdriver,
+		DevicePixelRatio:    b.DevicePixelRatio,
+		InnerWidth:          int64(b.InnerWidth),
+		InnerHeight:         int64(b.InnerHeight),
+		OuterWidth:          int64(b.OuterWidth),
+		OuterHeight:         int64(b.OuterHeight),
+		Screen: Screen{
+			AvailWidth:  int64(b.Screen.GetAvailWidth()),
+			AvailHeight: int64(b.Screen.GetAvailHeight()),
+			Width:       int64(b.Screen.GetWidth()),
+			Height:      int64(b.Screen.GetHeight()),
+			ColorDepth:  int64(b.Screen.GetColorDepth()),
+			PixelDepth:  int64(b.Screen.GetPixelDepth()),
+			AvailLeft:   int64(b.Screen.GetAvailLeft()),
+			AvailTop:    int64(b.Screen.GetAvailTop()),
+			Orientation: Orientation{
+				Angle: int64(b.Screen.GetOrientation().GetAngle()),
+				Type:  b.Screen.GetOrientation().GetType(),
+			},
+			IsExtended: b.Screen.GetIsExtended(),
+		},
+		Plugins: make([]Plugin, 0, len(b.Plugins)),
+		UserActivation: UserActivation{
+			HasBeenActive: b.UserActivation.GetHasBeenActive(),
+			IsActive:      b.UserActivation.GetIsActive(),
+		},
+		ChromeApp: ChromeApp{
+			IsInstalled:  b.ChromeApp.GetIsInstalled(),
+			InstallState: b.ChromeApp.GetInstallState(),
+			RunningState: b.ChromeApp.GetRunningState(),
+		},
+		Wow64:           b.Wow64,
+		Darkmode:        b.DarkMode,
+		AvailabeFonts:   append([]string{}, b.AvailableFonts.GetListData()...),
+		StackNative:     append([]float64{}, b.StackNative.GetStack()...),
+		TimingNative:    b.StackNative.GetTiming(),
+		Permissions:     b.Permissions,
+		Navigator:       append([]string{}, b.Navigator.GetListData()...),
+		Window:          append([]string{}, b.Window.GetListData()...),
+		Document:        append([]string{}, b.Document.GetListData()...),
+		DocumentElement: append([]string{}, b.DocumentElement.GetListData()...),
+		SpeechSynthesis: make([]SpeechSynthesis, 0, len(b.SpeechSynthesis)),
+		CSS:             make(map[string]any),
+		AudioTypes:      b.AudioTypes.GetMapData(),
+		VideoTypes:      b.VideoTypes.GetMapData(),
+		AudioContext:    audioContextToDL(b.AudioContext),
+		Webrtc: Webrtc{
+			Video: Audio{Codecs: []Codec{}, HeaderExtensions: []HeaderExtension{}},
+			Audio: Audio{Codecs: []Codec{}, HeaderExtensions: []HeaderExtension{}},
+		},
+		Webgpu: Webgpu{
+			Features:          Features{Size: b.WebGPU.GetFeatures().GetSize()},
+			Limits:            b.WebGPU.GetLimits(),
+			IsFallbackAdapter: b.WebGPU.GetIsFallbackAdapter(),
+			Vendor:            b.WebGPU.GetVendor(),
+			Architecture:      b.WebGPU.GetArchitecture(),
+			Device:            b.WebGPU.GetDevice(),
+			Description:       b.WebGPU.GetDescription(),
+		},
+		MediaDevices:   make([]MediaDevice, 0, len(b.MediaDevices)),
+		IsBot:          b.IsBot,
+		Status:         b.Status,
+		StackWorker:    append([]float64{}, b.StackWorker.GetStack()...),
+		TimingWorker:   b.StackWorker.GetTiming(),
+		Gl:             glCapabilitiesToDL(b.Gl),
+		Gl2:            glCapabilitiesToDL(b.Gl2),
+		GlExperimental: glCapabilitiesToDL(b.GlExperimental),
+	}
+
+	switch b.DoNotTrack {
+	case -1:
+		result.DoNotTrack = nil
+		break
+	case -2:
+		result.DoNotTrack = "unspecified"
+		break
+	default:
+		result.DoNotTrack = mustString(int(b.DoNotTrack))
+		break
+	}
+
+	for _, plugin := range b.Plugins {
+		pluginObj := Plugin{
+			Name:        plugin.Name,
+			Filename:    plugin.FileName,
+			Description: plugin.Description,
+			MIMETypes:   []Empty{},
+		}
+		mimeTypes := plugin.MimeTypes
+		if len(mimeTypes) == 0 {
+			// Profiles from before the order was kept
+			types := make([]string, 0, len(plugin.SupportedMIMETypes))
+			for mimeType := range plugin.SupportedMIMETypes {
+				types = append(types, mimeType)
+			}
+			sort.Strings(types)
+			for _, mimeType := range types {
+				mimeTypes = append(mimeTypes, plugin.SupportedMIMETypes[mimeType])
+			}
+		}
+		for _, mimeType := range mimeTypes {
+			pluginObj.MIMETypes = append(pluginObj.MIMETypes, Empty{
+				Type:        mimeType.Type,
+				Suffixes:    mimeType.Suffixes,
+				Description: mimeType.Description,
+			})
+		}
+		if len(pluginObj.MIMETypes) > 0 {
+			pluginObj.The0 = pluginObj.MIMETypes[0]
+		}
+		pluginObj.Length = int64(len(pluginObj.MIMETypes))
+		result.Plugins = append(result.Plugins, pluginObj)
+	}
+
+	if b.HighEntropyValues != nil {
+		result.HighEntropyValues = &HighEntropyValues{
+			Architecture:    b.HighEntropyValues.Architecture,
+			Bitness:         b.HighEntropyValues.Bitness,
+			Mobile:          b.HighEntropyValues.Mobile,
+			Model:           b.HighEntropyValues.Model,
+			Platform:        b.HighEntropyValues.Platform,
+			PlatformVersion: b.HighEntropyValues.PlatformVersion,
+			UaFullVersion:   b.HighEntropyValues.UsFullVersion,
+		}
+		for _, brand := range b.HighEntropyValues.Brands {
+			result.HighEntropyValues.Brands = append(result.HighEntropyValues.Brands, Brand{Brand: brand.Brand, Version: brand.Version})
+		}
+		for _, brand := range b.HighEntropyValues.FullVersionList {
+			result.HighEntropyValues.FullVersionList = append(result.HighEntropyValues.FullVersionList, Brand{Brand: brand.Brand, Version: brand.Version})
+		}
+	}
+
+	for _, speechEngine := range b.SpeechSynthesis {
+		result.SpeechSynthesis = append(result.SpeechSynthesis, SpeechSynthesis{
+			VoiceURI:     speechEngine.VoiceURI,
+			Name:         speechEngine.Name,
+			Lang:         speechEngine.Lang,
+			LocalService: speechEngine.LocalService,
+			Default:      speechEngine.Default,
+		})
+	}
+
+	for key, value := range b.Css.GetMapData() {
+		result.CSS[key] = value
+	}
+	for key, value := range b.Css.GetNumberData() {
+		result.CSS[key] = value
+	}
+
+	for _, codec := range b.WebRTC.GetVideo().GetCodecs() {
+		result.Webrtc.Video.Codecs = append(result.Webrtc.Video.Codecs, Codec{Channels: codec.Channels, ClockRate: codec.ClockRate, MIMEType: codec.MimeType, SDPFmtpLine: codec.SdpFmtLine})
+	}
+	for _, codec := range b.WebRTC.GetAudio().GetCodecs() {
+		result.Webrtc.Audio.Codecs = append(result.Webrtc.Audio.Codecs, Codec{Channels: codec.Channels, ClockRate: codec.ClockRate, MIMEType: codec.MimeType, SDPFmtpLine: codec.SdpFmtLine})
+	}
+	for _, headerExtension := range b.WebRTC.GetVideo().GetHeaderExtensions() {
+		result.Webrtc.Video.HeaderExtensions = append(result.Webrtc.Video.HeaderExtensions, HeaderExtension{Direction: headerExtension.Direction, URI: headerExtension.Uri})
+	}
+	for _, headerExtension := range b.WebRTC.GetAudio().GetHeaderExtensions() {
+		result.Webrtc.Audio.HeaderExtensions = append(result.Webrtc.Audio.HeaderExtensions, HeaderExtension{Direction: headerExtension.Direction, URI: headerExtension.Uri})
+	}
+
+	for _, mediaDevice := range b.MediaDevices {
+		result.MediaDevices = append(result.MediaDevices, MediaDevice{
+			DeviceID: mediaDevice.DeviceId,
+			Kind:     mediaDevice.Kind,
+			Label:    mediaDevice.Label,
+			GroupID:  mediaDevice.GroupId,
+		})
+	}
+
+	return result
+}
+
+func (b *Browser) ToDLFingerprintRaw() ([]byte, error) {
+	data, err := json.Marshal(b.ToDLFingerprint())
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	return data, nil
 }
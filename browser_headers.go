@@ -0,0 +1,437 @@
+package device_utils
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type RequestType int
+
+const (
+	RequestTypeNavigation RequestType = iota
+	RequestTypeFetch
+	RequestTypeImage
+	RequestTypeScript
+)
+
+const (
+	BrowserFamilyChromium = "chromium"
+	BrowserFamilyFirefox  = "firefox"
+	BrowserFamilySafari   = "safari"
+)
+
+// HeaderTemplateEntry is a single header in a template, Value is either a literal or one of the placeholders rendered by Browser.RenderHeaders
+type HeaderTemplateEntry struct {
+	Key   string
+	Value string
+	// MinMajorVersion leaves the header out for profiles older than the version that started sending it
+	MinMajorVersion int
+}
+
+// OrderedHeaders is a header list in the order a browser sends it
+type OrderedHeaders [][2]string
+
+var (
+	majorVersionRegexes = map[string]*regexp.Regexp{
+		BrowserFamilyChromium: regexp.MustCompile(`(?:Chrome|CriOS)/(\d+)`),
+		BrowserFamilyFirefox:  regexp.MustCompile(`(?:Firefox|FxiOS)/(\d+)`),
+		BrowserFamilySafari:   regexp.MustCompile(`Version/(\d+)`),
+	}
+
+	// HeaderTemplates src: captured from Chrome 124, Firefox 128 and Safari 18 on Windows and macOS
+	HeaderTemplates = map[string]map[RequestType][]*HeaderTemplateEntry{
+		BrowserFamilyChromium: {
+			RequestTypeNavigation: {
+				{Key: "cache-control", Value: ""},
+				{Key: "sec-ch-ua", Value: "{brandHeader}"},
+				{Key: "sec-ch-ua-mobile", Value: "{mobile}"},
+				{Key: "sec-ch-ua-platform", Value: "{platform}"},
+				{Key: "upgrade-insecure-requests", Value: "1"},
+				{Key: "user-agent", Value: "{userAgent}"},
+				{Key: "accept", Value: "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.7"},
+				{Key: "sec-gpc", Value: "{secGPC}"},
+				{Key: "sec-fetch-site", Value: "none"},
+				{Key: "sec-fetch-mode", Value: "navigate"},
+				{Key: "sec-fetch-user", Value: "?1"},
+				{Key: "sec-fetch-dest", Value: "document"},
+				{Key: "referer", Value: ""},
+				{Key: "accept-encoding", Value: "{acceptEncoding}"},
+				{Key: "accept-language", Value: "{acceptLanguage}"},
+				{Key: "cookie", Value: ""},
+				{Key: "priority", Value: "u=0, i", MinMajorVersion: 124},
+			},
+			RequestTypeFetch: {
+				{Key: "content-length", Value: ""},
+				{Key: "sec-ch-ua", Value: "{brandHeader}"},
+				{Key: "content-type", Value: ""},
+				{Key: "sec-ch-ua-mobile", Value: "{mobile}"},
+				{Key: "user-agent", Value: "{userAgent}"},
+				{Key: "sec-ch-ua-platform", Value: "{platform}"},
+				{Key: "accept", Value: "*/*"},
+				{Key: "sec-gpc", Value: "{secGPC}"},
+				{Key: "origin", Value: ""},
+				{Key: "sec-fetch-site", Value: "same-origin"},
+				{Key: "sec-fetch-mode", Value: "cors"},
+				{Key: "sec-fetch-dest", Value: "empty"},
+				{Key: "referer", Value: ""},
+				{Key: "accept-encoding", Value: "{acceptEncoding}"},
+				{Key: "accept-language", Value: "{acceptLanguage}"},
+				{Key: "cookie", Value: ""},
+				{Key: "priority", Value: "u=1, i", MinMajorVersion: 124},
+			},
+			RequestTypeImage: {
+				{Key: "sec-ch-ua", Value: "{brandHeader}"},
+				{Key: "sec-ch-ua-mobile", Value: "{mobile}"},
+				{Key: "user-agent", Value: "{userAgent}"},
+				{Key: "sec-ch-ua-platform", Value: "{platform}"},
+				{Key: "accept", Value: "image/avif,image/webp,image/apng,image/svg+xml,image/*,*/*;q=0.8"},
+				{Key: "sec-gpc", Value: "{secGPC}"},
+				{Key: "sec-fetch-site", Value: "same-origin"},
+				{Key: "sec-fetch-mode", Value: "no-cors"},
+				{Key: "sec-fetch-dest", Value: "image"},
+				{Key: "referer", Value: ""},
+				{Key: "accept-encoding", Value: "{acceptEncoding}"},
+				{Key: "accept-language", Value: "{acceptLanguage}"},
+				{Key: "cookie", Value: ""},
+				{Key: "priority", Value: "i", MinMajorVersion: 124},
+			},
+			RequestTypeScript: {
+				{Key: "sec-ch-ua", Value: "{brandHeader}"},
+				{Key: "sec-ch-ua-mobile", Value: "{mobile}"},
+				{Key: "user-agent", Value: "{userAgent}"},
+				{Key: "sec-ch-ua-platform", Value: "{platform}"},
+				{Key: "accept", Value: "*/*"},
+				{Key: "sec-gpc", Value: "{secGPC}"},
+				{Key: "sec-fetch-site", Value: "same-origin"},
+				{Key: "sec-fetch-mode", Value: "no-cors"},
+				{Key: "sec-fetch-dest", Value: "script"},
+				{Key: "referer", Value: ""},
+				{Key: "accept-encoding", Value: "{acceptEncoding}"},
+				{Key: "accept-language", Value: "{acceptLanguage}"},
+				{Key: "cookie", Value: ""},
+				{Key: "priority", Value: "u=1", MinMajorVersion: 124},
+			},
+		},
+		BrowserFamilyFirefox: {
+			RequestTypeNavigation: {
+				{Key: "user-agent", Value: "{userAgent}"},
+				{Key: "accept", Value: "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,*/*;q=0.8"},
+				{Key: "accept-language", Value: "{acceptLanguage}"},
+				{Key: "accept-encoding", Value: "{acceptEncoding}"},
+				{Key: "referer", Value: ""},
+				{Key: "cookie", Value: ""},
+				{Key: "upgrade-insecure-requests", Value: "1"},
+				{Key: "sec-fetch-dest", Value: "document"},
+				{Key: "sec-fetch-mode", Value: "navigate"},
+				{Key: "sec-fetch-site", Value: "none"},
+				{Key: "sec-fetch-user", Value: "?1"},
+				{Key: "priority", Value: "u=0, i", MinMajorVersion: 128},
+				{Key: "te", Value: "trailers"},
+			},
+			RequestTypeFetch: {
+				{Key: "user-agent", Value: "{userAgent}"},
+				{Key: "accept", Value: "*/*"},
+				{Key: "accept-language", Value: "{acceptLanguage}"},
+				{Key: "accept-encoding", Value: "{acceptEncoding}"},
+				{Key: "referer", Value: ""},
+				{Key: "content-type", Value: ""},
+				{Key: "content-length", Value: ""},
+				{Key: "origin", Value: ""},
+				{Key: "cookie", Value: ""},
+				{Key: "sec-fetch-dest", Value: "empty"},
+				{Key: "sec-fetch-mode", Value: "cors"},
+				{Key: "sec-fetch-site", Value: "same-origin"},
+				{Key: "priority", Value: "u=4", MinMajorVersion: 128},
+				{Key: "te", Value: "trailers"},
+			},
+			RequestTypeImage: {
+				{Key: "user-agent", Value: "{userAgent}"},
+				{Key: "accept", Value: "image/avif,image/webp,*/*"},
+				{Key: "accept-language", Value: "{acceptLanguage}"},
+				{Key: "accept-encoding", Value: "{acceptEncoding}"},
+				{Key: "referer", Value: ""},
+				{Key: "cookie", Value: ""},
+				{Key: "sec-fetch-dest", Value: "image"},
+				{Key: "sec-fetch-mode", Value: "no-cors"},
+				{Key: "sec-fetch-site", Value: "same-origin"},
+				{Key: "priority", Value: "u=5, i", MinMajorVersion: 128},
+				{Key: "te", Value: "trailers"},
+			},
+			RequestTypeScript: {
+				{Key: "user-agent", Value: "{userAgent}"},
+				{Key: "accept", Value: "*/*"},
+				{Key: "accept-language", Value: "{acceptLanguage}"},
+				{Key: "accept-encoding", Value: "{acceptEncoding}"},
+				{Key: "referer", Value: ""},
+				{Key: "cookie", Value: ""},
+				{Key: "sec-fetch-dest", Value: "script"},
+				{Key: "sec-fetch-mode", Value: "no-cors"},
+				{Key: "sec-fetch-site", Value: "same-origin"},
+				{Key: "priority", Value: "u=2", MinMajorVersion: 128},
+				{Key: "te", Value: "trailers"},
+			},
+		},
+		BrowserFamilySafari: {
+			RequestTypeNavigation: {
+				{Key: "accept", Value: "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"},
+				{Key: "sec-fetch-site", Value: "none"},
+				{Key: "cookie", Value: ""},
+				{Key: "sec-fetch-dest", Value: "document"},
+				{Key: "accept-language", Value: "{acceptLanguage}"},
+				{Key: "sec-fetch-mode", Value: "navigate"},
+				{Key: "user-agent", Value: "{userAgent}"},
+				{Key: "referer", Value: ""},
+				{Key: "accept-encoding", Value: "{acceptEncoding}"},
+				{Key: "priority", Value: "u=0, i", MinMajorVersion: 18},
+			},
+			RequestTypeFetch: {
+				{Key: "content-type", Value: ""},
+				{Key: "accept", Value: "*/*"},
+				{Key: "sec-fetch-site", Value: "same-origin"},
+				{Key: "origin", Value: ""},
+				{Key: "cookie", Value: ""},
+				{Key: "content-length", Value: ""},
+				{Key: "sec-fetch-dest", Value: "empty"},
+				{Key: "accept-language", Value: "{acceptLanguage}"},
+				{Key: "sec-fetch-mode", Value: "cors"},
+				{Key: "user-agent", Value: "{userAgent}"},
+				{Key: "referer", Value: ""},
+				{Key: "accept-encoding", Value: "{acceptEncoding}"},
+				{Key: "priority", Value: "u=3, i", MinMajorVersion: 18},
+			},
+			RequestTypeImage: {
+				{Key: "accept", Value: "image/webp,image/avif,image/jxl,image/heic,image/heic-sequence,video/*;q=0.8,image/png,image/svg+xml,image/*;q=0.8,*/*;q=0.5"},
+				{Key: "sec-fetch-site", Value: "same-origin"},
+				{Key: "cookie", Value: ""},
+				{Key: "sec-fetch-dest", Value: "image"},
+				{Key: "accept-language", Value: "{acceptLanguage}"},
+				{Key: "sec-fetch-mode", Value: "no-cors"},
+				{Key: "user-agent", Value: "{userAgent}"},
+				{Key: "referer", Value: ""},
+				{Key: "accept-encoding", Value: "{acceptEncoding}"},
+				{Key: "priority", Value: "u=5, i", MinMajorVersion: 18},
+			},
+			RequestTypeScript: {
+				{Key: "accept", Value: "*/*"},
+				{Key: "sec-fetch-site", Value: "same-origin"},
+				{Key: "cookie", Value: ""},
+				{Key: "sec-fetch-dest", Value: "script"},
+				{Key: "accept-language", Value: "{acceptLanguage}"},
+				{Key: "sec-fetch-mode", Value: "no-cors"},
+				{Key: "user-agent", Value: "{userAgent}"},
+				{Key: "referer", Value: ""},
+				{Key: "accept-encoding", Value: "{acceptEncoding}"},
+				{Key: "priority", Value: "u=2", MinMajorVersion: 18},
+			},
+		},
+	}
+)
+
+// GetFamily Returns the engine family of the browser, derived from the User-Agent with the name as fallback
+func (b *Browser) GetFamily() string {
+	switch {
+	case strings.Contains(b.UserAgent, "Firefox/") || strings.Contains(b.UserAgent, "FxiOS/"):
+		return BrowserFamilyFirefox
+	case strings.Contains(b.UserAgent, "Chrome/") || strings.Contains(b.UserAgent, "CriOS/"):
+		return BrowserFamilyChromium
+	case strings.Contains(b.UserAgent, "Version/") && strings.Contains(b.UserAgent, "Safari/"):
+		return BrowserFamilySafari
+	}
+
+	switch strings.ToLower(b.Name) {
+	case BrowserFamilyFirefox:
+		return BrowserFamilyFirefox
+	case BrowserFamilySafari:
+		return BrowserFamilySafari
+	}
+	return BrowserFamilyChromium
+}
+
+// GetMajorVersion Returns the major version of the engine from the User-Agent, so Chrome's version for Brave
+func (b *Browser) GetMajorVersion() int {
+	matches := majorVersionRegexes[b.GetFamily()].FindStringSubmatch(b.UserAgent)
+	if len(matches) < 2 {
+		return 0
+	}
+	return mustInt(matches[1])
+}
+
+// GetHeaderTemplate Returns the header template of the browser for the request type
+func (b *Browser) GetHeaderTemplate(requestType RequestType) []*HeaderTemplateEntry {
+	return HeaderTemplates[b.GetFamily()][requestType]
+}
+
+// RenderHeaders Renders the header template for the request type, headers in extra replace template values or are appended sorted if the template has no slot for them
+func (b *Browser) RenderHeaders(requestType RequestType, extra ...http.Header) OrderedHeaders {
+	overrides := map[string][]string{}
+	for _, headers := range extra {
+		for key, values := range headers {
+			overrides[strings.ToLower(key)] = values
+		}
+	}
+
+	placeholders := b.headerPlaceholders()
+	majorVersion := b.GetMajorVersion()
+	result := OrderedHeaders{}
+	for _, entry := range b.GetHeaderTemplate(requestType) {
+		if values, ok := overrides[entry.Key]; ok {
+			for _, value := range values {
+				result = append(result, [2]string{entry.Key, value})
+			}
+			delete(overrides, entry.Key)
+			continue
+		}
+		if entry.MinMajorVersion > majorVersion {
+			continue
+		}
+
+		value := entry.Value
+		if strings.HasPrefix(value, "{") {
+			value = placeholders[value]
+		}
+		// Empty values are slots for headers the caller has to supply, like cookie and referer
+		if value == "" {
+			continue
+		}
+		result = append(result, [2]string{entry.Key, value})
+	}
+
+	remaining := make([]string, 0, len(overrides))
+	for key := range overrides {
+		remaining = append(remaining, key)
+	}
+	sort.Strings(remaining)
+	for _, key := range remaining {
+		for _, value := range overrides[key] {
+			result = append(result, [2]string{key, value})
+		}
+	}
+	return result
+}
+
+func (b *Browser) headerPlaceholders() map[string]string {
+	family := b.GetFamily()
+	majorVersion := b.GetMajorVersion()
+	result := map[string]string{
+		"{userAgent}":      b.UserAgent,
+		"{acceptLanguage}": b.AcceptLanguage(),
+		"{acceptEncoding}": "gzip, deflate, br",
+		"{brandHeader}":    "",
+		"{mobile}":         "",
+		"{platform}":       "",
+		"{secGPC}":         "",
+	}
+
+	switch family {
+	case BrowserFamilyChromium:
+		brandHeader := b.BrandHeader
+		if brandHeader == "" {
+			brandHeader = GenerateBrandHeader("Google Chrome", majorVersion)
+		}
+		result["{brandHeader}"] = brandHeader
+		result["{mobile}"] = "?0"
+		if strings.Contains(b.UserAgent, "Mobile") {
+			result["{mobile}"] = "?1"
+		}
+		result["{platform}"] = strconv.Quote(clientHintsPlatform(b.UserAgent))
+		if majorVersion >= 123 {
+			result["{acceptEncoding}"] = "gzip, deflate, br, zstd"
+		}
+		if strings.EqualFold(b.Name, "brave") {
+			result["{secGPC}"] = "1"
+		}
+		break
+	case BrowserFamilyFirefox:
+		if majorVersion >= 126 {
+			result["{acceptEncoding}"] = "gzip, deflate, br, zstd"
+		}
+		break
+	}
+	return result
+}
+
+// AcceptLanguage Formats the languages of the browser the way its family weighs them in Accept-Language
+func (b *Browser) AcceptLanguage() string {
+	languages := b.Languages
+	if len(languages) == 0 && b.Language != "" {
+		languages = []string{b.Language}
+	}
+	if len(languages) == 0 {
+		languages = []string{"en-US", "en"}
+	}
+	return FormatAcceptLanguage(b.GetFamily(), languages)
+}
+
+// FormatAcceptLanguage Formats the languages in order of preference as Accept-Language with the q-values a browser of the
+// family gives them, see PreferredLanguages for the languages themselves
+func FormatAcceptLanguage(family string, languages []string) string {
+	result := make([]string, len(languages))
+	for i, language := range languages {
+		if i == 0 {
+			result[i] = language
+			continue
+		}
+
+		q := ""
+		if family == BrowserFamilyFirefox {
+			// Firefox spreads the weights evenly over the list, with 2 decimals once there are more than 10 languages
+			precision := 1
+			if len(languages) > 10 {
+				precision = 2
+			}
+			q = strconv.FormatFloat(1-float64(i)/float64(len(languages)), 'f', precision, 64)
+		} else {
+			// Chromium lowers the weight by 0.1 per language down to 0.1
+			weight := 10 - i
+			if weight < 1 {
+				weight = 1
+			}
+			q = strconv.FormatFloat(float64(weight)/10, 'f', -1, 64)
+		}
+		result[i] = fmt.Sprintf("%s;q=%s", language, q)
+	}
+	return strings.Join(result, ",")
+}
+
+// clientHintsPlatform Maps a User-Agent to the platform name sent in sec-ch-ua-platform
+func clientHintsPlatform(userAgent string) string {
+	switch {
+	case strings.Contains(userAgent, "Android"):
+		return "Android"
+	case strings.Contains(userAgent, "Windows"):
+		return "Windows"
+	case strings.Contains(userAgent, "CrOS"):
+		return "Chrome OS"
+	case strings.Contains(userAgent, "iPhone") || strings.Contains(userAgent, "iPad"):
+		return "iOS"
+	case strings.Contains(userAgent, "Macintosh"):
+		return "macOS"
+	case strings.Contains(userAgent, "Linux"):
+		return "Linux"
+	}
+	return "Unknown"
+}
+
+// Header Returns the headers as an unordered http.Header
+func (h OrderedHeaders) Header() http.Header {
+	result := make(http.Header, len(h))
+	for _, header := range h {
+		result.Add(header[0], header[1])
+	}
+	return result
+}
+
+// Order Returns the header names in order, without duplicates, fit for Browser_HTTPFingerprint.HeaderOrder
+func (h OrderedHeaders) Order() []string {
+	result := make([]string, 0, len(h))
+	for _, header := range h {
+		if _, ok := strInSlice(result, header[0]); !ok {
+			result = append(result, header[0])
+		}
+	}
+	return result
+}
@@ -0,0 +1,171 @@
+package device_utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// src: https://datatracker.ietf.org/doc/html/rfc9113#section-6.5.2
+	SettingHeaderTableSize      uint32 = 0x1
+	SettingEnablePush           uint32 = 0x2
+	SettingMaxConcurrentStreams uint32 = 0x3
+	SettingInitialWindowSize    uint32 = 0x4
+	SettingMaxFrameSize         uint32 = 0x5
+	SettingMaxHeaderListSize    uint32 = 0x6
+	// src: https://datatracker.ietf.org/doc/html/rfc8441#section-3
+	SettingEnableConnectProtocol uint32 = 0x8
+	// src: https://datatracker.ietf.org/doc/html/rfc9218#section-2.1
+	SettingNoRFC7540Priorities uint32 = 0x9
+)
+
+var akamaiPseudoHeaders = map[string]string{
+	":method":    "m",
+	":path":      "p",
+	":authority": "a",
+	":scheme":    "s",
+}
+
+// SettingsFromLegacy Converts the fixed SETTINGS fields into an ordered list, ascending by ID and skipping the ones set to -1
+func SettingsFromLegacy(settingsFrame *Browser_HTTPFingerprint_SettingsFrameOpts) []*Browser_HTTPFingerprint_Setting {
+	if settingsFrame == nil {
+		return []*Browser_HTTPFingerprint_Setting{}
+	}
+
+	values := []int64{
+		settingsFrame.HeaderTableSize,
+		settingsFrame.EnablePush,
+		settingsFrame.MaxConcurrentStreams,
+		settingsFrame.InitialWindowSize,
+		settingsFrame.MaxFrameSize,
+		settingsFrame.MaxHeaderListSize,
+	}
+	result := make([]*Browser_HTTPFingerprint_Setting, 0, len(values))
+	for i, value := range values {
+		if value < 0 {
+			continue
+		}
+		result = append(result, &Browser_HTTPFingerprint_Setting{Id: uint32(i + 1), Value: uint32(value)})
+	}
+	return result
+}
+
+// LegacyFromSettings Converts an ordered list into the fixed SETTINGS fields, the order and parameters beyond the classic six are lost
+func LegacyFromSettings(settings []*Browser_HTTPFingerprint_Setting) *Browser_HTTPFingerprint_SettingsFrameOpts {
+	// -1 assumes not present
+	result := &Browser_HTTPFingerprint_SettingsFrameOpts{
+		HeaderTableSize:      -1,
+		EnablePush:           -1,
+		MaxConcurrentStreams: -1,
+		InitialWindowSize:    -1,
+		MaxFrameSize:         -1,
+		MaxHeaderListSize:    -1,
+	}
+	for _, setting := range settings {
+		value := int64(setting.Value)
+		switch setting.Id {
+		case SettingHeaderTableSize:
+			result.HeaderTableSize = value
+			break
+		case SettingEnablePush:
+			result.EnablePush = value
+			break
+		case SettingMaxConcurrentStreams:
+			result.MaxConcurrentStreams = value
+			break
+		case SettingInitialWindowSize:
+			result.InitialWindowSize = value
+			break
+		case SettingMaxFrameSize:
+			result.MaxFrameSize = value
+			break
+		case SettingMaxHeaderListSize:
+			result.MaxHeaderListSize = value
+			break
+		}
+	}
+	return result
+}
+
+// OrderedSettings Returns the SETTINGS parameters in the order they are sent, profiles without the ordered list fall back to the fixed fields
+func (fp *Browser_HTTPFingerprint) OrderedSettings() []*Browser_HTTPFingerprint_Setting {
+	if len(fp.GetSettings()) > 0 {
+		return fp.Settings
+	}
+	return SettingsFromLegacy(fp.GetSettingsFrame())
+}
+
+// SetOrderedSettings Stores the SETTINGS parameters in order and mirrors them into the fixed fields
+func (fp *Browser_HTTPFingerprint) SetOrderedSettings(settings []*Browser_HTTPFingerprint_Setting) {
+	fp.Settings = settings
+	fp.SettingsFrame = LegacyFromSettings(settings)
+}
+
+// SetSetting Changes the value of a SETTINGS parameter, a new one is inserted in front of the first higher ID
+func (fp *Browser_HTTPFingerprint) SetSetting(id, value uint32) {
+	settings := fp.OrderedSettings()
+	index := len(settings)
+	for i, setting := range settings {
+		if setting.Id == id {
+			setting.Value = value
+			fp.SetOrderedSettings(settings)
+			return
+		}
+		if setting.Id > id && index == len(settings) {
+			index = i
+		}
+	}
+	settings = append(settings[:index], append([]*Browser_HTTPFingerprint_Setting{{Id: id, Value: value}}, settings[index:]...)...)
+	fp.SetOrderedSettings(settings)
+}
+
+// RemoveSetting Removes a SETTINGS parameter
+func (fp *Browser_HTTPFingerprint) RemoveSetting(id uint32) {
+	settings := fp.OrderedSettings()
+	for i, setting := range settings {
+		if setting.Id == id {
+			settings = append(settings[:i], settings[i+1:]...)
+			break
+		}
+	}
+	fp.SetOrderedSettings(settings)
+}
+
+// FormatAkamaiFingerprint Formats the fingerprint as SETTINGS|WINDOW_UPDATE|PRIORITY|pseudo header order
+// src: https://www.blackhat.com/docs/eu-17/materials/eu-17-Shuster-Passive-Fingerprinting-Of-HTTP2-Clients-wp.pdf
+func (fp *Browser_HTTPFingerprint) FormatAkamaiFingerprint() string {
+	settings := []string{}
+	for _, setting := range fp.OrderedSettings() {
+		settings = append(settings, fmt.Sprintf("%d:%d", setting.Id, setting.Value))
+	}
+
+	priorityFrames := []string{}
+	for _, priorityFrame := range fp.GetPriorityFrames() {
+		exclusive := 0
+		if priorityFrame.Exclusive {
+			exclusive = 1
+		}
+		priorityFrames = append(priorityFrames, fmt.Sprintf("%d:%d:%d:%d", priorityFrame.StreamId, exclusive, priorityFrame.StreamDep, priorityFrame.Weight))
+	}
+	if len(priorityFrames) == 0 {
+		priorityFrames = append(priorityFrames, "0")
+	}
+
+	pseudoHeaders := []string{}
+	for _, pseudoHeader := range fp.GetPseudoHeaderOrder() {
+		pseudoHeaders = append(pseudoHeaders, akamaiPseudoHeaders[pseudoHeader])
+	}
+
+	// Akamai writes 00 when there is no WINDOW_UPDATE frame
+	windowUpdate := "00"
+	if fp.GetWindowUpdateIncrement() > 0 {
+		windowUpdate = mustString(int(fp.WindowUpdateIncrement))
+	}
+
+	return strings.Join([]string{
+		strings.Join(settings, ","),
+		windowUpdate,
+		strings.Join(priorityFrames, ","),
+		strings.Join(pseudoHeaders, ","),
+	}, "|")
+}
@@ -0,0 +1,109 @@
+package device_utils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrImportMalformed = errors.New("the supplied value is malformed")
+	ErrImportUnknown   = errors.New("the supplied value is unknown")
+	ErrImportMissing   = errors.New("the supplied value is missing")
+)
+
+// FieldError Is a single problem found while importing a fingerprint, Path points at the offending field in the source JSON
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Err.Error())
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ImportError Collects every problem found while importing a fingerprint
+type ImportError struct {
+	Errors []*FieldError
+}
+
+func (e *ImportError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		messages[i] = fieldErr.Error()
+	}
+	return fmt.Sprintf("%d import errors: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+func (e *ImportError) Unwrap() []error {
+	result := make([]error, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		result[i] = fieldErr
+	}
+	return result
+}
+
+// importCollector Gathers field errors during an import, in lenient mode unknown data is skipped instead of reported
+type importCollector struct {
+	lenient bool
+	errors  []*FieldError
+}
+
+func newImportCollector(lenient ...bool) *importCollector {
+	return &importCollector{lenient: len(lenient) > 0 && lenient[0]}
+}
+
+func (c *importCollector) add(path string, err error) {
+	if c.lenient && errors.Is(err, ErrImportUnknown) {
+		return
+	}
+	c.errors = append(c.errors, &FieldError{Path: path, Err: err})
+}
+
+func (c *importCollector) err() error {
+	if len(c.errors) == 0 {
+		return nil
+	}
+	return &ImportError{Errors: c.errors}
+}
+
+func parseUint(in string) (uint64, error) {
+	result, err := strconv.ParseUint(in, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not an unsigned integer", ErrImportMalformed, in)
+	}
+	return result, nil
+}
+
+func parseInt(in string) (int64, error) {
+	result, err := strconv.ParseInt(in, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not an integer", ErrImportMalformed, in)
+	}
+	return result, nil
+}
+
+// parseUintBetween Parses the number between the last pair of parentheses, like in "X25519 (29)" or "EndHeaders (0x4)"
+func parseUintBetween(in string) (uint64, error) {
+	openingIndex := strings.LastIndex(in, "(")
+	closingIndex := strings.LastIndex(in, ")")
+
+	if openingIndex == -1 || closingIndex < openingIndex {
+		return 0, fmt.Errorf("%w: %q has no parenthesized number", ErrImportMalformed, in)
+	}
+
+	number := in[openingIndex+1 : closingIndex]
+	if strings.HasPrefix(number, "0x") {
+		result, err := strconv.ParseUint(number[2:], 16, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q is not a hex number", ErrImportMalformed, number)
+		}
+		return result, nil
+	}
+	return parseUint(number)
+}
@@ -1,10 +1,13 @@
 package device_utils
 
 import (
+	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"strconv"
 	"strings"
+
+	"google.golang.org/protobuf/proto"
 )
 
 type PeetResponse struct {
@@ -107,37 +110,90 @@ type TCP struct {
 	Window   int64 `json:"window"`
 }
 
+// MustUint Parses an unsigned integer, panics on failure
+// Deprecated: the importers no longer use this, hostile input should never be able to panic
 func MustUint(in string) uint64 {
-	result, err := strconv.ParseUint(in, 10, 64)
+	result, err := parseUint(in)
 	if err != nil {
 		panic(err)
 	}
 	return result
 }
 
+// MustInt Parses an integer, panics on failure
+// Deprecated: the importers no longer use this, hostile input should never be able to panic
 func MustInt(in string) int64 {
-	result, err := strconv.ParseInt(in, 10, 64)
+	result, err := parseInt(in)
 	if err != nil {
 		panic(err)
 	}
 	return result
 }
 
+// MustUintBetween Parses the number between the last pair of parentheses, panics on failure
+// Deprecated: the importers no longer use this, hostile input should never be able to panic
 func MustUintBetween(in string) uint64 {
-	openingIndex := strings.LastIndex(in, "(")
-	closingIndex := strings.LastIndex(in, ")")
+	result, err := parseUintBetween(in)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
 
-	if openingIndex == -1 || closingIndex == -1 {
-		panic(fmt.Errorf("parentheses not found"))
+var (
+	peetSignatureSchemes = map[string]Browser_TLSFingerprint_SignatureScheme{
+		"rsa_pkcs1_sha256":       Browser_TLSFingerprint_SignatureScheme(0x0401),
+		"rsa_pkcs1_sha384":       Browser_TLSFingerprint_SignatureScheme(0x0501),
+		"rsa_pkcs1_sha512":       Browser_TLSFingerprint_SignatureScheme(0x0601),
+		"ecdsa_secp256r1_sha256": Browser_TLSFingerprint_SignatureScheme(0x0403),
+		"ecdsa_secp384r1_sha384": Browser_TLSFingerprint_SignatureScheme(0x0503),
+		"ecdsa_secp521r1_sha512": Browser_TLSFingerprint_SignatureScheme(0x0603),
+		"rsa_pss_rsae_sha256":    Browser_TLSFingerprint_SignatureScheme(0x0804),
+		"rsa_pss_rsae_sha384":    Browser_TLSFingerprint_SignatureScheme(0x0805),
+		"rsa_pss_rsae_sha512":    Browser_TLSFingerprint_SignatureScheme(0x0806),
+		"ed25519":                Browser_TLSFingerprint_SignatureScheme(0x0807),
+		"ed448":                  Browser_TLSFingerprint_SignatureScheme(0x0808),
+		"rsa_pss_pss_sha256":     Browser_TLSFingerprint_SignatureScheme(0x0809),
+		"rsa_pss_pss_sha384":     Browser_TLSFingerprint_SignatureScheme(0x080a),
+		"rsa_pss_pss_sha512":     Browser_TLSFingerprint_SignatureScheme(0x080b),
+		"rsa_pkcs1_sha1":         Browser_TLSFingerprint_SignatureScheme(0x0201),
+		"ecdsa_sha1":             Browser_TLSFingerprint_SignatureScheme(0x0203),
+	}
+	peetProtocolVersions = map[string]Browser_TLSFingerprint_ProtocolVersion{
+		"TLS 1.3": Browser_TLSFingerprint_TLS1_3,
+		"TLS 1.2": Browser_TLSFingerprint_TLS1_2,
+		"TLS 1.1": Browser_TLSFingerprint_TLS1_1,
+		"TLS 1.0": Browser_TLSFingerprint_TLS1,
+	}
+	peetPseudoHeaders = map[string]string{
+		"m": ":method",
+		"p": ":path",
+		"a": ":authority",
+		"s": ":scheme",
 	}
+)
+
+// FromPEET Imports and normalizes output from https://tls.peet.ws/api/all
+// Every problem is collected into an *ImportError, lenient skips data that is well-formed but unknown instead of reporting it
+// The fingerprints are only replaced when the import succeeds
+func (b *Browser) FromPEET(response *PeetResponse, lenient ...bool) error {
+	collector := newImportCollector(lenient...)
+	tlsFingerprint := peetTLSFingerprint(collector, &response.TLS)
+	httpFingerprint := peetHTTPFingerprint(collector, response.Http2)
 
-	numberStr := in[openingIndex+1 : closingIndex]
-	return MustUint(numberStr)
+	err := collector.err()
+	if err != nil {
+		return err
+	}
+	b.TlsFingerprint = tlsFingerprint
+	if httpFingerprint != nil {
+		b.HttpFingerprint = httpFingerprint
+	}
+	return nil
 }
 
-// FromPEET Imports and normalizes output from https://tls.peet.ws/api/all
-func (b *Browser) FromPEET(response *PeetResponse) error {
-	b.TlsFingerprint = &Browser_TLSFingerprint{
+func peetTLSFingerprint(collector *importCollector, response *TLS) *Browser_TLSFingerprint {
+	result := &Browser_TLSFingerprint{
 		CipherSuites:              make([]Browser_TLSFingerprint_CipherSuite, 0),
 		Extensions:                make([]Browser_TLSFingerprint_Extension, 0),
 		EllipticCurves:            make([]Browser_TLSFingerprint_EllipticCurve, 0),
@@ -145,55 +201,115 @@ func (b *Browser) FromPEET(response *PeetResponse) error {
 		ExtensionData:             make([]*Browser_TLSFingerprint_ExtensionData, 0),
 	}
 	// Set JA3 stuff
-	for sectionIdx, section := range strings.Split(response.TLS.Ja3, ",") {
+	if response.Ja3 == "" {
+		collector.add("tls.ja3", ErrImportMissing)
+	}
+	for sectionIdx, section := range strings.Split(response.Ja3, ",") {
+		if section == "" {
+			// Empty sections are valid, a client may not send curves for example
+			continue
+		}
+		elements := strings.Split(section, "-")
+		values := make([]uint64, 0, len(elements))
+		for elementIdx, element := range elements {
+			value, err := parseUint(element)
+			if err != nil {
+				collector.add(fmt.Sprintf("tls.ja3[%d][%d]", sectionIdx, elementIdx), err)
+				continue
+			}
+			values = append(values, value)
+		}
+
 		switch sectionIdx {
 		case 0:
 			// TLS version
-			b.TlsFingerprint.Version = Browser_TLSFingerprint_ProtocolVersion(MustUint(section))
+			if len(values) == 1 {
+				result.Version = Browser_TLSFingerprint_ProtocolVersion(values[0])
+			}
 			break
 		case 1:
 			// Ciphers
-			for _, element := range strings.Split(section, "-") {
-				b.TlsFingerprint.CipherSuites = append(b.TlsFingerprint.CipherSuites, Browser_TLSFingerprint_CipherSuite(MustUint(element)))
+			for _, value := range values {
+				result.CipherSuites = append(result.CipherSuites, Browser_TLSFingerprint_CipherSuite(value))
 			}
 			break
 		case 2:
 			// Extensions
-			for _, element := range strings.Split(section, "-") {
-				b.TlsFingerprint.Extensions = append(b.TlsFingerprint.Extensions, Browser_TLSFingerprint_Extension(MustUint(element)))
+			for _, value := range values {
+				result.Extensions = append(result.Extensions, Browser_TLSFingerprint_Extension(value))
 			}
 			break
 		case 3:
 			// Elliptic Curves
-			for _, element := range strings.Split(section, "-") {
-				b.TlsFingerprint.EllipticCurves = append(b.TlsFingerprint.EllipticCurves, Browser_TLSFingerprint_EllipticCurve(MustUint(element)))
+			for _, value := range values {
+				result.EllipticCurves = append(result.EllipticCurves, Browser_TLSFingerprint_EllipticCurve(value))
 			}
 			break
 		case 4:
 			// Elliptic Curve Point Formats
-			for _, element := range strings.Split(section, "-") {
-				b.TlsFingerprint.EllipticCurvePointFormats = append(b.TlsFingerprint.EllipticCurvePointFormats, Browser_TLSFingerprint_EllipticCurvePointFormat(MustUint(element)))
+			for _, value := range values {
+				result.EllipticCurvePointFormats = append(result.EllipticCurvePointFormats, Browser_TLSFingerprint_EllipticCurvePointFormat(value))
 			}
 			break
+		default:
+			collector.add(fmt.Sprintf("tls.ja3[%d]", sectionIdx), ErrImportUnknown)
+			break
 		}
 	}
 
 	// Set extension data
-	for _, extensionRawData := range response.TLS.Extensions {
+	for extensionIdx, extensionRawData := range response.Extensions {
+		path := fmt.Sprintf("tls.extensions[%d]", extensionIdx)
 		if strings.HasPrefix(extensionRawData.Name, "TLS_GREASE") {
 			continue
 		}
-		extensionId := MustUintBetween(extensionRawData.Name)
+		extensionId, err := parseUintBetween(extensionRawData.Name)
+		if err != nil {
+			collector.add(path+".name", err)
+			continue
+		}
 
 		switch extensionId {
+		case 0, 11, 18, 23, 41:
+			// server_name and pre_shared_key only carry connection specific data, the others are empty or covered by JA3
+			break
+		case 5:
+			// OCSP status request
+			if extensionRawData.StatusRequest == nil {
+				collector.add(path+".status_request", ErrImportMissing)
+				continue
+			}
+			statusType, err := parseUintBetween(extensionRawData.StatusRequest.CertificateStatusType)
+			if err != nil {
+				collector.add(path+".status_request.certificate_status_type", err)
+				continue
+			}
+			extensionData := &Browser_TLSFingerprint_ExtensionData{ExtensionId: Browser_TLSFingerprint_Extension(extensionId)}
+			extensionData.StatusRequest = &Browser_TLSFingerprint_ExtensionData_StatusRequest{
+				StatusType:              uint32(statusType),
+				ResponderIdListLength:   uint32(extensionRawData.StatusRequest.ResponderIDListLength),
+				RequestExtensionsLength: uint32(extensionRawData.StatusRequest.RequestExtensionsLength),
+			}
+			result.ExtensionData = append(result.ExtensionData, extensionData)
+			break
 		case 10:
 			// Supported groups
-			// extensionData := &Browser_TLSFingerprint_ExtensionData{ExtensionId: Browser_TLSFingerprint_Extension(extensionId)}
-			// for _, e := range extensionRawData.SupportedGroups {
-			// 	if strings.HasPrefix(e, "TLS_GREASE") {
-			// 		continue
-			// 	}
-			// }
+			extensionData := &Browser_TLSFingerprint_ExtensionData{ExtensionId: Browser_TLSFingerprint_Extension(extensionId)}
+			extensionData.SupportedGroups = &Browser_TLSFingerprint_ExtensionData_SupportedGroups{
+				Groups: []Browser_TLSFingerprint_EllipticCurve{},
+			}
+			for i, e := range extensionRawData.SupportedGroups {
+				if strings.HasPrefix(e, "TLS_GREASE") {
+					continue
+				}
+				group, err := parseUintBetween(e)
+				if err != nil {
+					collector.add(fmt.Sprintf("%s.supported_groups[%d]", path, i), err)
+					continue
+				}
+				extensionData.SupportedGroups.Groups = append(extensionData.SupportedGroups.Groups, Browser_TLSFingerprint_EllipticCurve(group))
+			}
+			result.ExtensionData = append(result.ExtensionData, extensionData)
 			break
 		case 13:
 			// Signature Algos
@@ -201,38 +317,36 @@ func (b *Browser) FromPEET(response *PeetResponse) error {
 			extensionData.SignatureAlgorithms = &Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms{
 				SupportedSignatureAlgorithms: []Browser_TLSFingerprint_SignatureScheme{},
 			}
-			versionMap := map[string]Browser_TLSFingerprint_SignatureScheme{
-				"rsa_pkcs1_sha256":       Browser_TLSFingerprint_SignatureScheme(0x0401),
-				"rsa_pkcs1_sha384":       Browser_TLSFingerprint_SignatureScheme(0x0501),
-				"rsa_pkcs1_sha512":       Browser_TLSFingerprint_SignatureScheme(0x0601),
-				"ecdsa_secp256r1_sha256": Browser_TLSFingerprint_SignatureScheme(0x0403),
-				"ecdsa_secp384r1_sha384": Browser_TLSFingerprint_SignatureScheme(0x0503),
-				"ecdsa_secp521r1_sha512": Browser_TLSFingerprint_SignatureScheme(0x0603),
-				"rsa_pss_rsae_sha256":    Browser_TLSFingerprint_SignatureScheme(0x0804),
-				"rsa_pss_rsae_sha384":    Browser_TLSFingerprint_SignatureScheme(0x0805),
-				"rsa_pss_rsae_sha512":    Browser_TLSFingerprint_SignatureScheme(0x0806),
-				"ed25519":                Browser_TLSFingerprint_SignatureScheme(0x0807),
-				"ed448":                  Browser_TLSFingerprint_SignatureScheme(0x0808),
-				"rsa_pss_pss_sha256":     Browser_TLSFingerprint_SignatureScheme(0x0809),
-				"rsa_pss_pss_sha384":     Browser_TLSFingerprint_SignatureScheme(0x080a),
-				"rsa_pss_pss_sha512":     Browser_TLSFingerprint_SignatureScheme(0x080b),
-				"rsa_pkcs1_sha1":         Browser_TLSFingerprint_SignatureScheme(0x0201),
-				"ecdsa_sha1":             Browser_TLSFingerprint_SignatureScheme(0x0203),
-			}
-			for _, e := range extensionRawData.SignatureAlgorithms {
-				eResult, ok := versionMap[e]
-				if ok {
-					extensionData.SignatureAlgorithms.SupportedSignatureAlgorithms = append(extensionData.SignatureAlgorithms.SupportedSignatureAlgorithms, eResult)
+			for i, e := range extensionRawData.SignatureAlgorithms {
+				eResult, ok := peetSignatureSchemes[e]
+				if !ok {
+					collector.add(fmt.Sprintf("%s.signature_algorithms[%d]", path, i), fmt.Errorf("%w: %q", ErrImportUnknown, e))
+					continue
 				}
+				extensionData.SignatureAlgorithms.SupportedSignatureAlgorithms = append(extensionData.SignatureAlgorithms.SupportedSignatureAlgorithms, eResult)
+			}
+			result.ExtensionData = append(result.ExtensionData, extensionData)
+			break
+		case 21:
+			// Padding, only the length matters since the content is all zeroes
+			data, err := peetHexData(extensionRawData.Data)
+			if err != nil {
+				collector.add(path+".data", err)
+				continue
+			}
+			extensionData := &Browser_TLSFingerprint_ExtensionData{ExtensionId: Browser_TLSFingerprint_Extension(extensionId)}
+			extensionData.Padding = &Browser_TLSFingerprint_ExtensionData_Padding{
+				Length: uint32(len(data)),
 			}
-			b.TlsFingerprint.ExtensionData = append(b.TlsFingerprint.ExtensionData, extensionData)
+			result.ExtensionData = append(result.ExtensionData, extensionData)
+			break
 		case 16:
 			// ALPN
 			extensionData := &Browser_TLSFingerprint_ExtensionData{ExtensionId: Browser_TLSFingerprint_Extension(extensionId)}
 			extensionData.ApplicationLayerProtocolNegotiation = &Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation{
 				Protocols: extensionRawData.Protocols,
 			}
-			b.TlsFingerprint.ExtensionData = append(b.TlsFingerprint.ExtensionData, extensionData)
+			result.ExtensionData = append(result.ExtensionData, extensionData)
 			break
 		case 27:
 			// Compress Certificates
@@ -240,10 +354,61 @@ func (b *Browser) FromPEET(response *PeetResponse) error {
 			extensionData.CompressCertificate = &Browser_TLSFingerprint_ExtensionData_CompressCertificate{
 				Algorithms: []Browser_TLSFingerprint_ExtensionData_CompressCertificate_CertificateCompression{},
 			}
-			for _, algo := range extensionRawData.Algorithms {
-				extensionData.CompressCertificate.Algorithms = append(extensionData.CompressCertificate.Algorithms, Browser_TLSFingerprint_ExtensionData_CompressCertificate_CertificateCompression(MustUintBetween(algo)))
+			for i, algo := range extensionRawData.Algorithms {
+				algoId, err := parseUintBetween(algo)
+				if err != nil {
+					collector.add(fmt.Sprintf("%s.algorithms[%d]", path, i), err)
+					continue
+				}
+				extensionData.CompressCertificate.Algorithms = append(extensionData.CompressCertificate.Algorithms, Browser_TLSFingerprint_ExtensionData_CompressCertificate_CertificateCompression(algoId))
+			}
+			result.ExtensionData = append(result.ExtensionData, extensionData)
+			break
+		case 28:
+			// Record size limit
+			data, err := peetHexData(extensionRawData.Data)
+			if err != nil {
+				collector.add(path+".data", err)
+				continue
+			}
+			if len(data) != 2 {
+				collector.add(path+".data", fmt.Errorf("%w: record size limit has to be 2 bytes", ErrImportMalformed))
+				continue
+			}
+			extensionData := &Browser_TLSFingerprint_ExtensionData{ExtensionId: Browser_TLSFingerprint_Extension(extensionId)}
+			extensionData.RecordSizeLimit = &Browser_TLSFingerprint_ExtensionData_RecordSizeLimit{
+				Limit: uint32(binary.BigEndian.Uint16(data)),
+			}
+			result.ExtensionData = append(result.ExtensionData, extensionData)
+			break
+		case 34:
+			// Delegated credentials
+			extensionData := &Browser_TLSFingerprint_ExtensionData{ExtensionId: Browser_TLSFingerprint_Extension(extensionId)}
+			extensionData.DelegatedCredentials = &Browser_TLSFingerprint_ExtensionData_DelegatedCredentials{
+				SupportedSignatureAlgorithms: []Browser_TLSFingerprint_SignatureScheme{},
+			}
+			for i, e := range extensionRawData.SignatureHashAlgorithms {
+				eResult, ok := peetSignatureSchemes[e]
+				if !ok {
+					collector.add(fmt.Sprintf("%s.signature_hash_algorithms[%d]", path, i), fmt.Errorf("%w: %q", ErrImportUnknown, e))
+					continue
+				}
+				extensionData.DelegatedCredentials.SupportedSignatureAlgorithms = append(extensionData.DelegatedCredentials.SupportedSignatureAlgorithms, eResult)
+			}
+			result.ExtensionData = append(result.ExtensionData, extensionData)
+			break
+		case 35:
+			// Session ticket
+			data, err := peetHexData(extensionRawData.Data)
+			if err != nil {
+				collector.add(path+".data", err)
+				continue
+			}
+			extensionData := &Browser_TLSFingerprint_ExtensionData{ExtensionId: Browser_TLSFingerprint_Extension(extensionId)}
+			extensionData.SessionTicket = &Browser_TLSFingerprint_ExtensionData_SessionTicket{
+				Data: data,
 			}
-			b.TlsFingerprint.ExtensionData = append(b.TlsFingerprint.ExtensionData, extensionData)
+			result.ExtensionData = append(result.ExtensionData, extensionData)
 			break
 		case 43:
 			// Supported TLS versions
@@ -251,22 +416,18 @@ func (b *Browser) FromPEET(response *PeetResponse) error {
 			extensionData.SupportedVersions = &Browser_TLSFingerprint_ExtensionData_SupportedVersions{
 				Versions: []Browser_TLSFingerprint_ProtocolVersion{},
 			}
-			versionMap := map[string]Browser_TLSFingerprint_ProtocolVersion{
-				"TLS 1.3": Browser_TLSFingerprint_TLS1_3,
-				"TLS 1.2": Browser_TLSFingerprint_TLS1_2,
-				"TLS 1.1": Browser_TLSFingerprint_TLS1_1,
-				"TLS 1.0": Browser_TLSFingerprint_TLS1,
-			}
-			for _, e := range extensionRawData.Versions {
+			for i, e := range extensionRawData.Versions {
 				if strings.HasPrefix(e, "TLS_GREASE") {
 					continue
 				}
-				eResult, ok := versionMap[e]
-				if ok {
-					extensionData.SupportedVersions.Versions = append(extensionData.SupportedVersions.Versions, eResult)
+				eResult, ok := peetProtocolVersions[e]
+				if !ok {
+					collector.add(fmt.Sprintf("%s.versions[%d]", path, i), fmt.Errorf("%w: %q", ErrImportUnknown, e))
+					continue
 				}
+				extensionData.SupportedVersions.Versions = append(extensionData.SupportedVersions.Versions, eResult)
 			}
-			b.TlsFingerprint.ExtensionData = append(b.TlsFingerprint.ExtensionData, extensionData)
+			result.ExtensionData = append(result.ExtensionData, extensionData)
 			break
 		case 45:
 			// PSK exchange modes
@@ -275,8 +436,13 @@ func (b *Browser) FromPEET(response *PeetResponse) error {
 				Modes: []Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode{},
 			}
 			if extensionRawData.PSKKeyExchangeMode != nil {
-				extensionData.PskKeyExchangeModes.Modes = append(extensionData.PskKeyExchangeModes.Modes, Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode(MustUintBetween(*extensionRawData.PSKKeyExchangeMode)))
-				b.TlsFingerprint.ExtensionData = append(b.TlsFingerprint.ExtensionData, extensionData)
+				mode, err := parseUintBetween(*extensionRawData.PSKKeyExchangeMode)
+				if err != nil {
+					collector.add(path+".PSK_Key_Exchange_Mode", err)
+					continue
+				}
+				extensionData.PskKeyExchangeModes.Modes = append(extensionData.PskKeyExchangeModes.Modes, Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode(mode))
+				result.ExtensionData = append(result.ExtensionData, extensionData)
 			}
 			break
 		case 51:
@@ -285,17 +451,28 @@ func (b *Browser) FromPEET(response *PeetResponse) error {
 			extensionData.KeyShareExtension = &Browser_TLSFingerprint_ExtensionData_KeyShareExtension{
 				KeyShares: []*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare{},
 			}
-			for _, keyShare := range extensionRawData.SharedKeys {
-				for name, _ := range keyShare { // _ = hex data, TODO: allow importing that?
+			for i, keyShare := range extensionRawData.SharedKeys {
+				for name, keyData := range keyShare {
 					if strings.HasPrefix(name, "TLS_GREASE") {
 						continue
 					}
+					group, err := parseUintBetween(name)
+					if err != nil {
+						collector.add(fmt.Sprintf("%s.shared_keys[%d]", path, i), err)
+						continue
+					}
+					data, err := peetHexData(&keyData)
+					if err != nil {
+						collector.add(fmt.Sprintf("%s.shared_keys[%d]", path, i), err)
+						continue
+					}
 					extensionData.KeyShareExtension.KeyShares = append(extensionData.KeyShareExtension.KeyShares, &Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare{
-						Group: Browser_TLSFingerprint_EllipticCurve(MustUintBetween(name)),
+						Group: Browser_TLSFingerprint_EllipticCurve(group),
+						Data:  data,
 					})
 				}
 			}
-			b.TlsFingerprint.ExtensionData = append(b.TlsFingerprint.ExtensionData, extensionData)
+			result.ExtensionData = append(result.ExtensionData, extensionData)
 			break
 		case 17513:
 			// Application Settings
@@ -303,146 +480,331 @@ func (b *Browser) FromPEET(response *PeetResponse) error {
 			extensionData.ExtensionApplicationsSettings = &Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings{
 				Protocols: extensionRawData.Protocols,
 			}
-			b.TlsFingerprint.ExtensionData = append(b.TlsFingerprint.ExtensionData, extensionData)
+			result.ExtensionData = append(result.ExtensionData, extensionData)
 			break
 		case 65037:
-			// ECH
-			// TODO: don't hardcode this like this
-			if extensionRawData.Data != nil {
-				extensionData := &Browser_TLSFingerprint_ExtensionData{
-					ExtensionId: Browser_TLSFingerprint_EXTENSION_ENCRYPTED_CLIENT_HELLO,
-					ExtensionEncryptedClientHello: &Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello{
-						CandidateCipherSuites: []*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite{
-							{
-								KdfId:  Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF_SHA256,
-								AeadId: Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD_AES_128_GCM,
-							},
-							{
-								KdfId:  Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF_SHA256,
-								AeadId: Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD_CHACHA20POLY1305,
-							},
-						},
-						CandidatePayloadLens: []uint32{
-							128, 160, 192, 224,
-						},
-					},
-				}
-				b.TlsFingerprint.ExtensionData = append(b.TlsFingerprint.ExtensionData, extensionData)
+			// ECH, Chromium and Firefox send a GREASE outer ClientHello so the cipher suite and payload size are all there is
+			data, err := peetHexData(extensionRawData.Data)
+			if err != nil {
+				collector.add(path+".data", err)
+				continue
+			}
+			ech, err := parseECHOuter(data)
+			if err != nil {
+				collector.add(path+".data", err)
+				continue
+			}
+			result.ExtensionData = append(result.ExtensionData, &Browser_TLSFingerprint_ExtensionData{
+				ExtensionId:                   Browser_TLSFingerprint_EXTENSION_ENCRYPTED_CLIENT_HELLO,
+				ExtensionEncryptedClientHello: ech,
+			})
+			break
+		case 65281:
+			// Renegotiation info, an initial handshake always sends an empty renegotiated_connection so this can't tell once from freely
+			extensionData := &Browser_TLSFingerprint_ExtensionData{ExtensionId: Browser_TLSFingerprint_Extension(extensionId)}
+			extensionData.ExtensionRenegotiationInfo = &Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo{
+				RenegotiationSupport: Browser_TLSFingerprint_RENEGOTIATE_ONCE_AS_CLIENT,
 			}
+			result.ExtensionData = append(result.ExtensionData, extensionData)
 			break
 		}
 	}
+	return result
+}
 
-	// Set AKAMAI stuff
-	if response.Http2 != nil && response.Http2.AkamaiFingerprint != "" {
-		b.HttpFingerprint = &Browser_HTTPFingerprint{
-			SettingsFrame: &Browser_HTTPFingerprint_SettingsFrameOpts{
-				// -1 assumes not present
-				HeaderTableSize:      -1,
-				EnablePush:           -1,
-				MaxConcurrentStreams: -1,
-				InitialWindowSize:    -1,
-				MaxFrameSize:         -1,
-				MaxHeaderListSize:    -1,
+// peetHexData Decodes the hex data PEET reports for an extension, a missing value counts as empty
+func peetHexData(in *string) ([]byte, error) {
+	if in == nil {
+		return []byte{}, nil
+	}
+	result, err := hex.DecodeString(*in)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q is not hex", ErrImportMalformed, *in)
+	}
+	return result, nil
+}
+
+// parseECHOuter Parses the body of an outer encrypted_client_hello extension
+// See https://datatracker.ietf.org/doc/html/draft-ietf-tls-esni-18#section-5
+func parseECHOuter(data []byte) (*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello, error) {
+	// type (1) + cipher_suite (4) + config_id (1) + enc length (2)
+	if len(data) < 8 || data[0] != 0 {
+		return nil, fmt.Errorf("%w: not an outer ClientHello", ErrImportMalformed)
+	}
+	kdfId := binary.BigEndian.Uint16(data[1:3])
+	aeadId := binary.BigEndian.Uint16(data[3:5])
+	encLength := int(binary.BigEndian.Uint16(data[6:8]))
+	if len(data) < 8+encLength+2 {
+		return nil, fmt.Errorf("%w: enc is truncated", ErrImportMalformed)
+	}
+	payloadLength := int(binary.BigEndian.Uint16(data[8+encLength : 10+encLength]))
+	if len(data) != 10+encLength+payloadLength {
+		return nil, fmt.Errorf("%w: payload is truncated", ErrImportMalformed)
+	}
+
+	// Every HPKE AEAD adds a 16 byte tag, the profile stores the length before encryption
+	if payloadLength < 16 {
+		return nil, fmt.Errorf("%w: payload is shorter than the AEAD tag", ErrImportMalformed)
+	}
+	return &Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello{
+		CandidateCipherSuites: []*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite{
+			{
+				KdfId:  Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF(kdfId),
+				AeadId: Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD(aeadId),
 			},
-			PseudoHeaderOrder: []string{},
-			PriorityFrames:    []*Browser_HTTPFingerprint_PriorityFrameOpts{},
-		}
-		for sectionId, section := range strings.Split(response.Http2.AkamaiFingerprint, "|") {
-			switch sectionId {
-			case 0:
-				// SETTINGS frame
-				for _, parameterData := range strings.Split(section, ",") {
-					parameterDataSplit := strings.Split(parameterData, ":")
-					if len(parameterDataSplit) == 2 {
-						parameterValue := MustInt(parameterDataSplit[1])
-						switch parameterDataSplit[0] {
-						case "1":
-							b.HttpFingerprint.SettingsFrame.HeaderTableSize = parameterValue
-							break
-						case "2":
-							b.HttpFingerprint.SettingsFrame.EnablePush = parameterValue
-							break
-						case "3":
-							b.HttpFingerprint.SettingsFrame.MaxConcurrentStreams = parameterValue
-							break
-						case "4":
-							b.HttpFingerprint.SettingsFrame.InitialWindowSize = parameterValue
-							break
-						case "5":
-							b.HttpFingerprint.SettingsFrame.MaxFrameSize = parameterValue
-							break
-						case "6":
-							b.HttpFingerprint.SettingsFrame.MaxHeaderListSize = parameterValue
-							break
-						}
-					}
+		},
+		CandidatePayloadLens: []uint32{uint32(payloadLength - 16)},
+	}, nil
+}
+
+func peetHTTPFingerprint(collector *importCollector, response *Http2) *Browser_HTTPFingerprint {
+	// Set AKAMAI stuff
+	if response == nil || response.AkamaiFingerprint == "" {
+		return nil
+	}
+	result := &Browser_HTTPFingerprint{
+		SettingsFrame:     LegacyFromSettings(nil),
+		PseudoHeaderOrder: []string{},
+		PriorityFrames:    []*Browser_HTTPFingerprint_PriorityFrameOpts{},
+	}
+	for sectionId, section := range strings.Split(response.AkamaiFingerprint, "|") {
+		path := fmt.Sprintf("http2.akamai_fingerprint[%d]", sectionId)
+		switch sectionId {
+		case 0:
+			// SETTINGS frame, kept in order with every ID including GREASE ones
+			settings := []*Browser_HTTPFingerprint_Setting{}
+			for parameterIdx, parameterData := range strings.Split(section, ",") {
+				if parameterData == "" {
+					continue
+				}
+				parameterPath := fmt.Sprintf("%s[%d]", path, parameterIdx)
+				parameterDataSplit := strings.Split(parameterData, ":")
+				if len(parameterDataSplit) != 2 {
+					collector.add(parameterPath, fmt.Errorf("%w: %q is not id:value", ErrImportMalformed, parameterData))
+					continue
+				}
+				parameterId, err := parseUint(parameterDataSplit[0])
+				if err == nil && parameterId > 0xffff {
+					err = fmt.Errorf("%w: setting %d is out of range", ErrImportMalformed, parameterId)
 				}
-				break
-			case 1:
-				// WINDOW_UPDATE frame
-				b.HttpFingerprint.WindowUpdateIncrement = MustInt(section)
-				break
-			case 2:
-				// PRIORITY frames
-				if section == "0" {
+				if err != nil {
+					collector.add(parameterPath, err)
 					continue
 				}
-				for _, priorityData := range strings.Split(section, ",") {
-					priorityDataSplit := strings.Split(priorityData, ":")
-					if len(priorityDataSplit) == 4 {
-						priorityFrame := &Browser_HTTPFingerprint_PriorityFrameOpts{
-							StreamId:  MustInt(priorityDataSplit[0]),
-							StreamDep: MustInt(priorityDataSplit[2]),
-							Exclusive: priorityDataSplit[2] == "1",
-							Weight:    int32(MustInt(priorityDataSplit[3])),
-						}
-						b.HttpFingerprint.PriorityFrames = append(b.HttpFingerprint.PriorityFrames, priorityFrame)
+				parameterValue, err := parseUint(parameterDataSplit[1])
+				if err == nil && parameterValue > 0xffffffff {
+					err = fmt.Errorf("%w: value %d is out of range", ErrImportMalformed, parameterValue)
+				}
+				if err != nil {
+					collector.add(parameterPath, err)
+					continue
+				}
+				settings = append(settings, &Browser_HTTPFingerprint_Setting{Id: uint32(parameterId), Value: uint32(parameterValue)})
+			}
+			result.SetOrderedSettings(settings)
+			break
+		case 1:
+			// WINDOW_UPDATE frame
+			increment, err := parseInt(section)
+			if err != nil {
+				collector.add(path, err)
+				continue
+			}
+			result.WindowUpdateIncrement = increment
+			break
+		case 2:
+			// PRIORITY frames
+			if section == "0" {
+				continue
+			}
+			for priorityIdx, priorityData := range strings.Split(section, ",") {
+				priorityPath := fmt.Sprintf("%s[%d]", path, priorityIdx)
+				priorityDataSplit := strings.Split(priorityData, ":")
+				if len(priorityDataSplit) != 4 {
+					collector.add(priorityPath, fmt.Errorf("%w: %q is not stream:exclusive:dependency:weight", ErrImportMalformed, priorityData))
+					continue
+				}
+				values := make([]int64, 4)
+				valid := true
+				for i, element := range priorityDataSplit {
+					value, err := parseInt(element)
+					if err != nil {
+						collector.add(fmt.Sprintf("%s[%d]", priorityPath, i), err)
+						valid = false
+						continue
 					}
+					values[i] = value
 				}
-				break
-			case 3:
-				// Pseudo Header Order
-				headers := map[string]string{
-					"m": ":method",
-					"p": ":path",
-					"a": ":authority",
-					"s": ":scheme",
+				if !valid {
+					continue
 				}
-				for _, pseudoHeader := range strings.Split(section, ",") {
-					b.HttpFingerprint.PseudoHeaderOrder = append(b.HttpFingerprint.PseudoHeaderOrder, headers[pseudoHeader])
+				priorityFrame := &Browser_HTTPFingerprint_PriorityFrameOpts{
+					StreamId:  values[0],
+					StreamDep: values[2],
+					Exclusive: values[1] == 1,
+					Weight:    int32(values[3]),
 				}
-				break
+				result.PriorityFrames = append(result.PriorityFrames, priorityFrame)
 			}
+			break
+		case 3:
+			// Pseudo Header Order
+			for pseudoHeaderIdx, pseudoHeader := range strings.Split(section, ",") {
+				header, ok := peetPseudoHeaders[pseudoHeader]
+				if !ok {
+					collector.add(fmt.Sprintf("%s[%d]", path, pseudoHeaderIdx), fmt.Errorf("%w: pseudo header %q", ErrImportUnknown, pseudoHeader))
+					continue
+				}
+				result.PseudoHeaderOrder = append(result.PseudoHeaderOrder, header)
+			}
+			break
 		}
 	}
 
-	// Set priority for HEADERS frame
-	for _, frameRawData := range response.Http2.SentFrames {
-		if frameRawData.FrameType != "SETTINGS" {
+	// Model the frames sent up to the first request
+	result.Frames = []*Browser_HTTPFingerprint_Frame{}
+	for frameIdx, frameRawData := range response.SentFrames {
+		frame, ok := peetFrame(collector, fmt.Sprintf("http2.sent_frames[%d]", frameIdx), &frameRawData)
+		if !ok {
+			continue
+		}
+		result.Frames = append(result.Frames, frame)
+		if frame.Type != Browser_HTTPFingerprint_HEADERS {
 			continue
 		}
 
+		// Set header order and priority from the HEADERS frame, pseudo headers are already covered by the Akamai fingerprint
+		result.HeaderOrder = []string{}
+		for _, headerName := range frame.HeaderOrder {
+			if strings.HasPrefix(headerName, ":") {
+				continue
+			}
+			if _, ok := strInSlice(result.HeaderOrder, headerName); ok {
+				continue
+			}
+			result.HeaderOrder = append(result.HeaderOrder, headerName)
+		}
+		if frame.Priority != nil {
+			result.HeaderFramePriority = proto.Clone(frame.Priority).(*Browser_HTTPFingerprint_PriorityFrameOpts)
+		}
+		break
+	}
+	return result
+}
+
+var peetSettings = map[string]uint32{
+	"HEADER_TABLE_SIZE":       1,
+	"ENABLE_PUSH":             2,
+	"MAX_CONCURRENT_STREAMS":  3,
+	"INITIAL_WINDOW_SIZE":     4,
+	"MAX_FRAME_SIZE":          5,
+	"MAX_HEADER_LIST_SIZE":    6,
+	"ENABLE_CONNECT_PROTOCOL": 8,
+	"NO_RFC7540_PRIORITIES":   9,
+}
+
+// peetFrame Converts a frame from sent_frames, ok is false when the frame is unusable
+func peetFrame(collector *importCollector, path string, frameRawData *SentFrame) (*Browser_HTTPFingerprint_Frame, bool) {
+	frameType, ok := Browser_HTTPFingerprint_FrameType_value[frameRawData.FrameType]
+	if !ok {
+		collector.add(path+".frame_type", fmt.Errorf("%w: %q", ErrImportUnknown, frameRawData.FrameType))
+		return nil, false
+	}
+	result := &Browser_HTTPFingerprint_Frame{
+		Type:   Browser_HTTPFingerprint_FrameType(frameType),
+		Length: uint32(frameRawData.Length),
+	}
+	if frameRawData.StreamID != nil {
+		if *frameRawData.StreamID < 0 || *frameRawData.StreamID > 1<<31-1 {
+			collector.add(path+".stream_id", fmt.Errorf("%w: %d is out of range", ErrImportMalformed, *frameRawData.StreamID))
+			return nil, false
+		}
+		result.StreamId = uint32(*frameRawData.StreamID)
+	}
+	for i, flag := range frameRawData.Flags {
+		flagValue, err := parseUintBetween(flag)
+		if err != nil || flagValue > 0xff {
+			collector.add(fmt.Sprintf("%s.flags[%d]", path, i), fmt.Errorf("%w: %q is not a flag", ErrImportMalformed, flag))
+			return nil, false
+		}
+		result.Flags |= uint32(flagValue)
+	}
+
+	switch result.Type {
+	case Browser_HTTPFingerprint_SETTINGS:
+		result.Settings = []*Browser_HTTPFingerprint_Setting{}
+		for i, settingData := range frameRawData.Settings {
+			settingPath := fmt.Sprintf("%s.settings[%d]", path, i)
+			settingDataSplit := strings.Split(settingData, " = ")
+			if len(settingDataSplit) != 2 {
+				collector.add(settingPath, fmt.Errorf("%w: %q is not NAME = value", ErrImportMalformed, settingData))
+				continue
+			}
+			id, ok := peetSettings[settingDataSplit[0]]
+			if !ok {
+				// x/net names settings it doesn't know UNKNOWN_SETTING_<id>, which covers GREASE
+				unknownId, err := parseUint(strings.TrimPrefix(settingDataSplit[0], "UNKNOWN_SETTING_"))
+				if err != nil || unknownId > 0xffff {
+					collector.add(settingPath, fmt.Errorf("%w: setting %q", ErrImportUnknown, settingDataSplit[0]))
+					continue
+				}
+				id = uint32(unknownId)
+			}
+			value, err := parseUint(settingDataSplit[1])
+			if err != nil || value > 0xffffffff {
+				collector.add(settingPath, fmt.Errorf("%w: %q is not a setting value", ErrImportMalformed, settingDataSplit[1]))
+				continue
+			}
+			result.Settings = append(result.Settings, &Browser_HTTPFingerprint_Setting{Id: id, Value: uint32(value)})
+		}
+		break
+	case Browser_HTTPFingerprint_WINDOW_UPDATE:
+		if frameRawData.Increment == nil {
+			collector.add(path+".increment", ErrImportMissing)
+			return nil, false
+		}
+		if *frameRawData.Increment < 1 || *frameRawData.Increment > 1<<31-1 {
+			collector.add(path+".increment", fmt.Errorf("%w: %d is out of range", ErrImportMalformed, *frameRawData.Increment))
+			return nil, false
+		}
+		result.Increment = uint32(*frameRawData.Increment)
+		break
+	case Browser_HTTPFingerprint_PRIORITY:
 		if frameRawData.Priority == nil {
-			break
+			collector.add(path+".priority", ErrImportMissing)
+			return nil, false
 		}
+		break
+	case Browser_HTTPFingerprint_HEADERS:
+		result.HeaderOrder = []string{}
+		for _, header := range frameRawData.Headers {
+			headerName := strings.SplitN(header, ":", 2)[0]
+			if strings.HasPrefix(header, ":") {
+				// Pseudo headers start with a colon themselves
+				headerName = ":" + strings.SplitN(header[1:], ":", 2)[0]
+			}
+			result.HeaderOrder = append(result.HeaderOrder, headerName)
+		}
+		break
+	}
 
-		b.HttpFingerprint.HeaderFramePriority = &Browser_HTTPFingerprint_PriorityFrameOpts{
-			StreamId:  *frameRawData.StreamID,
+	if frameRawData.Priority != nil {
+		result.Priority = &Browser_HTTPFingerprint_PriorityFrameOpts{
+			StreamId:  int64(result.StreamId),
 			StreamDep: frameRawData.Priority.DependsOn,
 			Exclusive: frameRawData.Priority.Exclusive == 1,
 			Weight:    int32(frameRawData.Priority.Weight),
 		}
 	}
-	return nil
+	return result, true
 }
 
-func (b *Browser) FromPEETRaw(data []byte) error {
+func (b *Browser) FromPEETRaw(data []byte, lenient ...bool) error {
 	response := &PeetResponse{}
 	err := json.Unmarshal(data, response)
 	if err != nil {
 		return fmt.Errorf("json.Unmarshal: %w", err)
 	}
 
-	return b.FromPEET(response)
+	return b.FromPEET(response, lenient...)
 }
@@ -0,0 +1,374 @@
+package device_utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrBrowserPlatformUnsupported = errors.New("the supplied platform is not supported for this browser")
+
+// NewFirefoxBrowser Builds a Firefox profile, TLS and HTTP/2 values are taken from Firefox 121
+func NewFirefoxBrowser(version string, platform string) (*Browser, error) {
+	rv := firefoxVersion(version)
+	result := &Browser{
+		Version:             version,
+		Name:                "firefox",
+		AppCodeName:         "Mozilla",
+		AppName:             "Netscape",
+		CookieEnabled:       true,
+		DoNotTrack:          -2, // "unspecified" until Do Not Track is switched on or off
+		HardwareConcurrency: 8,
+		Language:            "en-US",
+		Languages:           []string{"en-US", "en"},
+		PdfViewerEnabled:    true,
+		Product:             "Gecko",
+		ProductSub:          "20100101",
+		TlsFingerprint:      firefoxTLSFingerprint(),
+		HttpFingerprint:     firefoxHTTPFingerprint(),
+	}
+
+	switch platform {
+	case PlatformWindows, PlatformWindows64:
+		result.UserAgent = fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:%s) Gecko/20100101 Firefox/%s", rv, rv)
+		result.AppVersion = "5.0 (Windows)"
+		result.Platform = "Win32"
+		break
+	case PlatformMac, PlatformMacARM64:
+		// Firefox reports an Intel Mac running 10.15 on every macOS version and architecture
+		result.UserAgent = fmt.Sprintf("Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:%s) Gecko/20100101 Firefox/%s", rv, rv)
+		result.AppVersion = "5.0 (Macintosh)"
+		result.Platform = "MacIntel"
+		break
+	case PlatformLinux:
+		result.UserAgent = fmt.Sprintf("Mozilla/5.0 (X11; Linux x86_64; rv:%s) Gecko/20100101 Firefox/%s", rv, rv)
+		result.AppVersion = "5.0 (X11)"
+		result.Platform = "Linux x86_64"
+		break
+	case PlatformAndroid:
+		result.UserAgent = fmt.Sprintf("Mozilla/5.0 (Android 14; Mobile; rv:%s) Gecko/%s Firefox/%s", rv, rv, rv)
+		result.AppVersion = "5.0 (Android 14)"
+		result.Platform = "Linux armv81"
+		result.MaxTouchPoints = 5
+		result.PdfViewerEnabled = false
+		break
+	default:
+		// Firefox on iOS is WebKit underneath, use NewSafariBrowser for it
+		return nil, ErrBrowserPlatformUnsupported
+	}
+
+	result.HttpFingerprint.HeaderOrder = headerTemplateOrder(BrowserFamilyFirefox, RequestTypeNavigation)
+	return result, nil
+}
+
+// NewSafariBrowser Builds a Safari profile, TLS and HTTP/2 values are taken from Safari 17
+func NewSafariBrowser(version string, platform string) (*Browser, error) {
+	version = safariVersion(version)
+	result := &Browser{
+		Version:             version,
+		Name:                "safari",
+		AppCodeName:         "Mozilla",
+		AppName:             "Netscape",
+		CookieEnabled:       true,
+		DoNotTrack:          -1, // null, Safari dropped Do Not Track
+		HardwareConcurrency: 8,
+		Language:            "en-US",
+		Languages:           []string{"en-US"},
+		PdfViewerEnabled:    true,
+		Product:             "Gecko",
+		ProductSub:          "20030107",
+		Vendor:              "Apple Computer, Inc.",
+		TlsFingerprint:      safariTLSFingerprint(),
+		HttpFingerprint:     safariHTTPFingerprint(),
+	}
+
+	switch platform {
+	case PlatformMac, PlatformMacARM64:
+		// Safari froze the macOS version at 10_15_7
+		result.UserAgent = fmt.Sprintf("Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/%s Safari/605.1.15", version)
+		result.Platform = "MacIntel"
+		break
+	case PlatformIOS:
+		// iOS ships Safari with the same version number
+		result.UserAgent = fmt.Sprintf("Mozilla/5.0 (iPhone; CPU iPhone OS %s like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/%s Mobile/15E148 Safari/604.1", strings.ReplaceAll(version, ".", "_"), version)
+		result.Platform = "iPhone"
+		result.MaxTouchPoints = 5
+		result.HardwareConcurrency = 4
+		break
+	default:
+		return nil, ErrBrowserPlatformUnsupported
+	}
+	result.AppVersion = strings.TrimPrefix(result.UserAgent, "Mozilla/")
+
+	result.HttpFingerprint.HeaderOrder = headerTemplateOrder(BrowserFamilySafari, RequestTypeNavigation)
+	return result, nil
+}
+
+// firefoxVersion Firefox only puts major.minor in its User-Agent
+func firefoxVersion(version string) string {
+	versionSplit := strings.Split(version, ".")
+	if len(versionSplit) < 2 {
+		return versionSplit[0] + ".0"
+	}
+	return versionSplit[0] + "." + versionSplit[1]
+}
+
+// safariVersion Safari leaves out the patch version when it is 0
+func safariVersion(version string) string {
+	versionSplit := strings.Split(version, ".")
+	if len(versionSplit) < 2 {
+		return versionSplit[0] + ".0"
+	}
+	if len(versionSplit) > 2 && versionSplit[2] == "0" {
+		return versionSplit[0] + "." + versionSplit[1]
+	}
+	return version
+}
+
+func headerTemplateOrder(family string, requestType RequestType) []string {
+	result := make([]string, 0, len(HeaderTemplates[family][requestType]))
+	for _, entry := range HeaderTemplates[family][requestType] {
+		result = append(result, entry.Key)
+	}
+	return result
+}
+
+// firefoxTLSFingerprint src: https://tls.peet.ws/api/all with Firefox 121 on a fresh session, so session_ticket instead of pre_shared_key
+func firefoxTLSFingerprint() *Browser_TLSFingerprint {
+	return &Browser_TLSFingerprint{
+		Version:                   771,
+		CipherSuites:              []Browser_TLSFingerprint_CipherSuite{4865, 4867, 4866, 49195, 49199, 52393, 52392, 49196, 49200, 49162, 49161, 49171, 49172, 156, 157, 47, 53},
+		Extensions:                []Browser_TLSFingerprint_Extension{0, 23, 65281, 10, 11, 35, 16, 5, 34, 51, 43, 13, 45, 28, 65037},
+		EllipticCurves:            []Browser_TLSFingerprint_EllipticCurve{29, 23, 24, 25, 256, 257},
+		EllipticCurvePointFormats: []Browser_TLSFingerprint_EllipticCurvePointFormat{0},
+		ExtensionData: []*Browser_TLSFingerprint_ExtensionData{
+			{
+				ExtensionId: Browser_TLSFingerprint_EXTENSION_RENEGOTIATION_INFO,
+				ExtensionRenegotiationInfo: &Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo{
+					RenegotiationSupport: Browser_TLSFingerprint_RENEGOTIATE_ONCE_AS_CLIENT,
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_APPLICATION_LAYER_PROTOCOL_NEGOTIATION,
+				ApplicationLayerProtocolNegotiation: &Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation{
+					Protocols: []string{
+						"h2",
+						"http/1.1",
+					},
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_DELEGATED_CREDENTIAL,
+				DelegatedCredentials: &Browser_TLSFingerprint_ExtensionData_DelegatedCredentials{
+					SupportedSignatureAlgorithms: []Browser_TLSFingerprint_SignatureScheme{
+						Browser_TLSFingerprint_ECDSA_SECP256R1_SHA256,
+						Browser_TLSFingerprint_ECDSA_SECP384R1_SHA384,
+						Browser_TLSFingerprint_ECDSA_SECP521R1_SHA512,
+						Browser_TLSFingerprint_ECDSA_SHA1,
+					},
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_KEY_SHARE,
+				KeyShareExtension: &Browser_TLSFingerprint_ExtensionData_KeyShareExtension{
+					KeyShares: []*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare{
+						{Group: Browser_TLSFingerprint_X25519},
+						{Group: Browser_TLSFingerprint_SECP256R1},
+					},
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_SUPPORTED_VERSIONS,
+				SupportedVersions: &Browser_TLSFingerprint_ExtensionData_SupportedVersions{
+					Versions: []Browser_TLSFingerprint_ProtocolVersion{
+						Browser_TLSFingerprint_TLS1_3,
+						Browser_TLSFingerprint_TLS1_2,
+					},
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_SIGNATURE_ALGORITHMS,
+				SignatureAlgorithms: &Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms{
+					SupportedSignatureAlgorithms: []Browser_TLSFingerprint_SignatureScheme{
+						Browser_TLSFingerprint_ECDSA_SECP256R1_SHA256,
+						Browser_TLSFingerprint_ECDSA_SECP384R1_SHA384,
+						Browser_TLSFingerprint_ECDSA_SECP521R1_SHA512,
+						Browser_TLSFingerprint_RSA_PSS_RSAE_SHA256,
+						Browser_TLSFingerprint_RSA_PSS_RSAE_SHA384,
+						Browser_TLSFingerprint_RSA_PSS_RSAE_SHA512,
+						Browser_TLSFingerprint_RSA_PKCS1_SHA256,
+						Browser_TLSFingerprint_RSA_PKCS1_SHA384,
+						Browser_TLSFingerprint_RSA_PKCS1_SHA512,
+						Browser_TLSFingerprint_ECDSA_SHA1,
+						Browser_TLSFingerprint_RSA_PKCS1_SHA1,
+					},
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_PSK_KEY_EXCHANGE_MODES,
+				PskKeyExchangeModes: &Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes{
+					Modes: []Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode{
+						Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_DHE,
+					},
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_RECORD_SIZE_LIMIT,
+				RecordSizeLimit: &Browser_TLSFingerprint_ExtensionData_RecordSizeLimit{
+					Limit: 0x4001,
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_EXTENSION_ENCRYPTED_CLIENT_HELLO,
+				ExtensionEncryptedClientHello: &Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello{
+					CandidateCipherSuites: []*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite{
+						{
+							KdfId:  Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF_SHA256,
+							AeadId: Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD_AES_128_GCM,
+						},
+						{
+							KdfId:  Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF_SHA256,
+							AeadId: Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD_CHACHA20POLY1305,
+						},
+					},
+					CandidatePayloadLens: []uint32{
+						223,
+					},
+				},
+			},
+		},
+	}
+}
+
+// firefoxHTTPFingerprint src: https://tls.peet.ws/api/all with Firefox 121
+func firefoxHTTPFingerprint() *Browser_HTTPFingerprint {
+	result := &Browser_HTTPFingerprint{
+		PseudoHeaderOrder:     []string{":method", ":path", ":authority", ":scheme"},
+		WindowUpdateIncrement: 12517377,
+		// Firefox builds its priority tree out of idle streams before the first request
+		PriorityFrames: []*Browser_HTTPFingerprint_PriorityFrameOpts{
+			{StreamId: 3, StreamDep: 0, Exclusive: false, Weight: 201},
+			{StreamId: 5, StreamDep: 0, Exclusive: false, Weight: 101},
+			{StreamId: 7, StreamDep: 0, Exclusive: false, Weight: 1},
+			{StreamId: 9, StreamDep: 7, Exclusive: false, Weight: 1},
+			{StreamId: 11, StreamDep: 3, Exclusive: false, Weight: 1},
+			{StreamId: 13, StreamDep: 0, Exclusive: false, Weight: 241},
+		},
+		HeaderFramePriority: &Browser_HTTPFingerprint_PriorityFrameOpts{
+			StreamId:  15,
+			StreamDep: 13,
+			Exclusive: false,
+			Weight:    42,
+		},
+	}
+	result.SetOrderedSettings([]*Browser_HTTPFingerprint_Setting{
+		{Id: SettingHeaderTableSize, Value: 65536},
+		{Id: SettingInitialWindowSize, Value: 131072},
+		{Id: SettingMaxFrameSize, Value: 16384},
+	})
+	return result
+}
+
+// safariTLSFingerprint src: https://tls.peet.ws/api/all with Safari 17 on macOS 14
+func safariTLSFingerprint() *Browser_TLSFingerprint {
+	return &Browser_TLSFingerprint{
+		Version:                   771,
+		CipherSuites:              []Browser_TLSFingerprint_CipherSuite{4865, 4866, 4867, 49196, 49195, 52393, 49200, 49199, 52392, 49162, 49161, 49172, 49171, 157, 156, 53, 47, 49160, 49170, 10},
+		Extensions:                []Browser_TLSFingerprint_Extension{0, 23, 65281, 10, 11, 16, 5, 13, 18, 51, 45, 43, 27, 21},
+		EllipticCurves:            []Browser_TLSFingerprint_EllipticCurve{29, 23, 24, 25},
+		EllipticCurvePointFormats: []Browser_TLSFingerprint_EllipticCurvePointFormat{0},
+		ExtensionData: []*Browser_TLSFingerprint_ExtensionData{
+			{
+				ExtensionId: Browser_TLSFingerprint_EXTENSION_RENEGOTIATION_INFO,
+				ExtensionRenegotiationInfo: &Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo{
+					RenegotiationSupport: Browser_TLSFingerprint_RENEGOTIATE_ONCE_AS_CLIENT,
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_APPLICATION_LAYER_PROTOCOL_NEGOTIATION,
+				ApplicationLayerProtocolNegotiation: &Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation{
+					Protocols: []string{
+						"h2",
+						"http/1.1",
+					},
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_SIGNATURE_ALGORITHMS,
+				SignatureAlgorithms: &Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms{
+					// Safari really does send rsa_pss_rsae_sha384 twice
+					SupportedSignatureAlgorithms: []Browser_TLSFingerprint_SignatureScheme{
+						Browser_TLSFingerprint_ECDSA_SECP256R1_SHA256,
+						Browser_TLSFingerprint_RSA_PSS_RSAE_SHA256,
+						Browser_TLSFingerprint_RSA_PKCS1_SHA256,
+						Browser_TLSFingerprint_ECDSA_SECP384R1_SHA384,
+						Browser_TLSFingerprint_ECDSA_SHA1,
+						Browser_TLSFingerprint_RSA_PSS_RSAE_SHA384,
+						Browser_TLSFingerprint_RSA_PSS_RSAE_SHA384,
+						Browser_TLSFingerprint_RSA_PKCS1_SHA384,
+						Browser_TLSFingerprint_RSA_PSS_RSAE_SHA512,
+						Browser_TLSFingerprint_RSA_PKCS1_SHA512,
+						Browser_TLSFingerprint_RSA_PKCS1_SHA1,
+					},
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_KEY_SHARE,
+				KeyShareExtension: &Browser_TLSFingerprint_ExtensionData_KeyShareExtension{
+					KeyShares: []*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare{
+						{Group: Browser_TLSFingerprint_X25519},
+					},
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_PSK_KEY_EXCHANGE_MODES,
+				PskKeyExchangeModes: &Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes{
+					Modes: []Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode{
+						Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_DHE,
+					},
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_SUPPORTED_VERSIONS,
+				SupportedVersions: &Browser_TLSFingerprint_ExtensionData_SupportedVersions{
+					Versions: []Browser_TLSFingerprint_ProtocolVersion{
+						Browser_TLSFingerprint_TLS1_3,
+						Browser_TLSFingerprint_TLS1_2,
+						Browser_TLSFingerprint_TLS1_1,
+						Browser_TLSFingerprint_TLS1,
+					},
+				},
+			},
+			{
+				ExtensionId: Browser_TLSFingerprint_COMPRESS_CERTIFICATE,
+				CompressCertificate: &Browser_TLSFingerprint_ExtensionData_CompressCertificate{
+					Algorithms: []Browser_TLSFingerprint_ExtensionData_CompressCertificate_CertificateCompression{
+						Browser_TLSFingerprint_ExtensionData_CompressCertificate_ZLIB,
+					},
+				},
+			},
+		},
+	}
+}
+
+// safariHTTPFingerprint src: https://tls.peet.ws/api/all with Safari 17 on macOS 14
+func safariHTTPFingerprint() *Browser_HTTPFingerprint {
+	result := &Browser_HTTPFingerprint{
+		PseudoHeaderOrder:     []string{":method", ":scheme", ":path", ":authority"},
+		WindowUpdateIncrement: 10485760,
+		PriorityFrames:        []*Browser_HTTPFingerprint_PriorityFrameOpts{},
+		HeaderFramePriority: &Browser_HTTPFingerprint_PriorityFrameOpts{
+			StreamId:  1,
+			StreamDep: 0,
+			Exclusive: false,
+			Weight:    255,
+		},
+	}
+	// Safari sends MAX_CONCURRENT_STREAMS after INITIAL_WINDOW_SIZE, which the fixed fields can't express
+	result.SetOrderedSettings([]*Browser_HTTPFingerprint_Setting{
+		{Id: SettingEnablePush, Value: 0},
+		{Id: SettingInitialWindowSize, Value: 4194304},
+		{Id: SettingMaxConcurrentStreams, Value: 100},
+	})
+	return result
+}
@@ -0,0 +1,432 @@
+package device_utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// glCapabilityNames are the WebGL parameters that describe the implementation, everything else is context state that
+// changes as the page renders and has to stay live
+var glCapabilityNames = map[string]bool{
+	"VENDOR":                     true,
+	"RENDERER":                   true,
+	"VERSION":                    true,
+	"SHADING_LANGUAGE_VERSION":   true,
+	"UNMASKED_VENDOR_WEBGL":      true,
+	"UNMASKED_RENDERER_WEBGL":    true,
+	"SUBPIXEL_BITS":              true,
+	"COMPRESSED_TEXTURE_FORMATS": true,
+}
+
+// glTypedArrays are the WebGL parameters returned as typed arrays
+var glTypedArrays = map[string]string{
+	"ALIASED_LINE_WIDTH_RANGE":   "Float32Array",
+	"ALIASED_POINT_SIZE_RANGE":   "Float32Array",
+	"MAX_VIEWPORT_DIMS":          "Int32Array",
+	"COMPRESSED_TEXTURE_FORMATS": "Uint32Array",
+}
+
+type scriptGLParameter struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+type scriptMIMEType struct {
+	Type        string `json:"type"`
+	Suffixes    string `json:"suffixes"`
+	Description string `json:"description"`
+}
+
+type scriptPlugin struct {
+	Name        string   `json:"name"`
+	Filename    string   `json:"filename"`
+	Description string   `json:"description"`
+	MIMETypes   []string `json:"mimeTypes"`
+}
+
+type scriptVoice struct {
+	VoiceURI     string `json:"voiceURI"`
+	Name         string `json:"name"`
+	Lang         string `json:"lang"`
+	LocalService bool   `json:"localService"`
+	Default      bool   `json:"default"`
+}
+
+type scriptProfile struct {
+	Navigator     map[string]any                `json:"navigator"`
+	Screen        map[string]any                `json:"screen"`
+	Orientation   map[string]any                `json:"orientation"`
+	Window        map[string]any                `json:"window"`
+	UserAgentData map[string]any                `json:"userAgentData"`
+	Plugins       []*scriptPlugin               `json:"plugins"`
+	MIMETypes     []*scriptMIMEType             `json:"mimeTypes"`
+	Voices        []*scriptVoice                `json:"voices"`
+	GL            map[string]*scriptGLParameter `json:"gl"`
+	GL2           map[string]*scriptGLParameter `json:"gl2"`
+}
+
+// GenerateSpoofingScript Renders a self-contained script that makes a page see the browser profile, meant to be injected
+// before any page script runs. Only the values the profile has are overridden, properties the engine doesn't expose are left out
+func (b *Browser) GenerateSpoofingScript() (string, error) {
+	profile := &scriptProfile{
+		Navigator: map[string]any{
+			// Automation is what the script hides, even when the profile was captured from it
+			"webdriver":        false,
+			"cookieEnabled":    b.CookieEnabled,
+			"pdfViewerEnabled": b.PdfViewerEnabled,
+			"maxTouchPoints":   b.MaxTouchPoints,
+		},
+		Window: map[string]any{},
+	}
+
+	for key, value := range map[string]string{
+		"userAgent":   b.UserAgent,
+		"appCodeName": b.AppCodeName,
+		"appName":     b.AppName,
+		"appVersion":  b.AppVersion,
+		"platform":    b.Platform,
+		"product":     b.Product,
+		"productSub":  b.ProductSub,
+		"vendor":      b.Vendor,
+		"vendorSub":   b.VendorSub,
+		"language":    b.Language,
+	} {
+		// vendor and vendorSub are legitimately empty in Firefox
+		if value != "" || key == "vendor" || key == "vendorSub" {
+			profile.Navigator[key] = value
+		}
+	}
+	if len(b.Languages) > 0 {
+		profile.Navigator["languages"] = b.Languages
+	}
+	if b.HardwareConcurrency > 0 {
+		profile.Navigator["hardwareConcurrency"] = b.HardwareConcurrency
+	}
+	if b.DeviceMemory > 0 {
+		profile.Navigator["deviceMemory"] = b.DeviceMemory
+	}
+	switch b.DoNotTrack {
+	case -1:
+		profile.Navigator["doNotTrack"] = nil
+		break
+	case -2:
+		profile.Navigator["doNotTrack"] = "unspecified"
+		break
+	default:
+		profile.Navigator["doNotTrack"] = mustString(int(b.DoNotTrack))
+		break
+	}
+
+	for key, value := range map[string]int32{
+		"innerWidth":  b.InnerWidth,
+		"innerHeight": b.InnerHeight,
+		"outerWidth":  b.OuterWidth,
+		"outerHeight": b.OuterHeight,
+	} {
+		if value > 0 {
+			profile.Window[key] = value
+		}
+	}
+	if b.DevicePixelRatio > 0 {
+		profile.Window["devicePixelRatio"] = b.DevicePixelRatio
+	}
+
+	if b.Screen != nil {
+		profile.Screen = map[string]any{
+			"availWidth":  b.Screen.AvailWidth,
+			"availHeight": b.Screen.AvailHeight,
+			"width":       b.Screen.Width,
+			"height":      b.Screen.Height,
+			"colorDepth":  b.Screen.ColorDepth,
+			"pixelDepth":  b.Screen.PixelDepth,
+			"availLeft":   b.Screen.AvailLeft,
+			"availTop":    b.Screen.AvailTop,
+			"isExtended":  b.Screen.IsExtended,
+		}
+		if b.Screen.Orientation != nil {
+			profile.Orientation = map[string]any{
+				"angle": b.Screen.Orientation.Angle,
+				"type":  b.Screen.Orientation.Type,
+			}
+		}
+	}
+
+	if b.HighEntropyValues != nil {
+		brands := make([]map[string]string, 0, len(b.HighEntropyValues.Brands))
+		for _, brand := range b.HighEntropyValues.Brands {
+			brands = append(brands, map[string]string{"brand": brand.Brand, "version": brand.Version})
+		}
+		fullVersionList := make([]map[string]string, 0, len(b.HighEntropyValues.FullVersionList))
+		for _, brand := range b.HighEntropyValues.FullVersionList {
+			fullVersionList = append(fullVersionList, map[string]string{"brand": brand.Brand, "version": brand.Version})
+		}
+		profile.UserAgentData = map[string]any{
+			"brands":   brands,
+			"mobile":   b.HighEntropyValues.Mobile,
+			"platform": b.HighEntropyValues.Platform,
+			"highEntropy": map[string]any{
+				"architecture":    b.HighEntropyValues.Architecture,
+				"bitness":         b.HighEntropyValues.Bitness,
+				"model":           b.HighEntropyValues.Model,
+				"platformVersion": b.HighEntropyValues.PlatformVersion,
+				"uaFullVersion":   b.HighEntropyValues.UsFullVersion,
+				"fullVersionList": fullVersionList,
+				"wow64":           b.Wow64,
+				"formFactors":     []string{},
+			},
+		}
+	}
+
+	if b.Plugins != nil {
+		profile.Plugins = []*scriptPlugin{}
+		profile.MIMETypes = []*scriptMIMEType{}
+		seenMIMETypes := map[string]bool{}
+		for _, plugin := range b.Plugins {
+			scriptPluginObj := &scriptPlugin{
+				Name:        plugin.Name,
+				Filename:    plugin.FileName,
+				Description: plugin.Description,
+				MIMETypes:   []string{},
+			}
+			mimeTypes := plugin.MimeTypes
+			if len(mimeTypes) == 0 {
+				types := make([]string, 0, len(plugin.SupportedMIMETypes))
+				for mimeType := range plugin.SupportedMIMETypes {
+					types = append(types, mimeType)
+				}
+				sort.Strings(types)
+				for _, mimeType := range types {
+					mimeTypes = append(mimeTypes, plugin.SupportedMIMETypes[mimeType])
+				}
+			}
+			for _, mimeType := range mimeTypes {
+				scriptPluginObj.MIMETypes = append(scriptPluginObj.MIMETypes, mimeType.Type)
+				if seenMIMETypes[mimeType.Type] {
+					continue
+				}
+				seenMIMETypes[mimeType.Type] = true
+				profile.MIMETypes = append(profile.MIMETypes, &scriptMIMEType{
+					Type:        mimeType.Type,
+					Suffixes:    mimeType.Suffixes,
+					Description: mimeType.Description,
+				})
+			}
+			profile.Plugins = append(profile.Plugins, scriptPluginObj)
+		}
+	}
+
+	if b.SpeechSynthesis != nil {
+		profile.Voices = []*scriptVoice{}
+		for _, voice := range b.SpeechSynthesis {
+			profile.Voices = append(profile.Voices, &scriptVoice{
+				VoiceURI:     voice.VoiceURI,
+				Name:         voice.Name,
+				Lang:         voice.Lang,
+				LocalService: voice.LocalService,
+				Default:      voice.Default,
+			})
+		}
+	}
+
+	profile.GL = scriptGLParameters(b.Gl)
+	profile.GL2 = scriptGLParameters(b.Gl2)
+
+	data, err := json.Marshal(profile)
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal: %w", err)
+	}
+	// json.Marshal escapes <, > and &, so the profile can't close a surrounding script tag
+	return strings.Replace(spoofingScriptTemplate, "__PROFILE__", string(data), 1), nil
+}
+
+func scriptGLParameters(collection *Browser_BrowserCollection) map[string]*scriptGLParameter {
+	if collection == nil || len(collection.GlCapabilities) == 0 {
+		return nil
+	}
+
+	result := map[string]*scriptGLParameter{}
+	for name, capability := range collection.GlCapabilities {
+		if !glCapabilityNames[name] && !strings.HasPrefix(name, "MAX_") && !strings.HasPrefix(name, "MIN_") && !strings.HasPrefix(name, "ALIASED_") {
+			continue
+		}
+
+		parameter := &scriptGLParameter{Type: "value"}
+		if typedArray, ok := glTypedArrays[name]; ok {
+			parameter.Type = typedArray
+			values := make([]float64, 0, len(capability.FloatValue)+len(capability.IntValue))
+			values = append(values, capability.FloatValue...)
+			for _, value := range capability.IntValue {
+				values = append(values, float64(value))
+			}
+			parameter.Value = values
+		} else if len(capability.StringValue) > 0 {
+			parameter.Value = capability.StringValue[0]
+		} else if len(capability.FloatValue) > 0 {
+			parameter.Value = capability.FloatValue[0]
+		} else if len(capability.IntValue) > 0 {
+			parameter.Value = capability.IntValue[0]
+		} else if len(capability.BoolValue) > 0 {
+			parameter.Value = capability.BoolValue[0]
+		}
+		result[mustString(int(capability.EnumValue))] = parameter
+	}
+	return result
+}
+
+const spoofingScriptTemplate = `(() => {
+  'use strict';
+  const profile = __PROFILE__;
+
+  // Overrides report themselves as native code
+  const nativeSources = new WeakMap();
+  const nativeToString = Function.prototype.toString;
+  const markNative = (fn, name) => {
+    nativeSources.set(fn, 'function ' + name + '() { [native code] }');
+    return fn;
+  };
+  const toString = markNative(function toString() {
+    return nativeSources.has(this) ? nativeSources.get(this) : nativeToString.call(this);
+  }, 'toString');
+  Object.defineProperty(Function.prototype, 'toString', {value: toString, writable: true, configurable: true});
+
+  const clone = (value) => Array.isArray(value) ? Object.freeze(value.slice()) : value;
+  const defineGetters = (target, values) => {
+    if (!target || !values) {
+      return;
+    }
+    for (const key of Object.keys(values)) {
+      // Adding a property the engine doesn't have gives the override away
+      if (!(key in target)) {
+        continue;
+      }
+      const value = values[key];
+      Object.defineProperty(target, key, {
+        get: markNative(function () { return clone(value); }, 'get ' + key),
+        set: undefined,
+        enumerable: true,
+        configurable: true,
+      });
+    }
+  };
+  const defineMethod = (target, name, fn) => {
+    Object.defineProperty(target, name, {value: markNative(fn, name), writable: true, enumerable: true, configurable: true});
+  };
+
+  defineGetters(typeof Navigator !== 'undefined' && Navigator.prototype, profile.navigator);
+  defineGetters(typeof Screen !== 'undefined' && Screen.prototype, profile.screen);
+  defineGetters(typeof ScreenOrientation !== 'undefined' && ScreenOrientation.prototype, profile.orientation);
+  defineGetters(typeof window !== 'undefined' && window, profile.window);
+
+  if (profile.userAgentData && typeof NavigatorUAData !== 'undefined') {
+    const userAgentData = profile.userAgentData;
+    const lowEntropy = () => ({
+      brands: userAgentData.brands.map((brand) => ({...brand})),
+      mobile: userAgentData.mobile,
+      platform: userAgentData.platform,
+    });
+    defineGetters(NavigatorUAData.prototype, {brands: userAgentData.brands, mobile: userAgentData.mobile, platform: userAgentData.platform});
+    defineMethod(NavigatorUAData.prototype, 'getHighEntropyValues', function (hints) {
+      if (hints === null || hints === undefined || typeof hints[Symbol.iterator] !== 'function') {
+        return Promise.reject(new TypeError("Failed to execute 'getHighEntropyValues' on 'NavigatorUAData': The provided value cannot be converted to a sequence."));
+      }
+      const result = lowEntropy();
+      for (const hint of hints) {
+        if (Object.prototype.hasOwnProperty.call(userAgentData.highEntropy, hint)) {
+          const value = userAgentData.highEntropy[hint];
+          result[hint] = Array.isArray(value) ? value.map((item) => typeof item === 'object' ? {...item} : item) : value;
+        }
+      }
+      return Promise.resolve(result);
+    });
+    defineMethod(NavigatorUAData.prototype, 'toJSON', function () {
+      return lowEntropy();
+    });
+  }
+
+  if (profile.plugins && typeof PluginArray !== 'undefined' && typeof MimeTypeArray !== 'undefined') {
+    const makeArray = (proto, items, key) => {
+      const result = Object.create(proto);
+      items.forEach((item, index) => Object.defineProperty(result, index, {value: item, enumerable: true, configurable: true}));
+      for (const item of items) {
+        if (!Object.prototype.hasOwnProperty.call(result, item[key])) {
+          Object.defineProperty(result, item[key], {value: item, configurable: true});
+        }
+      }
+      Object.defineProperties(result, {
+        length: {get: markNative(() => items.length, 'get length'), configurable: true},
+        item: {value: markNative((index) => items[index >>> 0] || null, 'item'), configurable: true},
+        namedItem: {value: markNative((name) => items.find((item) => item[key] === name) || null, 'namedItem'), configurable: true},
+        [Symbol.iterator]: {value: markNative(function values() { return items[Symbol.iterator](); }, 'values'), configurable: true},
+      });
+      return result;
+    };
+    const makeObject = (proto, values) => {
+      const result = Object.create(proto);
+      for (const key of Object.keys(values)) {
+        Object.defineProperty(result, key, {value: values[key], enumerable: true, configurable: true});
+      }
+      return result;
+    };
+
+    const mimeTypes = profile.mimeTypes.map((mimeType) => makeObject(typeof MimeType !== 'undefined' ? MimeType.prototype : Object.prototype, mimeType));
+    const plugins = profile.plugins.map((plugin) => {
+      const pluginMimeTypes = plugin.mimeTypes.map((type) => mimeTypes.find((mimeType) => mimeType.type === type));
+      const result = makeArray(typeof Plugin !== 'undefined' ? Plugin.prototype : Object.prototype, pluginMimeTypes, 'type');
+      for (const key of ['name', 'filename', 'description']) {
+        Object.defineProperty(result, key, {value: plugin[key], enumerable: true, configurable: true});
+      }
+      return result;
+    });
+    // A MIME type points at the first plugin that handles it
+    for (const mimeType of mimeTypes) {
+      const enabledPlugin = plugins.find((plugin) => plugin.namedItem(mimeType.type) !== null) || null;
+      Object.defineProperty(mimeType, 'enabledPlugin', {value: enabledPlugin, enumerable: true, configurable: true});
+    }
+
+    const pluginArray = makeArray(PluginArray.prototype, plugins, 'name');
+    Object.defineProperty(pluginArray, 'refresh', {value: markNative(() => undefined, 'refresh'), configurable: true});
+    const mimeTypeArray = makeArray(MimeTypeArray.prototype, mimeTypes, 'type');
+    defineGetters(typeof Navigator !== 'undefined' && Navigator.prototype, {plugins: pluginArray, mimeTypes: mimeTypeArray});
+  }
+
+  if (profile.voices && typeof SpeechSynthesis !== 'undefined') {
+    const voices = profile.voices.map((voice) => {
+      const result = Object.create(typeof SpeechSynthesisVoice !== 'undefined' ? SpeechSynthesisVoice.prototype : Object.prototype);
+      for (const key of ['voiceURI', 'name', 'lang', 'localService', 'default']) {
+        Object.defineProperty(result, key, {value: voice[key], enumerable: true, configurable: true});
+      }
+      return result;
+    });
+    defineMethod(SpeechSynthesis.prototype, 'getVoices', function () {
+      return voices.slice();
+    });
+  }
+
+  const patchGL = (context, parameters) => {
+    if (typeof context === 'undefined' || !parameters) {
+      return;
+    }
+    const getParameter = context.prototype.getParameter;
+    defineMethod(context.prototype, 'getParameter', function (pname) {
+      if (!Object.prototype.hasOwnProperty.call(parameters, pname)) {
+        return getParameter.apply(this, arguments);
+      }
+      const parameter = parameters[pname];
+      switch (parameter.type) {
+        case 'Float32Array':
+          return new Float32Array(parameter.value);
+        case 'Int32Array':
+          return new Int32Array(parameter.value);
+        case 'Uint32Array':
+          return new Uint32Array(parameter.value);
+        default:
+          return parameter.value === undefined ? null : parameter.value;
+      }
+    });
+  };
+  patchGL(typeof WebGLRenderingContext !== 'undefined' ? WebGLRenderingContext : undefined, profile.gl);
+  patchGL(typeof WebGL2RenderingContext !== 'undefined' ? WebGL2RenderingContext : undefined, profile.gl2);
+})();
+`
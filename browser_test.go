@@ -1,15 +1,25 @@
 package device_utils
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"io"
 	"os"
+	"os/exec"
+	"reflect"
+	"strings"
 	"testing"
 )
 
 func TestBrowser_FromPEET(t *testing.T) {
-	browser := AvailableBrowsers["brave"]["1.50.114"]
+	browser, err := GetDBBrowser("brave", "1.50.114")
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	f, err := os.Open("./_resources/samples/peet_brave_120.json")
 	if err != nil {
@@ -32,7 +42,10 @@ func TestBrowser_FromPEET(t *testing.T) {
 }
 
 func TestBrowser_FromDLFingerprint(t *testing.T) {
-	browser := AvailableBrowsers["brave"]["1.50.114"]
+	browser, err := GetDBBrowser("brave", "1.50.114")
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	f, err := os.Open("./_resources/samples/fingerprint_brave_120.json")
 	if err != nil {
@@ -56,7 +69,10 @@ func TestBrowser_FromDLFingerprint(t *testing.T) {
 }
 
 func TestBrowser_FromBoth(t *testing.T) {
-	browser := AvailableBrowsers["brave"]["1.50.114"]
+	browser, err := GetDBBrowser("brave", "1.50.114")
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	f, err := os.Open("./_resources/samples/peet_brave_120.json")
 	if err != nil {
@@ -90,3 +106,777 @@ func TestBrowser_FromBoth(t *testing.T) {
 	}
 	os.WriteFile("test_full.json", newData, 0666)
 }
+
+func TestNewFirefoxBrowser(t *testing.T) {
+	browser, err := NewFirefoxBrowser("121.0", PlatformWindows)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open("./_resources/samples/peet_firefox_121.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	peetResponse := &PeetResponse{}
+	err = json.Unmarshal(data, peetResponse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sample := &Browser{}
+	err = sample.FromPEET(peetResponse)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if browser.UserAgent != peetResponse.UserAgent {
+		t.Error(fmt.Sprintf("User-Agent got: %s, want: %s", browser.UserAgent, peetResponse.UserAgent))
+	}
+	if browser.BrandHeader != "" {
+		t.Error("Firefox doesn't send client hints")
+	}
+	if browser.DoNotTrack != -2 {
+		t.Error(fmt.Sprintf("DoNotTrack got: %d, want: -2 for \"unspecified\"", browser.DoNotTrack))
+	}
+
+	// The sample resumed a session, so it carries pre_shared_key where a fresh handshake has session_ticket
+	ja3 := strings.Split(browser.TlsFingerprint.FormatTLSFingerprint(true), ",")
+	sampleJa3 := strings.Split(peetResponse.TLS.Ja3, ",")
+	ja3[2] = strings.Replace(ja3[2], "-35", "", 1)
+	sampleJa3[2] = strings.Replace(sampleJa3[2], "-41", "", 1)
+	for i := range sampleJa3 {
+		fmt.Println(fmt.Sprintf("JA3 section %d: %s", i, ja3[i]))
+		if ja3[i] != sampleJa3[i] {
+			t.Error(fmt.Sprintf("JA3 section %d got: %s, want: %s", i, ja3[i], sampleJa3[i]))
+		}
+	}
+	for _, sampleData := range sample.TlsFingerprint.ExtensionData {
+		// Key material is generated per connection
+		for _, keyShare := range sampleData.GetKeyShareExtension().GetKeyShares() {
+			keyShare.Data = nil
+		}
+		for _, data := range browser.TlsFingerprint.ExtensionData {
+			if data.ExtensionId == sampleData.ExtensionId && sampleData.ExtensionId != Browser_TLSFingerprint_EXTENSION_ENCRYPTED_CLIENT_HELLO && !proto.Equal(data, sampleData) {
+				t.Error(fmt.Sprintf("Extension data %s got: %v, want: %v", data.ExtensionId, data, sampleData))
+			}
+		}
+	}
+
+	if !proto.Equal(browser.HttpFingerprint.SettingsFrame, sample.HttpFingerprint.SettingsFrame) {
+		t.Error(fmt.Sprintf("SETTINGS got: %v, want: %v", browser.HttpFingerprint.SettingsFrame, sample.HttpFingerprint.SettingsFrame))
+	}
+	if akamai := browser.HttpFingerprint.FormatAkamaiFingerprint(); akamai != "1:65536,4:131072,5:16384|12517377|3:0:0:201,5:0:0:101,7:0:0:1,9:0:7:1,11:0:3:1,13:0:0:241|m,p,a,s" {
+		t.Error(fmt.Sprintf("Akamai got: %s", akamai))
+	}
+	if browser.HttpFingerprint.WindowUpdateIncrement != sample.HttpFingerprint.WindowUpdateIncrement {
+		t.Error(fmt.Sprintf("WINDOW_UPDATE got: %d, want: %d", browser.HttpFingerprint.WindowUpdateIncrement, sample.HttpFingerprint.WindowUpdateIncrement))
+	}
+	if strings.Join(browser.HttpFingerprint.PseudoHeaderOrder, ",") != strings.Join(sample.HttpFingerprint.PseudoHeaderOrder, ",") {
+		t.Error(fmt.Sprintf("Pseudo header order got: %v, want: %v", browser.HttpFingerprint.PseudoHeaderOrder, sample.HttpFingerprint.PseudoHeaderOrder))
+	}
+	if len(browser.HttpFingerprint.PriorityFrames) != len(sample.HttpFingerprint.PriorityFrames) {
+		t.Fatal(fmt.Sprintf("PRIORITY frames got: %d, want: %d", len(browser.HttpFingerprint.PriorityFrames), len(sample.HttpFingerprint.PriorityFrames)))
+	}
+	for i, priorityFrame := range sample.HttpFingerprint.PriorityFrames {
+		if !proto.Equal(browser.HttpFingerprint.PriorityFrames[i], priorityFrame) {
+			t.Error(fmt.Sprintf("PRIORITY frame %d got: %v, want: %v", i, browser.HttpFingerprint.PriorityFrames[i], priorityFrame))
+		}
+	}
+	headers := browser.RenderHeaders(RequestTypeNavigation)
+	if strings.Join(headers.Order(), ",") != strings.Join(sample.HttpFingerprint.HeaderOrder, ",") {
+		t.Error(fmt.Sprintf("Header order got: %v, want: %v", headers.Order(), sample.HttpFingerprint.HeaderOrder))
+	}
+}
+
+func TestNewSafariBrowser(t *testing.T) {
+	browser, err := NewSafariBrowser("17.2.0", PlatformIOS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(browser.UserAgent)
+	if browser.UserAgent != "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1" {
+		t.Error(fmt.Sprintf("User-Agent got: %s", browser.UserAgent))
+	}
+	if browser.GetFamily() != BrowserFamilySafari || browser.GetMajorVersion() != 17 {
+		t.Error(fmt.Sprintf("Family got: %s %d", browser.GetFamily(), browser.GetMajorVersion()))
+	}
+	if browser.DoNotTrack != -1 {
+		t.Error(fmt.Sprintf("DoNotTrack got: %d, want: -1 for null", browser.DoNotTrack))
+	}
+	for _, header := range browser.RenderHeaders(RequestTypeNavigation) {
+		if strings.HasPrefix(header[0], "sec-ch-") {
+			t.Error("Safari doesn't send client hints")
+		}
+	}
+	if akamai := browser.HttpFingerprint.FormatAkamaiFingerprint(); akamai != "2:0,4:4194304,3:100|10485760|0|m,s,p,a" {
+		t.Error(fmt.Sprintf("Akamai got: %s", akamai))
+	}
+
+	_, err = NewSafariBrowser("17.2", PlatformWindows)
+	if !errors.Is(err, ErrBrowserPlatformUnsupported) {
+		t.Error("Safari on Windows should be unsupported")
+	}
+}
+
+func TestSynthesizeChromiumBrowser(t *testing.T) {
+	fmt.Println(AvailableBrowserNames(), AvailableBrowserVersions("chrome"))
+
+	closest, err := GetClosestDBBrowser("chrome", "115.0.5790.171")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if closest.Version != "111.0.5563.147" {
+		t.Error(fmt.Sprintf("Closest got: %s", closest.Version))
+	}
+
+	base, err := GetDBBrowser("brave", "1.50.114")
+	if err != nil {
+		t.Fatal(err)
+	}
+	browser, err := SynthesizeChromiumBrowser("brave", 131)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(browser.UserAgent)
+	fmt.Println(browser.BrandHeader)
+	fmt.Println(browser.TlsFingerprint.FormatTLSFingerprint(true))
+	if browser.GetMajorVersion() != 131 || browser.BrandHeader != GenerateBrandHeader("Brave", 131) {
+		t.Error("UA or brand header not updated")
+	}
+	if _, ok := browser.TlsFingerprint.getExtensionIndex(Browser_TLSFingerprint_EXTENSION_ENCRYPTED_CLIENT_HELLO); !ok {
+		t.Error("ECH should be added from Chromium 117")
+	}
+	if browser.TlsFingerprint.EllipticCurves[0] != 4588 {
+		t.Error("X25519MLKEM768 should be preferred from Chromium 131")
+	}
+	if catalogEntry, _ := GetDBBrowser("brave", "1.50.114"); !proto.Equal(base, catalogEntry) {
+		t.Error("The catalog entry was modified")
+	}
+
+	// A base enriched with a DL fingerprint has its navigator.userAgentData regenerated too
+	data, err := os.ReadFile("./_resources/samples/fingerprint_brave_120.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	enriched := proto.Clone(base).(*Browser)
+	if err = enriched.FromDLFingerprintRaw(data); err != nil {
+		t.Fatal(err)
+	}
+	browser = proto.Clone(enriched).(*Browser)
+	synthesizeChromiumVersion(browser, "opera", "Opera", 124)
+	if FormatBrandHeader(browser.HighEntropyValues.Brands) != GenerateBrandHeader("Opera", 124) || browser.HighEntropyValues.UsFullVersion != "124.0.0.0" {
+		t.Error(fmt.Sprintf("High entropy values got: %v", browser.HighEntropyValues))
+	}
+	var consistencyErr *ConsistencyError
+	if errors.As(browser.CheckConsistency(), &consistencyErr) {
+		for _, fieldErr := range consistencyErr.Errors {
+			if strings.HasPrefix(fieldErr.Path, "highEntropyValues") || strings.HasPrefix(fieldErr.Path, "appVersion") || strings.HasPrefix(fieldErr.Path, "brandHeader") {
+				t.Error(fieldErr)
+			}
+		}
+	}
+
+	// A PEET import carries supported_groups and key_share data, which have to move along with the curves
+	data, err = os.ReadFile("./_resources/samples/peet_brave_120.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	browser = &Browser{}
+	if err = browser.FromPEETRaw(data); err != nil {
+		t.Fatal(err)
+	}
+	synthesizeChromiumVersion(browser, "brave", "Brave", 131)
+	supportedGroups, ok := browser.TlsFingerprint.getExtensionData(Browser_TLSFingerprint_SUPPORTED_GROUPS)
+	if !ok {
+		t.Fatal("The sample has no supported_groups")
+	}
+	groups := map[Browser_TLSFingerprint_EllipticCurve]bool{}
+	for _, group := range supportedGroups.SupportedGroups.GetGroups() {
+		groups[group] = true
+	}
+	if fmt.Sprint(supportedGroups.SupportedGroups.GetGroups()) != fmt.Sprint(browser.TlsFingerprint.EllipticCurves) {
+		t.Error(fmt.Sprintf("supported_groups got: %v, curves: %v", supportedGroups.SupportedGroups.GetGroups(), browser.TlsFingerprint.EllipticCurves))
+	}
+	keyShares, _ := browser.TlsFingerprint.getExtensionData(Browser_TLSFingerprint_KEY_SHARE)
+	for _, keyShare := range keyShares.GetKeyShareExtension().GetKeyShares() {
+		if !groups[keyShare.Group] {
+			t.Error(fmt.Sprintf("key_share %s is missing from supported_groups", keyShare.Group))
+		}
+	}
+	if !groups[4588] || groups[25497] {
+		t.Error(fmt.Sprintf("supported_groups got: %v, want X25519MLKEM768 instead of X25519Kyber768Draft00", supportedGroups.SupportedGroups.GetGroups()))
+	}
+}
+
+func TestBrowser_FromPEETErrors(t *testing.T) {
+	browser, err := GetDBBrowser("brave", "1.50.114")
+	if err != nil {
+		t.Fatal(err)
+	}
+	original := proto.Clone(browser)
+
+	data := []byte(`{"tls":{"ja3":"771,4865-x,0-13,29,0","extensions":[{"name":"signature_algorithms (13)","signature_algorithms":["ecdsa_secp256r1_sha256","rsa_made_up"]},{"name":"no id"}]},"http2":{"akamai_fingerprint":"1:65536,9:x|abc|0|m,p,x","sent_frames":[{"frame_type":"WINDOW_UPDATE"}]}}`)
+	err = browser.FromPEETRaw(data)
+	importErr := &ImportError{}
+	if !errors.As(err, &importErr) {
+		t.Fatal(fmt.Sprintf("Expected an ImportError, got: %v", err))
+	}
+	fmt.Println(err)
+	paths := []string{}
+	for _, fieldErr := range importErr.Errors {
+		paths = append(paths, fieldErr.Path)
+	}
+	expected := []string{
+		"tls.ja3[1][1]",
+		"tls.extensions[0].signature_algorithms[1]",
+		"tls.extensions[1].name",
+		"http2.akamai_fingerprint[0][1]",
+		"http2.akamai_fingerprint[1]",
+		"http2.akamai_fingerprint[3][2]",
+		"http2.sent_frames[0].increment",
+	}
+	if strings.Join(paths, ",") != strings.Join(expected, ",") {
+		t.Error(fmt.Sprintf("Paths got: %v, want: %v", paths, expected))
+	}
+	if !errors.Is(err, ErrImportMalformed) || !errors.Is(err, ErrImportUnknown) {
+		t.Error("Expected both malformed and unknown errors")
+	}
+	if !proto.Equal(browser, original) {
+		t.Error("A failed import modified the browser")
+	}
+
+	// Lenient mode only skips what is unknown
+	err = browser.FromPEETRaw(data, true)
+	if !errors.As(err, &importErr) || len(importErr.Errors) != 5 || errors.Is(err, ErrImportUnknown) {
+		t.Error(fmt.Sprintf("Lenient got: %v", err))
+	}
+
+	data = []byte(`{"gl":{"VERSION":["WebGL 1.0",7938],"EMPTY":[],"BAD_ENUM":["x"],"BAD_INDEX":[{"5":1},1],"NULL":[null,1]}}`)
+	err = browser.FromDLFingerprintRaw(data)
+	if !errors.As(err, &importErr) || len(importErr.Errors) != 4 {
+		t.Error(fmt.Sprintf("DL got: %v", err))
+	}
+	fmt.Println(err)
+	err = browser.FromDLFingerprintRaw([]byte(`{"gl":{"VERSION":["WebGL 1.0",7938],"NULL":[null,1]}}`), true)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func FuzzFromPEETRaw(f *testing.F) {
+	for _, sample := range []string{"peet_brave_120.json", "peet_firefox_121.json"} {
+		data, err := os.ReadFile("./_resources/samples/" + sample)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data, false)
+	}
+	f.Add([]byte(`{"tls":{"ja3":"771,,,,"},"http2":{"akamai_fingerprint":"|||","sent_frames":[{"frame_type":"SETTINGS","priority":{}}]}}`), true)
+	f.Add([]byte(`{"tls":{"extensions":[{"name":")("},{"name":"key_share (51)","shared_keys":[{"(":""}]}]}}`), false)
+
+	f.Fuzz(func(t *testing.T, data []byte, lenient bool) {
+		browser := &Browser{}
+		err := browser.FromPEETRaw(data, lenient)
+		if err == nil && browser.TlsFingerprint == nil {
+			t.Error("A successful import has to set the TLS fingerprint")
+		}
+	})
+}
+
+func FuzzFromDLFingerprintRaw(f *testing.F) {
+	data, err := os.ReadFile("./_resources/samples/fingerprint_brave_120.json")
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(data, false)
+	f.Add([]byte(`{"gl":{"A":[],"B":[{"-1":true},1],"C":[[[{"0":{"1":2}}]],"x"]},"HighEntropyValues":null}`), true)
+
+	f.Fuzz(func(t *testing.T, data []byte, lenient bool) {
+		browser := &Browser{}
+		_ = browser.FromDLFingerprintRaw(data, lenient)
+	})
+}
+
+func TestBrowser_FromPEETExtensions(t *testing.T) {
+	for _, sample := range []string{"peet_brave_120.json", "peet_firefox_121.json"} {
+		data, err := os.ReadFile("./_resources/samples/" + sample)
+		if err != nil {
+			t.Fatal(err)
+		}
+		peetResponse := &PeetResponse{}
+		err = json.Unmarshal(data, peetResponse)
+		if err != nil {
+			t.Fatal(err)
+		}
+		browser := &Browser{}
+		err = browser.FromPEET(peetResponse)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		grease := strings.Contains(peetResponse.TLS.Peetprint, "GREASE")
+		peetprint := browser.TlsFingerprint.FormatPeetprint(grease)
+		fmt.Println(peetprint)
+		if peetprint != peetResponse.TLS.Peetprint {
+			t.Error(fmt.Sprintf("%s peetprint got: %s, want: %s", sample, peetprint, peetResponse.TLS.Peetprint))
+		}
+		// tls.peet.ws hashes the last JA4 section differently from the spec, so only the first two are compared
+		ja4 := browser.TlsFingerprint.FormatJA4()
+		fmt.Println(ja4)
+		if ja4[:strings.LastIndex(ja4, "_")] != peetResponse.TLS.Ja4[:strings.LastIndex(peetResponse.TLS.Ja4, "_")] {
+			t.Error(fmt.Sprintf("%s JA4 got: %s, want: %s", sample, ja4, peetResponse.TLS.Ja4))
+		}
+
+		// Every extension that has parameters has to keep them
+		for _, extension := range browser.TlsFingerprint.Extensions {
+			switch extension {
+			case Browser_TLSFingerprint_SERVER_NAME, Browser_TLSFingerprint_EC_POINT_FORMATS, Browser_TLSFingerprint_SIGNED_CERTIFICATE_TIMESTAMP, Browser_TLSFingerprint_EXTENDED_MASTER_SECRET, Browser_TLSFingerprint_PRE_SHARED_KEY:
+				continue
+			}
+			if _, ok := browser.TlsFingerprint.getExtensionData(extension); !ok {
+				t.Error(fmt.Sprintf("%s lost the data of %s", sample, extension))
+			}
+		}
+		for _, extensionData := range browser.TlsFingerprint.ExtensionData {
+			for _, keyShare := range extensionData.GetKeyShareExtension().GetKeyShares() {
+				if len(keyShare.Data) == 0 {
+					t.Error(fmt.Sprintf("%s lost the key share of %s", sample, keyShare.Group))
+				}
+			}
+			if ech := extensionData.GetExtensionEncryptedClientHello(); ech != nil && (len(ech.CandidateCipherSuites) != 1 || len(ech.CandidatePayloadLens) != 1) {
+				t.Error(fmt.Sprintf("%s ECH got: %v", sample, ech))
+			}
+		}
+	}
+}
+
+func TestBrowser_FromPEETFrames(t *testing.T) {
+	data, err := os.ReadFile("./_resources/samples/peet_firefox_121.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	browser := &Browser{}
+	err = browser.FromPEETRaw(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	frameTypes := []string{}
+	for _, frame := range browser.HttpFingerprint.Frames {
+		frameTypes = append(frameTypes, frame.Type.String())
+	}
+	fmt.Println(frameTypes)
+	if strings.Join(frameTypes, ",") != "SETTINGS,WINDOW_UPDATE,PRIORITY,PRIORITY,PRIORITY,PRIORITY,PRIORITY,PRIORITY,HEADERS" {
+		t.Error(fmt.Sprintf("Frames got: %v", frameTypes))
+	}
+	headersFrame := browser.HttpFingerprint.Frames[len(browser.HttpFingerprint.Frames)-1]
+	if headersFrame.Flags != 0x25 || headersFrame.HeaderOrder[0] != ":method" {
+		t.Error(fmt.Sprintf("HEADERS got: %v", headersFrame))
+	}
+	expected := &Browser_HTTPFingerprint_PriorityFrameOpts{StreamId: 15, StreamDep: 13, Exclusive: false, Weight: 42}
+	if !proto.Equal(browser.HttpFingerprint.HeaderFramePriority, expected) {
+		t.Error(fmt.Sprintf("HEADERS priority got: %v, want: %v", browser.HttpFingerprint.HeaderFramePriority, expected))
+	}
+
+	// Exclusive is the second field of an Akamai PRIORITY entry, GREASE settings keep their place
+	data = []byte(`{"tls":{"ja3":"771,4865,0,29,0"},"http2":{"akamai_fingerprint":"1:65536|15663105|3:1:0:201|m,a,s,p","sent_frames":[{"frame_type":"SETTINGS","settings":["HEADER_TABLE_SIZE = 65536","UNKNOWN_SETTING_2570 = 1","NO_RFC7540_PRIORITIES = 1"]},{"frame_type":"HEADERS","stream_id":1,"headers":[":method: GET","user-agent: x"],"flags":["EndHeaders (0x4)","Priority (0x20)"],"priority":{"weight":256,"depends_on":0,"exclusive":1}}]}}`)
+	err = browser.FromPEETRaw(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !browser.HttpFingerprint.PriorityFrames[0].Exclusive || browser.HttpFingerprint.PriorityFrames[0].StreamDep != 0 {
+		t.Error(fmt.Sprintf("PRIORITY got: %v", browser.HttpFingerprint.PriorityFrames[0]))
+	}
+	settings := browser.HttpFingerprint.Frames[0].Settings
+	if len(settings) != 3 || settings[1].Id != 2570 || settings[2].Id != 9 {
+		t.Error(fmt.Sprintf("SETTINGS got: %v", settings))
+	}
+	expected = &Browser_HTTPFingerprint_PriorityFrameOpts{StreamId: 1, StreamDep: 0, Exclusive: true, Weight: 256}
+	if !proto.Equal(browser.HttpFingerprint.HeaderFramePriority, expected) {
+		t.Error(fmt.Sprintf("HEADERS priority got: %v, want: %v", browser.HttpFingerprint.HeaderFramePriority, expected))
+	}
+}
+
+func TestBrowser_HTTPFingerprintSettings(t *testing.T) {
+	for _, sample := range []string{"peet_brave_120.json", "peet_firefox_121.json"} {
+		data, err := os.ReadFile("./_resources/samples/" + sample)
+		if err != nil {
+			t.Fatal(err)
+		}
+		peetResponse := &PeetResponse{}
+		err = json.Unmarshal(data, peetResponse)
+		if err != nil {
+			t.Fatal(err)
+		}
+		browser := &Browser{}
+		err = browser.FromPEET(peetResponse)
+		if err != nil {
+			t.Fatal(err)
+		}
+		akamai := browser.HttpFingerprint.FormatAkamaiFingerprint()
+		fmt.Println(akamai)
+		if akamai != peetResponse.Http2.AkamaiFingerprint {
+			t.Error(fmt.Sprintf("%s Akamai got: %s, want: %s", sample, akamai, peetResponse.Http2.AkamaiFingerprint))
+		}
+	}
+
+	// Order, newer parameters and GREASE IDs have to survive
+	akamai := "2:0,1:65536,4:6291456,6:262144,9:1,43690:7|15663105|0|m,a,s,p"
+	browser := &Browser{}
+	err := browser.FromPEET(&PeetResponse{TLS: TLS{Ja3: "771,4865,0,29,0"}, Http2: &Http2{AkamaiFingerprint: akamai}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if browser.HttpFingerprint.FormatAkamaiFingerprint() != akamai {
+		t.Error(fmt.Sprintf("Akamai got: %s, want: %s", browser.HttpFingerprint.FormatAkamaiFingerprint(), akamai))
+	}
+	legacy := browser.HttpFingerprint.SettingsFrame
+	if legacy.EnablePush != 0 || legacy.MaxConcurrentStreams != -1 || legacy.MaxHeaderListSize != 262144 {
+		t.Error(fmt.Sprintf("Legacy SETTINGS got: %v", legacy))
+	}
+	settings := SettingsFromLegacy(legacy)
+	if len(settings) != 4 || settings[0].Id != SettingHeaderTableSize || settings[1].Id != SettingEnablePush {
+		t.Error(fmt.Sprintf("SETTINGS from legacy got: %v", settings))
+	}
+
+	browser.HttpFingerprint.SetSetting(SettingMaxConcurrentStreams, 1000)
+	browser.HttpFingerprint.RemoveSetting(SettingNoRFC7540Priorities)
+	if !strings.HasPrefix(browser.HttpFingerprint.FormatAkamaiFingerprint(), "2:0,1:65536,3:1000,4:6291456,6:262144,43690:7|") {
+		t.Error(fmt.Sprintf("Edited SETTINGS got: %s", browser.HttpFingerprint.FormatAkamaiFingerprint()))
+	}
+}
+
+// spoofingScriptHarness Stubs the browser classes the spoofing script patches and prints what a page would see
+const spoofingScriptHarness = `
+const props = (names) => Object.fromEntries(names.map((name) => [name, {get() { return 'native'; }, configurable: true, enumerable: true}]));
+class Navigator {}
+Object.defineProperties(Navigator.prototype, props(['userAgent', 'appVersion', 'platform', 'vendor', 'language', 'languages', 'hardwareConcurrency', 'deviceMemory', 'webdriver', 'doNotTrack', 'plugins', 'mimeTypes']));
+class Screen {}
+Object.defineProperties(Screen.prototype, props(['width', 'height', 'availWidth', 'availHeight', 'colorDepth']));
+class NavigatorUAData {}
+class PluginArray {}
+class MimeTypeArray {}
+class Plugin {}
+class MimeType {}
+class SpeechSynthesis { getVoices() { return []; } }
+class SpeechSynthesisVoice {}
+class WebGLRenderingContext { getParameter(pname) { return 'native ' + pname; } }
+class WebGL2RenderingContext { getParameter(pname) { return 'native ' + pname; } }
+globalThis.window = globalThis;
+globalThis.devicePixelRatio = 1;
+globalThis.innerWidth = 800;
+Object.assign(globalThis, {Navigator, Screen, NavigatorUAData, PluginArray, MimeTypeArray, Plugin, MimeType, SpeechSynthesis, SpeechSynthesisVoice, WebGLRenderingContext, WebGL2RenderingContext});
+require('vm').runInThisContext(require('fs').readFileSync(0, 'utf8'));
+const navigator = new Navigator();
+const gl = new WebGLRenderingContext();
+new NavigatorUAData().getHighEntropyValues(['platformVersion', 'fullVersionList', 'wow64']).then((highEntropy) => console.log(JSON.stringify({
+  userAgent: navigator.userAgent,
+  languages: navigator.languages,
+  hardwareConcurrency: navigator.hardwareConcurrency,
+  webdriver: navigator.webdriver,
+  doNotTrack: navigator.doNotTrack,
+  hasProduct: 'product' in Navigator.prototype,
+  width: new Screen().width,
+  devicePixelRatio: window.devicePixelRatio,
+  innerWidth: window.innerWidth,
+  platformVersion: highEntropy.platformVersion,
+  fullVersionList: highEntropy.fullVersionList.length,
+  wow64: highEntropy.wow64,
+  plugins: navigator.plugins.length,
+  pluginName: navigator.plugins[0].name,
+  pluginMimeTypes: navigator.plugins[0].length,
+  mimeTypes: navigator.mimeTypes.length,
+  enabledPlugin: navigator.mimeTypes[0].enabledPlugin === navigator.plugins[0],
+  voices: new SpeechSynthesis().getVoices().length,
+  renderer: gl.getParameter(37446),
+  maxViewportDims: Array.from(gl.getParameter(3386)),
+  isTypedArray: gl.getParameter(33902) instanceof Float32Array,
+  viewport: gl.getParameter(2978),
+  getParameter: WebGLRenderingContext.prototype.getParameter.toString(),
+})));
+`
+
+func TestBrowser_GenerateSpoofingScript(t *testing.T) {
+	file, err := os.Open("./_resources/samples/fingerprint_brave_120.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	browser := &Browser{}
+	err = browser.FromDLFingerprintRaw(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	browser.Wow64 = true
+	browser.Webdriver = true
+	script, err := browser.GenerateSpoofingScript()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(len(script))
+
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("node is required to run the script")
+	}
+	cmd := exec.Command("node", "-e", spoofingScriptHarness)
+	cmd.Stdin = strings.NewReader(script)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatal(err, string(output))
+	}
+	fmt.Println(string(output))
+
+	result := map[string]any{}
+	err = json.Unmarshal(output, &result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mimeTypes := map[string]bool{}
+	for _, plugin := range browser.Plugins {
+		for mimeType := range plugin.SupportedMIMETypes {
+			mimeTypes[mimeType] = true
+		}
+	}
+	expected := map[string]any{
+		"userAgent":           browser.UserAgent,
+		"languages":           []any{"en-US"},
+		"hardwareConcurrency": float64(browser.HardwareConcurrency),
+		"webdriver":           false,
+		"doNotTrack":          nil,
+		"hasProduct":          false,
+		"width":               float64(browser.Screen.Width),
+		"devicePixelRatio":    browser.DevicePixelRatio,
+		"innerWidth":          float64(browser.InnerWidth),
+		"platformVersion":     browser.HighEntropyValues.PlatformVersion,
+		"fullVersionList":     float64(len(browser.HighEntropyValues.FullVersionList)),
+		"wow64":               true,
+		"plugins":             float64(len(browser.Plugins)),
+		"pluginName":          browser.Plugins[0].Name,
+		"pluginMimeTypes":     float64(len(browser.Plugins[0].SupportedMIMETypes)),
+		"mimeTypes":           float64(len(mimeTypes)),
+		"enabledPlugin":       true,
+		"voices":              float64(len(browser.SpeechSynthesis)),
+		"renderer":            browser.Gl.GlCapabilities["UNMASKED_RENDERER_WEBGL"].StringValue[0],
+		"maxViewportDims":     []any{float64(32767), float64(32767)},
+		"isTypedArray":        true,
+		"viewport":            "native 2978",
+		"getParameter":        "function getParameter() { [native code] }",
+	}
+	for key, value := range expected {
+		if fmt.Sprint(result[key]) != fmt.Sprint(value) {
+			t.Error(fmt.Sprintf("%s got: %v, expected: %v", key, result[key], value))
+		}
+	}
+}
+
+func TestBrowser_ToDLFingerprint(t *testing.T) {
+	data, err := os.ReadFile("./_resources/samples/fingerprint_brave_120.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	browser := &Browser{}
+	err = browser.FromDLFingerprintRaw(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Stored profiles go through protojson, the export has to survive it too
+	stored, err := protojson.Marshal(browser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storedBrowser := &Browser{}
+	err = protojson.Unmarshal(stored, storedBrowser)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]any{}
+	err = json.Unmarshal(data, &expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, current := range map[string]*Browser{"imported": browser, "stored": storedBrowser} {
+		newData, err := current.ToDLFingerprintRaw()
+		if err != nil {
+			t.Fatal(err)
+		}
+		result := map[string]any{}
+		err = json.Unmarshal(newData, &result)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for key, value := range expected {
+			if !reflect.DeepEqual(result[key], value) {
+				t.Error(fmt.Sprintf("%s %s got: %.200v, expected: %.200v", name, key, result[key], value))
+			}
+		}
+		for key := range result {
+			if _, ok := expected[key]; !ok {
+				t.Error(fmt.Sprintf("%s %s is not in the original", name, key))
+			}
+		}
+	}
+}
+
+func TestDiffBrowsers(t *testing.T) {
+	// Every rule has to name an existing field or message
+	for name := range diffSeverities {
+		if _, err := protoregistry.GlobalFiles.FindDescriptorByName(name); err != nil {
+			t.Error(name, err)
+		}
+	}
+	for name := range diffOrderSeverities {
+		if _, err := protoregistry.GlobalFiles.FindDescriptorByName(name); err != nil {
+			t.Error(name, err)
+		}
+	}
+	for name := range diffKeyFields {
+		if _, err := protoregistry.GlobalFiles.FindDescriptorByName(name); err != nil {
+			t.Error(name, err)
+		}
+	}
+
+	data, err := os.ReadFile("./_resources/samples/peet_brave_120.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := &Browser{HardwareConcurrency: 8}
+	err = base.FromPEETRaw(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := DiffBrowsers(base, base); len(diff.Differences) != 0 {
+		t.Error(fmt.Sprintf("Identical browsers got: %s", diff))
+	}
+
+	changed := proto.Clone(base).(*Browser)
+	changed.HardwareConcurrency = 9
+	changed.TlsFingerprint.CipherSuites = changed.TlsFingerprint.CipherSuites[1:]
+	extensions := changed.TlsFingerprint.Extensions
+	extensions[0], extensions[1] = extensions[1], extensions[0]
+	changed.HttpFingerprint.SetSetting(SettingMaxConcurrentStreams, 100)
+
+	diff := DiffBrowsers(base, changed)
+	fmt.Println(diff)
+	expected := map[string]*Difference{
+		"hardwareConcurrency":                                {Kind: DiffKindChanged, Severity: DiffSeverityMedium, Old: "8", New: "9"},
+		"tlsFingerprint.cipherSuites":                        {Kind: DiffKindElements, Severity: DiffSeverityHigh, Missing: []string{base.TlsFingerprint.CipherSuites[0].String()}, Extra: []string{}},
+		"tlsFingerprint.extensions":                          {Kind: DiffKindReordered, Severity: DiffSeverityLow},
+		"httpFingerprint.settings":                           {Kind: DiffKindElements, Severity: DiffSeverityHigh, Missing: []string{}, Extra: []string{"3"}},
+		"httpFingerprint.settingsFrame.maxConcurrentStreams": {Kind: DiffKindChanged, Severity: DiffSeverityInfo, Old: "-1", New: "100"},
+	}
+	if len(diff.Differences) != len(expected) {
+		t.Error(fmt.Sprintf("Got %d differences, expected %d", len(diff.Differences), len(expected)))
+	}
+	for _, difference := range diff.Differences {
+		want, ok := expected[difference.Path]
+		if !ok {
+			t.Error(fmt.Sprintf("Unexpected difference: %s", difference))
+			continue
+		}
+		if difference.Kind != want.Kind || difference.Severity != want.Severity {
+			t.Error(fmt.Sprintf("%s got: %s %s, expected: %s %s", difference.Path, difference.Kind, difference.Severity, want.Kind, want.Severity))
+		}
+		if want.Old != "" && (difference.Old != want.Old || difference.New != want.New) {
+			t.Error(fmt.Sprintf("%s got: %s -> %s", difference.Path, difference.Old, difference.New))
+		}
+		if want.Missing != nil && (fmt.Sprint(difference.Missing) != fmt.Sprint(want.Missing) || fmt.Sprint(difference.Extra) != fmt.Sprint(want.Extra)) {
+			t.Error(fmt.Sprintf("%s got: missing %v, extra %v", difference.Path, difference.Missing, difference.Extra))
+		}
+	}
+	if diff.MaxSeverity() != DiffSeverityHigh || len(diff.Filter(DiffSeverityMedium).Differences) != 3 {
+		t.Error("Wrong severity summary")
+	}
+	if !strings.HasPrefix(diff.String(), "[HIGH]") {
+		t.Error("The most severe differences have to come first")
+	}
+}
+
+func TestBrowser_CheckConsistency(t *testing.T) {
+	for _, name := range AvailableBrowserNames() {
+		for _, version := range AvailableBrowserVersions(name) {
+			browser, err := GetDBBrowser(name, version)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = browser.CheckConsistency(); err != nil {
+				t.Error(name, version, err)
+			}
+		}
+	}
+	firefox, err := NewFirefoxBrowser("121.0", PlatformMac)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = firefox.CheckConsistency(); err != nil {
+		t.Error(err)
+	}
+	// Without a profile of the same major version in the catalog the network fingerprints can't be checked
+	firefox.TlsFingerprint.CipherSuites = firefox.TlsFingerprint.CipherSuites[1:]
+	if err = firefox.CheckConsistency(); !errors.Is(err, ErrBrowserInconsistent) {
+		t.Error(fmt.Sprintf("Expected the cipher suites of Firefox 121 to be checked, got: %v", err))
+	}
+	firefox, err = NewFirefoxBrowser("128.0", PlatformMac)
+	if err != nil {
+		t.Fatal(err)
+	}
+	firefox.TlsFingerprint.CipherSuites = firefox.TlsFingerprint.CipherSuites[1:]
+	if err = firefox.CheckConsistency(); err != nil {
+		t.Error(err)
+	}
+
+	browser, err := GetDBBrowser("brave", "1.50.114")
+	if err != nil {
+		t.Fatal(err)
+	}
+	browser.Name = "chrome"
+	browser.HighEntropyValues = &Browser_HighEntropyValues{
+		Platform:        "macOS",
+		UsFullVersion:   "120.0.6099.71",
+		Brands:          []*Browser_HighEntropyValues_Brand{{Brand: "Chromium", Version: "120"}},
+		FullVersionList: []*Browser_HighEntropyValues_Brand{{Brand: "Chromium", Version: "120.0.6099.71"}},
+	}
+	browser.Platform = "MacIntel"
+	browser.AppVersion = "5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+	browser.DeviceMemory = 16
+	browser.Screen = &Browser_BrowserScreen{Width: 1280, Height: 720, AvailWidth: 1280, AvailHeight: 680}
+	browser.OuterWidth = 1920
+	browser.OuterHeight = 700
+	browser.InnerWidth = 1920
+	browser.InnerHeight = 600
+	browser.TlsFingerprint.CipherSuites = browser.TlsFingerprint.CipherSuites[1:]
+
+	err = browser.CheckConsistency()
+	consistencyErr := &ConsistencyError{}
+	if !errors.As(err, &consistencyErr) || !errors.Is(err, ErrBrowserInconsistent) {
+		t.Fatal(fmt.Sprintf("Expected a ConsistencyError, got: %v", err))
+	}
+	fmt.Println(err)
+	paths := []string{}
+	for _, fieldErr := range consistencyErr.Errors {
+		paths = append(paths, fieldErr.Path)
+	}
+	expected := []string{
+		"brandHeader",
+		"highEntropyValues.brands[0]",
+		"highEntropyValues.fullVersionList[0]",
+		"highEntropyValues.usFullVersion",
+		"highEntropyValues.platform",
+		"platform",
+		"appVersion",
+		"deviceMemory",
+		"outerWidth",
+		"tlsFingerprint.cipherSuites",
+	}
+	if strings.Join(paths, ",") != strings.Join(expected, ",") {
+		t.Error(fmt.Sprintf("Paths got: %v, want: %v", paths, expected))
+	}
+}
@@ -151,6 +151,8 @@ func GetLatestChromium(index int, args ...string) (*ChromiumVersion, error) {
 	}
 }
 
+// MustChromiumHeaders Renders the fetch headers of the latest Chrome, or of defaultMajorVersion when the version history is
+// unreachable, as an unordered http.Header with lowercase keys. Browser.RenderHeaders keeps the order the browser sends them in
 func MustChromiumHeaders(brand string, defaultMajorVersion int, withFullVersions bool) http.Header {
 	if brand == "" {
 		brand = "Google Chrome"
@@ -167,20 +169,21 @@ func MustChromiumHeaders(brand string, defaultMajorVersion int, withFullVersions
 		}
 	}
 
-	result := http.Header{
-		"user-agent":       {fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s Safari/537.36", latest.GetUAVersion())},
-		"sec-ch-ua":        {GenerateBrandHeader(brand, latest.GetMajorVersion())},
-		"sec-ch-ua-mobile": {"?0"},
-		"priority":         {"u=1, i"},
+	browser := &Browser{
+		UserAgent:   fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s Safari/537.36", latest.GetUAVersion()),
+		BrandHeader: GenerateBrandHeader(brand, latest.GetMajorVersion()),
 	}
-
+	extra := http.Header{}
 	if withFullVersions {
-		result["sec-ch-ua-platform"] = []string{"\"Windows\""}
-		result["sec-ch-ua-arch"] = []string{"\"x86\""}
-		result["sec-ch-ua-platform-version"] = []string{"\"19.0.0\""}
-		result["sec-ch-ua-model"] = []string{""}
-		result["sec-ch-ua-full-version-list"] = []string{GenerateBrandHeader(brand, latest.GetMajorVersion())}
+		extra["sec-ch-ua-arch"] = []string{"\"x86\""}
+		extra["sec-ch-ua-platform-version"] = []string{"\"19.0.0\""}
+		extra["sec-ch-ua-model"] = []string{"\"\""}
+		extra["sec-ch-ua-full-version-list"] = []string{browser.BrandHeader}
 	}
 
+	result := http.Header{}
+	for _, header := range browser.RenderHeaders(RequestTypeFetch, extra) {
+		result[header[0]] = append(result[header[0]], header[1])
+	}
 	return result
 }
@@ -2,7 +2,11 @@ package device_utils
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"os"
+	"strings"
 	"testing"
 )
 
@@ -31,6 +35,81 @@ func TestGenerateBrandHeader(t *testing.T) {
 	}
 }
 
+func TestParseBrandHeader(t *testing.T) {
+	type testArgs struct {
+		header string
+		brands []*Browser_HighEntropyValues_Brand
+		err    error
+	}
+
+	testCases := []testArgs{
+		{
+			header: `"Not_A Brand";v="8.0.0.0", "Chromium";v="120.0.6099.71", "Brave";v="120.0.6099.71"`,
+			brands: []*Browser_HighEntropyValues_Brand{{Brand: "Not_A Brand", Version: "8.0.0.0"}, {Brand: "Chromium", Version: "120.0.6099.71"}, {Brand: "Brave", Version: "120.0.6099.71"}},
+		},
+		{
+			header: ` "Quoted \"Brand\" \\ Escaped";v="1";extra=?1,"Chromium" ;v="2"`,
+			err:    ErrStructuredHeaderMalformed,
+		},
+		{
+			header: `"Quoted \"Brand\" \\ Escaped";v="1";extra=?1,"Chromium";v="2"`,
+			brands: []*Browser_HighEntropyValues_Brand{{Brand: `Quoted "Brand" \ Escaped`, Version: "1"}, {Brand: "Chromium", Version: "2"}},
+		},
+		{header: `"Chromium";v="120",`, err: ErrStructuredHeaderMalformed},
+		{header: `"Chromium"`, err: ErrStructuredHeaderMalformed},
+		{header: `Chromium;v="120"`, err: ErrStructuredHeaderMalformed},
+		{header: `"Chromium";v=120`, err: ErrStructuredHeaderMalformed},
+		{header: `"Chromium";v="120`, err: ErrStructuredHeaderMalformed},
+	}
+
+	for i, testCase := range testCases {
+		brands, err := ParseBrandHeader(testCase.header)
+		fmt.Println(fmt.Sprintf("Test %d parsed: %v, %v", i, brands, err))
+		if !errors.Is(err, testCase.err) {
+			t.Error(fmt.Sprintf("Test %d failed got: %v, want: %v", i, err, testCase.err))
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if len(brands) != len(testCase.brands) {
+			t.Error(fmt.Sprintf("Test %d failed got %d brands, want: %d", i, len(brands), len(testCase.brands)))
+			continue
+		}
+		for j, brand := range brands {
+			if brand.Brand != testCase.brands[j].Brand || brand.Version != testCase.brands[j].Version {
+				t.Error(fmt.Sprintf("Test %d failed got: %v, want: %v", i, brand, testCase.brands[j]))
+			}
+		}
+		if reparsed, err := ParseBrandHeader(FormatBrandHeader(brands)); err != nil || len(reparsed) != len(brands) {
+			t.Error(fmt.Sprintf("Test %d failed to round trip: %s", i, FormatBrandHeader(brands)))
+		}
+	}
+
+	for majorVersion := 100; majorVersion < 130; majorVersion++ {
+		for _, useLegacy := range []bool{false, true} {
+			header := GenerateBrandHeader("Google Chrome", majorVersion, useLegacy)
+			brands, err := ParseBrandHeader(header)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if formatted := FormatBrandHeader(brands); formatted != header {
+				t.Error(fmt.Sprintf("Version %d failed got: %s, want: %s", majorVersion, formatted, header))
+			}
+			matches, legacy := MatchesChromiumGREASE(brands)
+			// Both algorithms can pick the same GREASE brand, the current one is tried first
+			if !matches || (legacy != useLegacy && FormatBrandHeader(brands) != GenerateBrandHeader("Google Chrome", majorVersion)) {
+				t.Error(fmt.Sprintf("Version %d failed got: %v %v, want: true %v", majorVersion, matches, legacy, useLegacy))
+			}
+		}
+	}
+
+	swapped, _ := ParseBrandHeader(`"Chromium";v="126", "Not/A)Brand";v="8", "Brave";v="126"`)
+	if matches, _ := MatchesChromiumGREASE(swapped); matches {
+		t.Error("a reordered brand list matched the GREASE algorithm")
+	}
+}
+
 func TestGetLatestChrome(t *testing.T) {
 	latest, err := GetLatestChromium(0)
 	if err != nil {
@@ -51,3 +130,61 @@ func TestGetLatestChrome(t *testing.T) {
 
 	fmt.Println(string(resultBytes))
 }
+
+func TestBrowser_RenderHeaders(t *testing.T) {
+	f, err := os.ReadFile("./_resources/samples/peet_firefox_121.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	browser := &Browser{
+		Name:      "firefox",
+		UserAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:121.0) Gecko/20100101 Firefox/121.0",
+		Languages: []string{"en-US", "en"},
+	}
+	err = browser.FromPEETRaw(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	headers := browser.RenderHeaders(RequestTypeNavigation)
+	for _, header := range headers {
+		fmt.Println(fmt.Sprintf("%s: %s", header[0], header[1]))
+	}
+	if strings.Join(headers.Order(), ",") != strings.Join(browser.HttpFingerprint.HeaderOrder, ",") {
+		t.Error(fmt.Sprintf("Header order got: %v, want: %v", headers.Order(), browser.HttpFingerprint.HeaderOrder))
+	}
+	if headers.Header().Get("accept-language") != "en-US,en;q=0.5" {
+		t.Error(fmt.Sprintf("Accept-Language got: %s", headers.Header().Get("accept-language")))
+	}
+
+	browser.UserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"
+	browser.Name = "chrome"
+	browser.BrandHeader = GenerateBrandHeader("Google Chrome", 124)
+	headers = browser.RenderHeaders(RequestTypeFetch, http.Header{"Referer": {"https://example.com/"}, "X-Requested-With": {"XMLHttpRequest"}})
+	for _, header := range headers {
+		fmt.Println(fmt.Sprintf("%s: %s", header[0], header[1]))
+	}
+	if headers[len(headers)-1][0] != "x-requested-with" || headers.Header().Get("priority") != "u=1, i" {
+		t.Error("Unexpected fetch headers")
+	}
+}
+
+func TestMustChromiumHeaders(t *testing.T) {
+	headers := MustChromiumHeaders("Brave", 126, true)
+	for key, values := range headers {
+		fmt.Println(fmt.Sprintf("%s: %s", key, strings.Join(values, ", ")))
+		if key != strings.ToLower(key) {
+			t.Error(fmt.Sprintf("Header %s is not lowercase", key))
+		}
+	}
+
+	browser := &Browser{UserAgent: headers["user-agent"][0], BrandHeader: headers["sec-ch-ua"][0]}
+	for _, header := range browser.RenderHeaders(RequestTypeFetch) {
+		if values := headers[header[0]]; len(values) != 1 || values[0] != header[1] {
+			t.Error(fmt.Sprintf("%s got: %v, want: %s", header[0], values, header[1]))
+		}
+	}
+	if len(headers["sec-ch-ua-full-version-list"]) != 1 {
+		t.Error("Missing sec-ch-ua-full-version-list")
+	}
+}
@@ -1,6 +1,19 @@
 package device_utils
 
-var AvailableBrowsers = map[string]map[string]*Browser{
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var ErrBrowserIncomplete = errors.New("the supplied browser has no name or version")
+
+// browserDBLock guards availableBrowsers and browserAdoption, entries are never handed out without cloning them
+var browserDBLock sync.RWMutex
+
+var availableBrowsers = map[string]map[string]*Browser{
 	"brave": {
 		"1.50.114": &Browser{
 			Version:        "1.50.114",
@@ -15,7 +28,7 @@ var AvailableBrowsers = map[string]map[string]*Browser{
 			Version:     "111.0.5563.147",
 			Name:        "chrome",
 			UserAgent:   "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/111.0.0.0 Safari/537.36",
-			BrandHeader: "\"Chromium\";v=\"112\", \"Google Chrome\";v=\"112\", \"Not:A-Brand\";v=\"99\"",
+			BrandHeader: "\"Google Chrome\";v=\"111\", \"Not(A:Brand\";v=\"8\", \"Chromium\";v=\"111\"",
 			TlsFingerprint: &Browser_TLSFingerprint{
 				Version:                   771,
 				CipherSuites:              []Browser_TLSFingerprint_CipherSuite{4865, 4866, 4867, 49195, 49199, 49196, 49200, 52393, 52392, 49171, 49172, 156, 157, 47, 53},
@@ -94,4 +107,56 @@ var AvailableBrowsers = map[string]map[string]*Browser{
 			},
 		},
 	},
+	"firefox": {
+		"121.0": mustBrowser(NewFirefoxBrowser("121.0", PlatformWindows)),
+	},
+	"safari": {
+		"17.2": mustBrowser(NewSafariBrowser("17.2", PlatformMac)),
+	},
+}
+
+// browserAdoption Are rough shares of all browser usage in percent per browser version, at the time the profile was recorded
+var browserAdoption = map[string]map[string]float64{
+	"brave": {
+		"1.50.114": 0.05,
+	},
+	"chrome": {
+		"111.0.5563.147": 1.6,
+	},
+	"firefox": {
+		"121.0": 1.9,
+	},
+	"safari": {
+		"17.2": 5.1,
+	},
+}
+
+// RegisterBrowser Adds a copy of the browser to the catalog under its name and version, replacing any existing entry.
+// adoption optionally sets its share of all browser usage in percent
+func RegisterBrowser(browser *Browser, adoption ...float64) error {
+	if browser.GetName() == "" || browser.GetVersion() == "" {
+		return ErrBrowserIncomplete
+	}
+	name := strings.ToLower(browser.Name)
+
+	browserDBLock.Lock()
+	defer browserDBLock.Unlock()
+	if _, ok := availableBrowsers[name]; !ok {
+		availableBrowsers[name] = map[string]*Browser{}
+	}
+	availableBrowsers[name][browser.Version] = proto.Clone(browser).(*Browser)
+	if len(adoption) > 0 {
+		if _, ok := browserAdoption[name]; !ok {
+			browserAdoption[name] = map[string]float64{}
+		}
+		browserAdoption[name][browser.Version] = adoption[0]
+	}
+	return nil
+}
+
+func mustBrowser(browser *Browser, err error) *Browser {
+	if err != nil {
+		panic(err)
+	}
+	return browser
 }
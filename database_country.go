@@ -0,0 +1,101 @@
+package device_utils
+
+type languageShare struct {
+	Language string
+	// Share is the part of the population using Language on their phone, in percent
+	Share float64
+}
+
+type phoneNumberPlan struct {
+	// Length is the length of the national significant number, without trunk prefix or country code
+	Length int
+	// Prefixes are the mobile prefixes of the national significant number, countries that number by area use cityAreaCodes
+	Prefixes []string
+	// NANP numbers have an exchange code after the area code that starts with 2-9 and is never 555
+	NANP bool
+}
+
+// countryLanguages Are the languages phones are set to per country, by rough share
+var countryLanguages = map[string][]*languageShare{
+	"US": {{Language: "en", Share: 87}, {Language: "es", Share: 13}},
+	"CA": {{Language: "en", Share: 78}, {Language: "fr", Share: 22}},
+	"MX": {{Language: "es", Share: 100}},
+	"GB": {{Language: "en", Share: 100}},
+	"IE": {{Language: "en", Share: 100}},
+	"AU": {{Language: "en", Share: 100}},
+	"NZ": {{Language: "en", Share: 100}},
+	"DE": {{Language: "de", Share: 100}},
+	"AT": {{Language: "de", Share: 100}},
+	"CH": {{Language: "de", Share: 63}, {Language: "fr", Share: 23}, {Language: "it", Share: 8}},
+	"FR": {{Language: "fr", Share: 100}},
+	"BE": {{Language: "nl", Share: 58}, {Language: "fr", Share: 42}},
+	"NL": {{Language: "nl", Share: 100}},
+	"ES": {{Language: "es", Share: 100}},
+	"IT": {{Language: "it", Share: 100}},
+	"PT": {{Language: "pt", Share: 100}},
+	"BR": {{Language: "pt", Share: 100}},
+	"AR": {{Language: "es", Share: 100}},
+	"CO": {{Language: "es", Share: 100}},
+	"CL": {{Language: "es", Share: 100}},
+	"PE": {{Language: "es", Share: 100}},
+	"NI": {{Language: "es", Share: 100}},
+	"PL": {{Language: "pl", Share: 100}},
+	"SE": {{Language: "sv", Share: 100}},
+	"NO": {{Language: "nb", Share: 100}},
+	"DK": {{Language: "da", Share: 100}},
+	"FI": {{Language: "fi", Share: 90}, {Language: "sv", Share: 10}},
+	"RU": {{Language: "ru", Share: 100}},
+	"UA": {{Language: "uk", Share: 70}, {Language: "ru", Share: 30}},
+	"TR": {{Language: "tr", Share: 100}},
+	"IN": {{Language: "en", Share: 60}, {Language: "hi", Share: 40}},
+	"PH": {{Language: "en", Share: 70}, {Language: "fil", Share: 30}},
+	"ID": {{Language: "in", Share: 100}},
+	"JP": {{Language: "ja", Share: 100}},
+	"KR": {{Language: "ko", Share: 100}},
+	"CN": {{Language: "zh", Share: 100}},
+	"TW": {{Language: "zh", Share: 100}},
+	"VN": {{Language: "vi", Share: 100}},
+	"TH": {{Language: "th", Share: 100}},
+	"EG": {{Language: "ar", Share: 100}},
+	"SA": {{Language: "ar", Share: 100}},
+	"AE": {{Language: "ar", Share: 50}, {Language: "en", Share: 50}},
+	"ZA": {{Language: "en", Share: 100}},
+	"NG": {{Language: "en", Share: 100}},
+}
+
+// phoneNumberPlans Source: https://www.itu.int/oth/T0202.aspx?parent=T0202
+var phoneNumberPlans = map[string]*phoneNumberPlan{
+	"US": {Length: 10, NANP: true},
+	"CA": {Length: 10, NANP: true},
+	"MX": {Length: 10},
+	"GB": {Length: 10, Prefixes: []string{"71", "73", "74", "75", "77", "78", "79"}},
+	"IE": {Length: 9, Prefixes: []string{"83", "85", "86", "87", "89"}},
+	"AU": {Length: 9, Prefixes: []string{"4"}},
+	"NZ": {Length: 9, Prefixes: []string{"21", "22", "27"}},
+	"DE": {Length: 11, Prefixes: []string{"151", "152", "157", "159", "160", "162", "163", "170", "171", "172", "173", "174", "175", "176", "177", "178", "179"}},
+	"AT": {Length: 11, Prefixes: []string{"650", "660", "664", "676", "680", "688", "699"}},
+	"CH": {Length: 9, Prefixes: []string{"75", "76", "77", "78", "79"}},
+	"FR": {Length: 9, Prefixes: []string{"6", "7"}},
+	"BE": {Length: 9, Prefixes: []string{"46", "47", "48", "49"}},
+	"NL": {Length: 9, Prefixes: []string{"6"}},
+	"ES": {Length: 9, Prefixes: []string{"6", "7"}},
+	"IT": {Length: 10, Prefixes: []string{"32", "33", "34", "35", "36", "37", "38", "39"}},
+	"PT": {Length: 9, Prefixes: []string{"91", "92", "93", "96"}},
+	"BR": {Length: 11},
+	"AR": {Length: 10},
+	"PL": {Length: 9, Prefixes: []string{"5", "6", "7", "88"}},
+	"SE": {Length: 9, Prefixes: []string{"70", "72", "73", "76", "79"}},
+	"NO": {Length: 8, Prefixes: []string{"4", "9"}},
+	"DK": {Length: 8, Prefixes: []string{"2", "30", "31", "40", "41", "42", "50", "51", "52", "53", "60", "61", "71", "81", "91", "92", "93"}},
+	"FI": {Length: 9, Prefixes: []string{"40", "41", "44", "45", "46", "50"}},
+	"RU": {Length: 10, Prefixes: []string{"9"}},
+	"TR": {Length: 10, Prefixes: []string{"50", "53", "54", "55"}},
+	"IN": {Length: 10, Prefixes: []string{"6", "7", "8", "9"}},
+	"PH": {Length: 10, Prefixes: []string{"9"}},
+	"JP": {Length: 10, Prefixes: []string{"70", "80", "90"}},
+	"KR": {Length: 10, Prefixes: []string{"10"}},
+	"CN": {Length: 11, Prefixes: []string{"13", "15", "17", "18", "19"}},
+	"NI": {Length: 8, Prefixes: []string{"5", "7", "8"}},
+	"ZA": {Length: 9, Prefixes: []string{"6", "7", "8"}},
+	"NG": {Length: 10, Prefixes: []string{"70", "80", "81", "90", "91"}},
+}
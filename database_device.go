@@ -2,14 +2,17 @@ package device_utils
 
 import (
 	"google.golang.org/protobuf/proto"
-	"math/rand"
-	"time"
+	"strings"
+	"sync"
 )
 
+// deviceDBLock guards deviceDB, deviceDBKeys and deviceMarketShares, entries are never handed out without cloning them
+var deviceDBLock sync.RWMutex
+
 // Here we store a few devices and way to get them, just easy access in case you want to prototype a few devices in a library fast
 // TODO: Actually add a few devices here...
 // TODO: Turn this into an interface to customize with custom backend
-var DeviceDB = map[string]*AndroidDevice{
+var deviceDB = map[string]*AndroidDevice{
 	// "oneplus3": "",
 	"oneplus5": {
 		Locale: &Locale{
@@ -136,7 +139,7 @@ var DeviceDB = map[string]*AndroidDevice{
 	},
 }
 
-var DeviceDBKeys = []string{
+var deviceDBKeys = []string{
 	// "oneplus3",
 	"oneplus5",
 	"oneplus7t",
@@ -144,6 +147,25 @@ var DeviceDBKeys = []string{
 	"oneplus9pro",
 }
 
+// deviceMarketShares Are rough shares of the Android market in percent per country, "" holds the worldwide ones
+var deviceMarketShares = map[string]map[string]float64{
+	"": {
+		"oneplus5":    0.05,
+		"oneplus7t":   0.15,
+		"oneplus9pro": 0.2,
+	},
+	"US": {
+		"oneplus5":    0.04,
+		"oneplus7t":   0.2,
+		"oneplus9pro": 0.3,
+	},
+	"IN": {
+		"oneplus5":    0.6,
+		"oneplus7t":   1.1,
+		"oneplus9pro": 0.9,
+	},
+}
+
 func GetDBDevice(key string) (*AndroidDevice, bool) {
 	device := new(AndroidDevice)
 	device.Build = new(AndroidDevice_BuildData)
@@ -153,10 +175,12 @@ func GetDBDevice(key string) (*AndroidDevice, bool) {
 		AbiList: []string{"arm64-v8a", "armeabi-v7a", "armeabi"},
 	}
 
-	val, found := DeviceDB[key]
+	deviceDBLock.RLock()
+	val, found := deviceDB[key]
 	if found {
 		device = proto.Clone(val).(*AndroidDevice)
 	}
+	deviceDBLock.RUnlock()
 	// Device from DB needs to be random ID
 	device.Id = NewAndroidID()
 	device.Location = GetRandomDBLocation(device.Locale.GetCountryISO())
@@ -177,11 +201,26 @@ func GetDBDevice(key string) (*AndroidDevice, bool) {
 	return device, found
 }
 
-func GetRandomDevice() *AndroidDevice {
-	s := rand.NewSource(time.Now().Unix())
-	r := rand.New(s)
-	val := DeviceDB[DeviceDBKeys[r.Intn(len(DeviceDBKeys))]]
+// GetRandomDevice Picks a device weighted by its market share in countryISO, or worldwide if that's not given or unknown
+func GetRandomDevice(countryISO ...string) *AndroidDevice {
+	deviceDBLock.RLock()
+	shares := deviceMarketShares[""]
+	if len(countryISO) > 0 {
+		if countryShares, ok := deviceMarketShares[strings.ToUpper(countryISO[0])]; ok {
+			shares = countryShares
+		}
+	}
+	weights := make([]float64, len(deviceDBKeys))
+	for i, key := range deviceDBKeys {
+		share, ok := shares[key]
+		if !ok {
+			share = -1
+		}
+		weights[i] = share
+	}
+	val := deviceDB[deviceDBKeys[randomWeighted(fillMissingWeights(weights))]]
 	device := proto.Clone(val).(*AndroidDevice)
+	deviceDBLock.RUnlock()
 	// Device from DB needs to be random ID
 	device.Id = NewAndroidID()
 	device.Location = GetRandomDBLocation(device.Locale.GetCountryISO())
@@ -202,3 +241,32 @@ func GetRandomDevice() *AndroidDevice {
 	device.MacAddress.Generate("", false, true)
 	return device
 }
+
+// AvailableDevices Lists the keys of the devices GetRandomDevice picks from
+func AvailableDevices() []string {
+	deviceDBLock.RLock()
+	defer deviceDBLock.RUnlock()
+
+	result := make([]string, len(deviceDBKeys))
+	copy(result, deviceDBKeys)
+	return result
+}
+
+// RegisterDevice Adds a copy of the device to the catalog under key, replacing any existing entry. share optionally sets its
+// market share in percent in the country of its locale
+func RegisterDevice(key string, device *AndroidDevice, share ...float64) {
+	deviceDBLock.Lock()
+	defer deviceDBLock.Unlock()
+
+	if _, ok := deviceDB[key]; !ok {
+		deviceDBKeys = append(deviceDBKeys, key)
+	}
+	deviceDB[key] = proto.Clone(device).(*AndroidDevice)
+	if len(share) > 0 {
+		countryISO := strings.ToUpper(device.GetLocale().GetCountryISO())
+		if _, ok := deviceMarketShares[countryISO]; !ok {
+			deviceMarketShares[countryISO] = map[string]float64{}
+		}
+		deviceMarketShares[countryISO][key] = share[0]
+	}
+}
@@ -1,122 +1,675 @@
 package device_utils
 
 import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	_ "embed"
 	"errors"
-	"google.golang.org/protobuf/proto"
+	"fmt"
+	"math"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
 )
 
-var LocationDB = map[string]map[string]*GPSLocation{
-	"US": {
-		"newyorkcity": &GPSLocation{
-			Longitude: -74.005973,
-			Latitude:  40.712775,
-			Altitude:  10.440,
-			Provider:  GPSLocation_LocationProvider_NONE,
-		},
-		"losangeles": &GPSLocation{
-			Longitude: -118.243685,
-			Latitude:  34.052234,
-			Altitude:  86.854,
-			Provider:  GPSLocation_LocationProvider_NONE,
-		},
-		"chicago": &GPSLocation{
-			Longitude: -87.629798,
-			Latitude:  41.878114,
-			Altitude:  181.513,
-			Provider:  GPSLocation_LocationProvider_NONE,
-		},
-		"houston": &GPSLocation{
-			Longitude: -95.369803,
-			Latitude:  29.760427,
-			Altitude:  14.562,
-			Provider:  GPSLocation_LocationProvider_NONE,
-		},
-		"washington": &GPSLocation{
-			Longitude: -77.036871,
-			Latitude:  38.907192,
-			Altitude:  22.015,
-			Provider:  GPSLocation_LocationProvider_NONE,
-		},
-		"philadelphia": &GPSLocation{
-			Longitude: -75.165222,
-			Latitude:  39.952584,
-			Altitude:  14.336,
-			Provider:  GPSLocation_LocationProvider_NONE,
-		},
-		"miami": &GPSLocation{
-			Longitude: -80.191790,
-			Latitude:  25.761680,
-			Altitude:  0.537,
-			Provider:  GPSLocation_LocationProvider_NONE,
-		},
-	},
-	"MX": {
-		"mexicocity": &GPSLocation{
-			Longitude: -99.133208,
-			Latitude:  19.432608,
-			Altitude:  2229.729,
-			Provider:  GPSLocation_LocationProvider_NONE,
-		},
-	},
-	"CA": {
-		"toronto": &GPSLocation{
-			Longitude: -79.383184,
-			Latitude:  43.653226,
-			Altitude:  91.723,
-			Provider:  GPSLocation_LocationProvider_NONE,
-		},
-	},
+//go:generate sh -c "gzip -9nc _resources/cities/cities.tsv > database_cities.tsv.gz"
+
+// citiesTSV Is _resources/cities/cities.tsv compressed, one city per line sorted by country and population
+//
+//go:embed database_cities.tsv.gz
+var citiesTSV []byte
+
+var (
+	ErrCountryUnsupported = errors.New("the supplied country is unsupported")
+	ErrCityUnsupported    = errors.New("the supplied city is unsupported")
+)
+
+// City Is an entry of the location catalog
+type City struct {
+	// Key is the catalog key of the city, its normalized name or, if another city of the country has the same name, its
+	// normalized name and region
+	Key        string
+	Name       string
+	Aliases    []string
+	CountryISO string
+	Region     string
+	Latitude   float64
+	Longitude  float64
+	// Elevation is in meters above sea level
+	Elevation  float64
+	Population int64
+	// Timezone is the IANA name of the timezone, empty if unknown
+	Timezone string
+}
+
+// Location Converts the city to a GPSLocation without a provider
+func (city *City) Location() *GPSLocation {
+	return &GPSLocation{
+		Longitude: city.Longitude,
+		Latitude:  city.Latitude,
+		Altitude:  city.Elevation,
+		Provider:  GPSLocation_LocationProvider_NONE,
+	}
+}
+
+// Radius Is the radius in meters of a disc with the area the population of the city would cover at 3000 people per square
+// kilometer, between 2 and 25 kilometers
+func (city *City) Radius() float64 {
+	return math.Max(2000, math.Min(25000, 1000*math.Sqrt(float64(city.Population)/3000/math.Pi)))
+}
+
+// randomLocationAttempts Is how many fixes RandomLocation draws before it settles for one at the center of the city
+const randomLocationAttempts = 32
+
+// RandomLocation Picks a fix within the radius of the city, so devices in the same city don't share coordinates. Fixes that
+// land across a border are drawn again, unless the timezone boundaries don't place the city itself in its country
+func (city *City) RandomLocation() *GPSLocation {
+	if area, ok := findTimezoneArea(city.Longitude, city.Latitude); !ok || area.CountryISO != city.CountryISO {
+		return city.Location().RandomLocationWithin(city.Radius()).RandomFix()
+	}
+	for i := 0; i < randomLocationAttempts; i++ {
+		result := city.Location().RandomLocationWithin(city.Radius()).RandomFix()
+		if area, ok := findTimezoneArea(result.Longitude, result.Latitude); ok && area.CountryISO == city.CountryISO {
+			return result
+		}
+	}
+	return city.Location().RandomFix()
 }
 
-var AvailableCountries = []string{
-	"US", "MX", "CA",
+func (city *City) clone() *City {
+	result := *city
+	result.Aliases = make([]string, len(city.Aliases))
+	copy(result.Aliases, city.Aliases)
+	return &result
 }
 
-var AvailableCities = map[string][]string{
+// locationDBLock guards cityDB, the name indexes, availableCountries, availableCities and cityAreaCodes, entries are never
+// handed out without cloning them
+var locationDBLock sync.RWMutex
+
+var cityDB = map[string]map[string]*City{}
+
+// cityNameIndex and cityAliasIndex Map normalized names and aliases to the keys of the cities that go by them
+var (
+	cityNameIndex  = map[string]map[string][]string{}
+	cityAliasIndex = map[string]map[string][]string{}
+)
+
+var availableCountries = []string{}
+
+var availableCities = map[string][]string{}
+
+// cityAreaCodes Are the area codes of cities, for countries that number phones by area
+var cityAreaCodes = map[string]map[string][]string{
 	"US": {
-		"newyorkcity",
-		"losangeles",
-		"chicago",
-		"houston",
-		"washington",
-		"philadelphia",
-		"miami",
+		"newyorkcity":   {"212", "332", "347", "646", "718", "917", "929"},
+		"losangeles":    {"213", "310", "323", "424", "818"},
+		"chicago":       {"312", "773", "872"},
+		"houston":       {"281", "346", "713", "832"},
+		"washington":    {"202", "771"},
+		"philadelphia":  {"215", "267", "445"},
+		"miami":         {"305", "786"},
+		"phoenix":       {"480", "602", "623"},
+		"sanantonio":    {"210", "726"},
+		"sandiego":      {"619", "858"},
+		"dallas":        {"214", "469", "945", "972"},
+		"sanjose":       {"408", "669"},
+		"austin":        {"512", "737"},
+		"jacksonville":  {"904"},
+		"fortworth":     {"682", "817"},
+		"columbus":      {"380", "614"},
+		"charlotte":     {"704", "980"},
+		"sanfrancisco":  {"415", "628"},
+		"indianapolis":  {"317", "463"},
+		"seattle":       {"206"},
+		"denver":        {"303", "720", "983"},
+		"boston":        {"617", "857"},
+		"nashville":     {"615", "629"},
+		"elpaso":        {"915"},
+		"detroit":       {"313"},
+		"oklahomacity":  {"405", "572"},
+		"portland":      {"503", "971"},
+		"lasvegas":      {"702", "725"},
+		"memphis":       {"901"},
+		"louisville":    {"502"},
+		"baltimore":     {"410", "443", "667"},
+		"milwaukee":     {"414"},
+		"albuquerque":   {"505"},
+		"tucson":        {"520"},
+		"fresno":        {"559"},
+		"sacramento":    {"279", "916"},
+		"kansascity":    {"816"},
+		"atlanta":       {"404", "470", "678", "770", "943"},
+		"omaha":         {"402", "531"},
+		"raleigh":       {"919", "984"},
+		"minneapolis":   {"612"},
+		"tulsa":         {"539", "918"},
+		"wichita":       {"316"},
+		"tampa":         {"656", "813"},
+		"neworleans":    {"504"},
+		"cleveland":     {"216"},
+		"honolulu":      {"808"},
+		"newark":        {"862", "973"},
+		"cincinnati":    {"283", "513"},
+		"orlando":       {"321", "407", "689"},
+		"pittsburgh":    {"412", "878"},
+		"stlouis":       {"314", "557"},
+		"anchorage":     {"907"},
+		"buffalo":       {"716"},
+		"boise":         {"208", "986"},
+		"richmond":      {"804"},
+		"desmoines":     {"515"},
+		"littlerock":    {"501"},
+		"birmingham":    {"205", "659"},
+		"saltlakecity":  {"385", "801"},
+		"siouxfalls":    {"605"},
+		"providence":    {"401"},
+		"jackson":       {"601", "769"},
+		"charleston":    {"843", "854"},
+		"fargo":         {"701"},
+		"hartford":      {"860", "959"},
+		"billings":      {"406"},
+		"manchester":    {"603"},
+		"wilmington":    {"302"},
+		"portlandmaine": {"207"},
+		"cheyenne":      {"307"},
+		"burlington":    {"802"},
 	},
 	"MX": {
-		"mexicocity",
+		"mexicocity":     {"55", "56"},
+		"tijuana":        {"664"},
+		"leon":           {"477"},
+		"puebla":         {"222"},
+		"ecatepec":       {"55", "56"},
+		"ciudadjuarez":   {"656"},
+		"zapopan":        {"33"},
+		"guadalajara":    {"33"},
+		"monterrey":      {"81"},
+		"queretaro":      {"442"},
+		"culiacan":       {"667"},
+		"merida":         {"999"},
+		"aguascalientes": {"449"},
+		"chihuahua":      {"614"},
+		"hermosillo":     {"662"},
+		"sanluispotosi":  {"444"},
+		"cancun":         {"998"},
+		"acapulco":       {"744"},
+		"veracruz":       {"229"},
+		"oaxaca":         {"951"},
 	},
 	"CA": {
-		"toronto",
+		"toronto":       {"416", "437", "647"},
+		"montreal":      {"263", "438", "514"},
+		"calgary":       {"368", "403", "587", "825"},
+		"ottawa":        {"343", "613", "753"},
+		"edmonton":      {"368", "587", "780", "825"},
+		"winnipeg":      {"204", "431", "584"},
+		"mississauga":   {"289", "365", "742", "905"},
+		"vancouver":     {"236", "604", "672", "778"},
+		"brampton":      {"289", "365", "742", "905"},
+		"hamilton":      {"289", "365", "742", "905"},
+		"quebeccity":    {"367", "418", "581"},
+		"halifax":       {"782", "902"},
+		"saskatoon":     {"306", "474", "639"},
+		"regina":        {"306", "474", "639"},
+		"stjohns":       {"709", "879"},
+		"victoria":      {"236", "250", "672", "778"},
+		"fredericton":   {"428", "506"},
+		"charlottetown": {"782", "902"},
+		"whitehorse":    {"867"},
+		"yellowknife":   {"867"},
+		"iqaluit":       {"867"},
 	},
 }
 
-func GetDBLocation(countryISO, city string) (*GPSLocation, error) {
-	countryISO = strings.ToUpper(countryISO)
-	city = strings.ReplaceAll(strings.ToLower(city), " ", "")
-	result := new(GPSLocation)
+func init() {
+	reader, err := gzip.NewReader(bytes.NewReader(citiesTSV))
+	if err != nil {
+		panic(fmt.Errorf("database_cities.tsv.gz: %w", err))
+	}
+	scanner := bufio.NewScanner(reader)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Text()) == 0 || strings.HasPrefix(scanner.Text(), "#") {
+			continue
+		}
+		city, err := parseCityRow(scanner.Text())
+		if err != nil {
+			panic(fmt.Errorf("database_cities.tsv.gz:%d: %w", line, err))
+		}
+		registerCity(city)
+	}
+	if err = scanner.Err(); err != nil {
+		panic(fmt.Errorf("database_cities.tsv.gz: %w", err))
+	}
+}
+
+// parseCityRow Parses country, name, region, latitude, longitude, elevation, population, timezone and |-separated aliases
+func parseCityRow(row string) (*City, error) {
+	fields := strings.Split(row, "\t")
+	if len(fields) != 9 {
+		return nil, fmt.Errorf("expected 9 fields, got %d", len(fields))
+	}
+	result := &City{
+		Name:       fields[1],
+		Aliases:    []string{},
+		CountryISO: fields[0],
+		Region:     fields[2],
+		Timezone:   fields[7],
+	}
 	var err error
+	if result.Latitude, err = strconv.ParseFloat(fields[3], 64); err != nil {
+		return nil, fmt.Errorf("latitude: %w", err)
+	}
+	if result.Longitude, err = strconv.ParseFloat(fields[4], 64); err != nil {
+		return nil, fmt.Errorf("longitude: %w", err)
+	}
+	if result.Elevation, err = strconv.ParseFloat(fields[5], 64); err != nil {
+		return nil, fmt.Errorf("elevation: %w", err)
+	}
+	if result.Population, err = strconv.ParseInt(fields[6], 10, 64); err != nil {
+		return nil, fmt.Errorf("population: %w", err)
+	}
+	if len(fields[8]) > 0 {
+		result.Aliases = strings.Split(fields[8], "|")
+	}
+	return result, nil
+}
 
-	_, ok := AvailableCities[countryISO]
-	if ok {
-		result, ok = LocationDB[countryISO][city]
-		if !ok {
-			err = errors.New("city not supported")
+// normalizeCityName Folds case, accents and punctuation so "Montréal", "montreal" and "MONTREAL" match
+func normalizeCityName(name string) string {
+	result := strings.Builder{}
+	for _, r := range norm.NFD.String(strings.ToLower(name)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			break
+		case r == 'ß':
+			result.WriteString("ss")
+			break
+		case r == 'æ':
+			result.WriteString("ae")
+			break
+		case r == 'œ':
+			result.WriteString("oe")
+			break
+		case r == 'þ':
+			result.WriteString("th")
+			break
+		case r == 'ø':
+			result.WriteRune('o')
+			break
+		case r == 'ł':
+			result.WriteRune('l')
+			break
+		case r == 'đ':
+			result.WriteRune('d')
+			break
+		case r == 'ı':
+			result.WriteRune('i')
+			break
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			result.WriteRune(r)
+			break
 		}
+	}
+	return result.String()
+}
+
+// registerCity expects locationDBLock to be held or the catalog to be loading, it takes ownership of city
+func registerCity(city *City) {
+	city.CountryISO = strings.ToUpper(city.CountryISO)
+	if _, ok := cityDB[city.CountryISO]; !ok {
+		cityDB[city.CountryISO] = map[string]*City{}
+		cityNameIndex[city.CountryISO] = map[string][]string{}
+		cityAliasIndex[city.CountryISO] = map[string][]string{}
+		availableCountries = append(availableCountries, city.CountryISO)
+	}
+
+	city.Key = normalizeCityName(city.Name)
+	if existing, ok := cityDB[city.CountryISO][city.Key]; ok && len(city.Region) > 0 && normalizeCityName(existing.Region) != normalizeCityName(city.Region) {
+		city.Key = normalizeCityName(city.Name + city.Region)
+	}
+	if existing, ok := cityDB[city.CountryISO][city.Key]; ok {
+		unindexCity(existing)
 	} else {
-		err = errors.New("country not supported")
+		availableCities[city.CountryISO] = append(availableCities[city.CountryISO], city.Key)
+	}
+	cityDB[city.CountryISO][city.Key] = city
+
+	name := normalizeCityName(city.Name)
+	cityNameIndex[city.CountryISO][name] = append(cityNameIndex[city.CountryISO][name], city.Key)
+	for _, alias := range city.Aliases {
+		alias = normalizeCityName(alias)
+		cityAliasIndex[city.CountryISO][alias] = append(cityAliasIndex[city.CountryISO][alias], city.Key)
+	}
+}
+
+// unindexCity expects locationDBLock to be held
+func unindexCity(city *City) {
+	index := func(names map[string][]string, name string) {
+		keys := names[name]
+		for i, key := range keys {
+			if key == city.Key {
+				names[name] = append(keys[:i:i], keys[i+1:]...)
+				break
+			}
+		}
 	}
+	index(cityNameIndex[city.CountryISO], normalizeCityName(city.Name))
+	for _, alias := range city.Aliases {
+		index(cityAliasIndex[city.CountryISO], normalizeCityName(alias))
+	}
+}
+
+// unregisterCity expects locationDBLock to be held
+func unregisterCity(countryISO, key string) {
+	city, ok := cityDB[countryISO][key]
+	if !ok {
+		return
+	}
+	unindexCity(city)
+	delete(cityDB[countryISO], key)
+	keys := availableCities[countryISO]
+	for i, existing := range keys {
+		if existing == key {
+			availableCities[countryISO] = append(keys[:i:i], keys[i+1:]...)
+			break
+		}
+	}
+}
 
-	return result, err
+// findDBCity expects locationDBLock to be held, keys win over names and names over aliases
+func findDBCity(countryISO, name string, region ...string) (*City, error) {
+	cities, ok := cityDB[countryISO]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrCountryUnsupported, countryISO)
+	}
+	name = normalizeCityName(name)
+	matches := func(city *City) bool {
+		return len(region) == 0 || normalizeCityName(city.Region) == normalizeCityName(region[0])
+	}
+
+	if city, ok := cities[name]; ok && matches(city) {
+		return city, nil
+	}
+	for _, index := range []map[string][]string{cityNameIndex[countryISO], cityAliasIndex[countryISO]} {
+		for _, key := range index[name] {
+			if matches(cities[key]) {
+				return cities[key], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: %s in %s", ErrCityUnsupported, name, countryISO)
+}
+
+// GetDBCity Looks a city up by its key, name or alias, ignoring case and accents. region optionally tells apart cities of
+// the same name, without it the most populous one wins
+func GetDBCity(countryISO, name string, region ...string) (*City, error) {
+	locationDBLock.RLock()
+	defer locationDBLock.RUnlock()
+
+	city, err := findDBCity(strings.ToUpper(countryISO), name, region...)
+	if err != nil {
+		return nil, fmt.Errorf("GetDBCity: %w", err)
+	}
+	return city.clone(), nil
 }
 
+// GetRandomDBCity Picks a city of the country, and optionally of the region, weighted by its population
+func GetRandomDBCity(countryISO string, region ...string) (*City, error) {
+	countryISO = strings.ToUpper(countryISO)
+	locationDBLock.RLock()
+	defer locationDBLock.RUnlock()
+
+	if _, ok := cityDB[countryISO]; !ok {
+		return nil, fmt.Errorf("GetRandomDBCity: %w: %s", ErrCountryUnsupported, countryISO)
+	}
+	city, ok := randomCity(countryISO, func(city *City) bool {
+		return len(region) == 0 || normalizeCityName(city.Region) == normalizeCityName(region[0])
+	})
+	if !ok {
+		return nil, fmt.Errorf("GetRandomDBCity: %w: no cities in %s of %s", ErrCityUnsupported, region[0], countryISO)
+	}
+	return city.clone(), nil
+}
+
+// randomCity expects locationDBLock to be held
+func randomCity(countryISO string, filter func(city *City) bool) (*City, bool) {
+	cities := []*City{}
+	weights := []float64{}
+	for i, weight := range cityWeights(countryISO) {
+		city := cityDB[countryISO][availableCities[countryISO][i]]
+		if filter(city) {
+			cities = append(cities, city)
+			weights = append(weights, weight)
+		}
+	}
+	if len(cities) == 0 {
+		return nil, false
+	}
+	return cities[randomWeighted(weights)], true
+}
+
+func GetDBLocation(countryISO, city string) (*GPSLocation, error) {
+	locationDBLock.RLock()
+	defer locationDBLock.RUnlock()
+
+	result, err := findDBCity(strings.ToUpper(countryISO), city)
+	if err != nil {
+		return new(GPSLocation), fmt.Errorf("GetDBLocation: %w", err)
+	}
+	return result.Location(), nil
+}
+
+// GetRandomDBLocation Picks a city of the country weighted by its population, the country too if it has no cities, and a
+// random fix within it, see City.RandomLocation
 func GetRandomDBLocation(countryISO string) *GPSLocation {
-	_, ok := AvailableCities[countryISO]
+	countryISO = strings.ToUpper(countryISO)
+	locationDBLock.RLock()
+	defer locationDBLock.RUnlock()
+	_, ok := availableCities[countryISO]
+	if !ok {
+		countryISO = randomLocationCountry()
+	}
+	cities := availableCities[countryISO]
+	city := cities[randomWeighted(cityWeights(countryISO))]
+
+	return cityDB[countryISO][city].RandomLocation()
+}
+
+type dbCity struct {
+	*City
+	AreaCodes []string
+}
+
+// getRandomDBCity Picks a city of the country weighted by its population among the cities that have a timezone, and area
+// codes if needsAreaCodes
+func getRandomDBCity(countryISO string, needsAreaCodes bool) (*dbCity, bool) {
+	locationDBLock.RLock()
+	defer locationDBLock.RUnlock()
+
+	city, ok := randomCity(countryISO, func(city *City) bool {
+		return len(city.Timezone) > 0 && (!needsAreaCodes || len(cityAreaCodes[countryISO][city.Key]) > 0)
+	})
 	if !ok {
-		countryISO = randomStrSlice(AvailableCountries)
+		return nil, false
+	}
+	result := &dbCity{
+		City:      city.clone(),
+		AreaCodes: make([]string, len(cityAreaCodes[countryISO][city.Key])),
+	}
+	copy(result.AreaCodes, cityAreaCodes[countryISO][city.Key])
+	return result, true
+}
+
+// nearestDBCity Is the city of the country closest to the location among the cities filter accepts, of any country if
+// countryISO is empty
+func nearestDBCity(countryISO string, location *GPSLocation, filter func(city *City) bool) (*dbCity, bool) {
+	locationDBLock.RLock()
+	defer locationDBLock.RUnlock()
+
+	countries := []string{countryISO}
+	if len(countryISO) == 0 {
+		countries = availableCountries
+	}
+	var nearest *City
+	distance := math.Inf(1)
+	for _, country := range countries {
+		for _, city := range cityDB[country] {
+			if !filter(city) {
+				continue
+			}
+			if d := location.DistanceTo(city.Location()); d < distance {
+				nearest, distance = city, d
+			}
+		}
+	}
+	if nearest == nil {
+		return nil, false
+	}
+	result := &dbCity{
+		City:      nearest.clone(),
+		AreaCodes: make([]string, len(cityAreaCodes[nearest.CountryISO][nearest.Key])),
+	}
+	copy(result.AreaCodes, cityAreaCodes[nearest.CountryISO][nearest.Key])
+	return result, true
+}
+
+// randomDBCountry Picks a country that has locations, weighted by the population of its cities
+func randomDBCountry() string {
+	locationDBLock.RLock()
+	defer locationDBLock.RUnlock()
+	return randomLocationCountry()
+}
+
+// randomLocationCountry expects locationDBLock to be held
+func randomLocationCountry() string {
+	weights := make([]float64, len(availableCountries))
+	for i, countryISO := range availableCountries {
+		for _, weight := range cityWeights(countryISO) {
+			weights[i] += weight
+		}
+	}
+	return availableCountries[randomWeighted(weights)]
+}
+
+// cityWeights expects locationDBLock to be held
+func cityWeights(countryISO string) []float64 {
+	cities := availableCities[countryISO]
+	result := make([]float64, len(cities))
+	for i, city := range cities {
+		population := cityDB[countryISO][city].Population
+		if population <= 0 {
+			result[i] = -1
+			continue
+		}
+		result[i] = float64(population)
+	}
+	return fillMissingWeights(result)
+}
+
+// RegisterCityTimezone Sets the IANA timezone and optionally the phone area codes of a city in the catalog
+func RegisterCityTimezone(countryISO, city, timezone string, areaCodes ...string) error {
+	countryISO = strings.ToUpper(countryISO)
+	if err := new(Timezone).FromName(timezone); err != nil {
+		return fmt.Errorf("RegisterCityTimezone: %w", err)
+	}
+
+	locationDBLock.Lock()
+	defer locationDBLock.Unlock()
+	result, err := findDBCity(countryISO, city)
+	if err != nil {
+		return fmt.Errorf("RegisterCityTimezone: %w", err)
+	}
+	result.Timezone = timezone
+	if len(areaCodes) > 0 {
+		if _, ok := cityAreaCodes[countryISO]; !ok {
+			cityAreaCodes[countryISO] = map[string][]string{}
+		}
+		cityAreaCodes[countryISO][result.Key] = areaCodes
+	}
+	return nil
+}
+
+// AvailableCountries Lists the ISO codes of the countries that have locations
+func AvailableCountries() []string {
+	locationDBLock.RLock()
+	defer locationDBLock.RUnlock()
+
+	result := make([]string, len(availableCountries))
+	copy(result, availableCountries)
+	return result
+}
+
+// AvailableCities Lists the cities of a country, as keys for GetDBLocation
+func AvailableCities(countryISO string) []string {
+	locationDBLock.RLock()
+	defer locationDBLock.RUnlock()
+
+	cities := availableCities[strings.ToUpper(countryISO)]
+	result := make([]string, len(cities))
+	copy(result, cities)
+	return result
+}
+
+// AvailableRegions Lists the regions of a country that have cities, for GetRandomDBCity
+func AvailableRegions(countryISO string) []string {
+	countryISO = strings.ToUpper(countryISO)
+	locationDBLock.RLock()
+	defer locationDBLock.RUnlock()
+
+	result := []string{}
+	seen := map[string]bool{}
+	for _, key := range availableCities[countryISO] {
+		region := cityDB[countryISO][key].Region
+		if len(region) > 0 && !seen[region] {
+			seen[region] = true
+			result = append(result, region)
+		}
+	}
+	return result
+}
+
+// RegisterCity Adds a copy of the city to the catalog, replacing the city of the same name and region. A city that shares
+// its name with a city of another region is keyed by its name and region, see City.Key
+func RegisterCity(city *City) (string, error) {
+	if len(city.Timezone) > 0 {
+		if err := new(Timezone).FromName(city.Timezone); err != nil {
+			return "", fmt.Errorf("RegisterCity: %w", err)
+		}
+	}
+	if len(normalizeCityName(city.Name)) == 0 {
+		return "", fmt.Errorf("RegisterCity: %w: %q has no letters", ErrCityUnsupported, city.Name)
+	}
+
+	locationDBLock.Lock()
+	defer locationDBLock.Unlock()
+	result := city.clone()
+	registerCity(result)
+	return result.Key, nil
+}
+
+// RegisterLocation Adds a copy of the location to the catalog, replacing any existing entry. population optionally sets the
+// population of the city, cities without one are picked as often as the smallest known city of their country
+func RegisterLocation(countryISO, city string, location *GPSLocation, population ...int64) {
+	result := &City{
+		Name:       city,
+		Aliases:    []string{},
+		CountryISO: countryISO,
+		Latitude:   location.GetLatitude(),
+		Longitude:  location.GetLongitude(),
+		Elevation:  location.GetAltitude(),
+	}
+	if len(population) > 0 {
+		result.Population = population[0]
 	}
-	city := randomStrSlice(AvailableCities[countryISO])
-	location := LocationDB[countryISO][city]
 
-	return proto.Clone(location).(*GPSLocation)
+	locationDBLock.Lock()
+	defer locationDBLock.Unlock()
+	registerCity(result)
 }
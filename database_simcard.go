@@ -2,21 +2,194 @@ package device_utils
 
 import (
 	"google.golang.org/protobuf/proto"
+	"strings"
+	"sync"
 )
 
+// simCardDBLock guards availableSIMCards and carrierShares, entries are never handed out without cloning them
+var simCardDBLock sync.RWMutex
+
 func GetRandomDBSIMCard(countryISO string) *SIMCard {
-	_, ok := AvailableSIMCards[countryISO]
-	if !ok {
-		countryISO = randomStrSlice(AvailableCountries)
+	simCard, ok := getRandomDBMobileSIMCard(strings.ToUpper(countryISO))
+	for !ok {
+		// Countries without a mobile carrier fall back to a random one
+		simCard, ok = getRandomDBMobileSIMCard(randomDBCountry())
 	}
-	simCard := randomSIMSlice(AvailableSIMCards[countryISO])
 	simCard.Imei = new(SIMCard_IMEI)
 
-	return proto.Clone(simCard).(*SIMCard)
+	return simCard
+}
+
+// GetDBSIMCards Returns copies of the known SIM cards of a country
+func GetDBSIMCards(countryISO string) []*SIMCard {
+	simCardDBLock.RLock()
+	defer simCardDBLock.RUnlock()
+
+	simCards := availableSIMCards[strings.ToUpper(countryISO)]
+	result := make([]*SIMCard, len(simCards))
+	for i, simCard := range simCards {
+		result[i] = proto.Clone(simCard).(*SIMCard)
+	}
+	return result
+}
+
+// RegisterSIMCard Adds a copy of the SIM card to the catalog of its country, share optionally sets the subscriber share of its carrier in percent
+func RegisterSIMCard(simCard *SIMCard, share ...float64) {
+	countryISO := strings.ToUpper(simCard.GetCountryISO())
+
+	simCardDBLock.Lock()
+	defer simCardDBLock.Unlock()
+	availableSIMCards[countryISO] = append(availableSIMCards[countryISO], proto.Clone(simCard).(*SIMCard))
+	if len(share) > 0 {
+		if _, ok := carrierShares[countryISO]; !ok {
+			carrierShares[countryISO] = map[string]float64{}
+		}
+		carrierShares[countryISO][simCard.GetCarrier()] = share[0]
+	}
+}
+
+// getRandomDBMobileSIMCard Is GetRandomDBSIMCard without the fallback to another country, ok is false if the country has no
+// carrier that can be picked
+func getRandomDBMobileSIMCard(countryISO string) (*SIMCard, bool) {
+	simCardDBLock.RLock()
+	defer simCardDBLock.RUnlock()
+
+	simCard, ok := randomDBSIMCard(countryISO)
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(simCard).(*SIMCard), true
+}
+
+// randomDBSIMCard Picks by simCardWeights, ok is false if no SIM card has a weight, like in countries with only fixed line
+// networks. Expects simCardDBLock to be held
+func randomDBSIMCard(countryISO string) (*SIMCard, bool) {
+	weights := simCardWeights(countryISO)
+	for _, weight := range weights {
+		if weight > 0 {
+			return availableSIMCards[countryISO][randomWeighted(weights)], true
+		}
+	}
+	return nil, false
+}
+
+// simCardWeights Splits the share of a carrier over its networks, carriers without a known share split what is left and fixed
+// line networks never get picked. Expects simCardDBLock to be held
+func simCardWeights(countryISO string) []float64 {
+	simCards := availableSIMCards[countryISO]
+	shares := carrierShares[countryISO]
+
+	networks := map[string]int{}
+	unknownNetworks := 0
+	remainder := 100.0
+	for _, simCard := range simCards {
+		if simCard.MNC == fixedLineMNC {
+			continue
+		}
+		if _, ok := shares[simCard.Carrier]; ok {
+			networks[simCard.Carrier]++
+		} else {
+			unknownNetworks++
+		}
+	}
+	for carrier, share := range shares {
+		if networks[carrier] > 0 {
+			remainder -= share
+		}
+	}
+	if remainder < 0 {
+		remainder = 0
+	}
+
+	result := make([]float64, len(simCards))
+	for i, simCard := range simCards {
+		if simCard.MNC == fixedLineMNC {
+			continue
+		}
+		if share, ok := shares[simCard.Carrier]; ok {
+			result[i] = share / float64(networks[simCard.Carrier])
+		} else {
+			result[i] = remainder / float64(unknownNetworks)
+		}
+	}
+	return result
+}
+
+// fixedLineMNC is the MNC the catalog uses for fixed line networks, a phone never has one of those
+const fixedLineMNC = "999"
+
+// carrierShares Are rough mobile subscriber shares in percent, per country and carrier
+var carrierShares = map[string]map[string]float64{
+	"US": {
+		"Verizon Wireless":             35,
+		"T-Mobile":                     30,
+		"AT&T Wireless Inc.":           29,
+		"USA 3650 AT&T":                0,
+		"Sprint Spectrum":              1,
+		"United States Cellular Corp.": 1.3,
+		"Unknown":                      0,
+		"Testing":                      0,
+	},
+	"CA": {
+		"Bell Mobility":                 28,
+		"Telus Mobility":                29,
+		"Rogers AT&T Wireless":          26,
+		"FIDO (Rogers AT&T/ Microcell)": 6,
+		"Videotron":                     4,
+		"WIND":                          4,
+		"Sask Tel Mobility":             1.5,
+		"Public Mobile":                 1,
+	},
+	"MX": {
+		"TelCel/America Movil":      62,
+		"AT&T/IUSACell":             17,
+		"Movistar/Pegaso":           15,
+		"Operadora Unefon SA de CV": 4,
+		"NEXTEL":                    0,
+	},
+	"GB": {
+		"Everyth. Ev.wh./T-Mobile": 12,
+		"Everyth. Ev.wh./Orange":   10,
+		"Everyth. Ev.wh.":          8,
+		"O2 Ltd.":                  27,
+		"Vodafone":                 21,
+		"H3G Hutchinson":           13,
+		"Virgin Mobile":            4,
+		"Sky UK Limited":           3,
+		"Lycamobile":               1.5,
+	},
+	"DE": {
+		"T-mobile/Telekom": 36,
+		"Vodafone D2":      31,
+		"O2":               30,
+		"E-Plus":           0,
+		"Lycamobile":       1,
+	},
+	"FR": {
+		"Orange":            38,
+		"S.F.R.":            25,
+		"Bouygues Telecom":  21,
+		"Lliad/FREE Mobile": 15,
+	},
+	"BR": {
+		"Vivo S.A./Telemig":         38,
+		"Claro/Albra/America Movil": 33,
+		"TIM":                       25,
+		"Oi (TNL PCS / Oi)":         2,
+	},
+	"NI": {
+		"Claro":    55,
+		"Movistar": 43,
+		"Empresa Nicaraguense de Telecomunicaciones SA (ENITEL)": 2,
+	},
+	"PH": {
+		"Smart":         52,
+		"Globe Telecom": 45,
+	},
 }
 
-// AvailableSIMCards Source: https://www.mcc-mnc.com/
-var AvailableSIMCards = map[string][]*SIMCard{
+// availableSIMCards Source: https://www.mcc-mnc.com/
+var availableSIMCards = map[string][]*SIMCard{
 	"AG": {
 		{MNC: "030", MCC: "344", Carrier: "APUA PCS", CountryISO: "AG", CountryCode: "1268"},
 		{MNC: "920", MCC: "344", Carrier: "C & W", CountryISO: "AG", CountryCode: "1268"},
@@ -0,0 +1,160 @@
+package device_utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+//go:generate sh -c "gzip -9nc _resources/timezones/timezones.geojson > database_timezones.geojson.gz"
+
+// timezonesGeoJSON Is _resources/timezones/timezones.geojson compressed, a FeatureCollection with a feature per timezone and
+// country, its properties name them as tzid and country. _resources/timezones/README.md has its source and license
+//
+//go:embed database_timezones.geojson.gz
+var timezonesGeoJSON []byte
+
+// timezoneCoastDistance Is how far in meters off the polygons a location still gets the timezone of the nearest one, the
+// polygons are coarse and coasts, ports and border crossings fall just outside of them
+const timezoneCoastDistance = 30000
+
+// timezoneArea Is a polygon of a timezone, with its bounding box and area to look it up by
+type timezoneArea struct {
+	Timezone   string
+	CountryISO string
+	// Polygon is the exterior ring and the holes of the polygon
+	Polygon                        [][][]float64
+	MinLon, MaxLon, MinLat, MaxLat float64
+	// Area is the planar area of the exterior ring in square degrees, scaled by the cosine of its mean latitude
+	Area float64
+}
+
+// timezoneAreas Is never written to after loading, so it needs no lock
+var timezoneAreas = []*timezoneArea{}
+
+func init() {
+	reader, err := gzip.NewReader(bytes.NewReader(timezonesGeoJSON))
+	if err != nil {
+		panic(fmt.Errorf("database_timezones.geojson.gz: %w", err))
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		panic(fmt.Errorf("database_timezones.geojson.gz: %w", err))
+	}
+	collection := new(geoJSON)
+	if err = json.Unmarshal(data, collection); err != nil {
+		panic(fmt.Errorf("database_timezones.geojson.gz: %w", err))
+	}
+	for i, feature := range collection.Features {
+		properties := struct {
+			Timezone   string `json:"tzid"`
+			CountryISO string `json:"country"`
+		}{}
+		if err = json.Unmarshal(feature.Properties, &properties); err != nil || len(properties.Timezone) == 0 {
+			panic(fmt.Errorf("database_timezones.geojson.gz: feature %d has no tzid", i))
+		}
+		polygons, err := feature.polygons()
+		if err != nil {
+			panic(fmt.Errorf("database_timezones.geojson.gz: feature %d: %w", i, err))
+		}
+		for _, polygon := range polygons {
+			timezoneAreas = append(timezoneAreas, newTimezoneArea(properties.Timezone, properties.CountryISO, polygon))
+		}
+	}
+}
+
+func newTimezoneArea(timezone, countryISO string, polygon [][][]float64) *timezoneArea {
+	result := &timezoneArea{Timezone: timezone, CountryISO: countryISO, Polygon: polygon, MinLon: 180, MaxLon: -180, MinLat: 90, MaxLat: -90}
+	sum, latitudes := 0.0, 0.0
+	ring := polygon[0]
+	for i, position := range ring {
+		result.MinLon, result.MaxLon = math.Min(result.MinLon, position[0]), math.Max(result.MaxLon, position[0])
+		result.MinLat, result.MaxLat = math.Min(result.MinLat, position[1]), math.Max(result.MaxLat, position[1])
+		latitudes += position[1]
+		if i > 0 {
+			sum += (position[0] - ring[i-1][0]) * (position[1] + ring[i-1][1]) / 2
+		}
+	}
+	result.Area = math.Abs(sum) * math.Cos(toRadians(latitudes/float64(len(ring))))
+	return result
+}
+
+func (area *timezoneArea) contains(longitude, latitude float64) bool {
+	if longitude < area.MinLon || longitude > area.MaxLon || latitude < area.MinLat || latitude > area.MaxLat {
+		return false
+	}
+	if !ringContains(area.Polygon[0], longitude, latitude) {
+		return false
+	}
+	for _, hole := range area.Polygon[1:] {
+		if ringContains(hole, longitude, latitude) {
+			return false
+		}
+	}
+	return true
+}
+
+// distanceTo Is the distance in meters from the location to the exterior ring, on a plane tangent at the location which is
+// close enough within timezoneCoastDistance
+func (area *timezoneArea) distanceTo(longitude, latitude float64) float64 {
+	scale := earthRadius * math.Pi / 180
+	scaleLon := scale * math.Cos(toRadians(latitude))
+	result := math.Inf(1)
+	ring := area.Polygon[0]
+	for i := 1; i < len(ring); i++ {
+		ax, ay := (ring[i-1][0]-longitude)*scaleLon, (ring[i-1][1]-latitude)*scale
+		bx, by := (ring[i][0]-longitude)*scaleLon, (ring[i][1]-latitude)*scale
+		dx, dy := bx-ax, by-ay
+		t := 0.0
+		if length := dx*dx + dy*dy; length > 0 {
+			t = math.Max(0, math.Min(1, -(ax*dx+ay*dy)/length))
+		}
+		result = math.Min(result, math.Hypot(ax+t*dx, ay+t*dy))
+	}
+	return result
+}
+
+// findTimezoneArea Is the smallest polygon that contains the location, so enclaves and islands win over what surrounds them,
+// or the nearest one within timezoneCoastDistance. ok is false out at sea
+func findTimezoneArea(longitude, latitude float64) (*timezoneArea, bool) {
+	var result *timezoneArea
+	for _, area := range timezoneAreas {
+		if area.contains(longitude, latitude) && (result == nil || area.Area < result.Area) {
+			result = area
+		}
+	}
+	if result != nil {
+		return result, true
+	}
+
+	marginLat := timezoneCoastDistance / (earthRadius * math.Pi / 180)
+	marginLon := marginLat / math.Max(0.01, math.Cos(toRadians(latitude)))
+	nearest := math.Inf(1)
+	for _, area := range timezoneAreas {
+		if longitude < area.MinLon-marginLon || longitude > area.MaxLon+marginLon || latitude < area.MinLat-marginLat || latitude > area.MaxLat+marginLat {
+			continue
+		}
+		if distance := area.distanceTo(longitude, latitude); distance <= timezoneCoastDistance && distance < nearest {
+			result, nearest = area, distance
+		}
+	}
+	return result, result != nil
+}
+
+// nauticalTimezone Is the Etc zone ships keep at the longitude, 15 degrees per hour. Its sign is inverted, Etc/GMT-5 is 5 hours
+// ahead of UTC
+func nauticalTimezone(longitude float64) string {
+	offset := int(math.Round(longitude / 15))
+	if offset == 0 {
+		return "Etc/GMT"
+	}
+	if offset > 0 {
+		return "Etc/GMT-" + strconv.Itoa(offset)
+	}
+	return "Etc/GMT+" + strconv.Itoa(-offset)
+}
@@ -1958,7 +1958,7 @@ func (x Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF)
 
 // Deprecated: Use Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF.Descriptor instead.
 func (Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF) EnumDescriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 9, 0}
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 14, 0}
 }
 
 type Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD int32
@@ -2010,7 +2010,127 @@ func (x Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEA
 
 // Deprecated: Use Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD.Descriptor instead.
 func (Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD) EnumDescriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 9, 1}
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 14, 1}
+}
+
+type Browser_HTTPFingerprint_FrameType int32
+
+const (
+	Browser_HTTPFingerprint_DATA          Browser_HTTPFingerprint_FrameType = 0
+	Browser_HTTPFingerprint_HEADERS       Browser_HTTPFingerprint_FrameType = 1
+	Browser_HTTPFingerprint_PRIORITY      Browser_HTTPFingerprint_FrameType = 2
+	Browser_HTTPFingerprint_RST_STREAM    Browser_HTTPFingerprint_FrameType = 3
+	Browser_HTTPFingerprint_SETTINGS      Browser_HTTPFingerprint_FrameType = 4
+	Browser_HTTPFingerprint_PUSH_PROMISE  Browser_HTTPFingerprint_FrameType = 5
+	Browser_HTTPFingerprint_PING          Browser_HTTPFingerprint_FrameType = 6
+	Browser_HTTPFingerprint_GOAWAY        Browser_HTTPFingerprint_FrameType = 7
+	Browser_HTTPFingerprint_WINDOW_UPDATE Browser_HTTPFingerprint_FrameType = 8
+	Browser_HTTPFingerprint_CONTINUATION  Browser_HTTPFingerprint_FrameType = 9
+)
+
+// Enum value maps for Browser_HTTPFingerprint_FrameType.
+var (
+	Browser_HTTPFingerprint_FrameType_name = map[int32]string{
+		0: "DATA",
+		1: "HEADERS",
+		2: "PRIORITY",
+		3: "RST_STREAM",
+		4: "SETTINGS",
+		5: "PUSH_PROMISE",
+		6: "PING",
+		7: "GOAWAY",
+		8: "WINDOW_UPDATE",
+		9: "CONTINUATION",
+	}
+	Browser_HTTPFingerprint_FrameType_value = map[string]int32{
+		"DATA":          0,
+		"HEADERS":       1,
+		"PRIORITY":      2,
+		"RST_STREAM":    3,
+		"SETTINGS":      4,
+		"PUSH_PROMISE":  5,
+		"PING":          6,
+		"GOAWAY":        7,
+		"WINDOW_UPDATE": 8,
+		"CONTINUATION":  9,
+	}
+)
+
+func (x Browser_HTTPFingerprint_FrameType) Enum() *Browser_HTTPFingerprint_FrameType {
+	p := new(Browser_HTTPFingerprint_FrameType)
+	*p = x
+	return p
+}
+
+func (x Browser_HTTPFingerprint_FrameType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Browser_HTTPFingerprint_FrameType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_device_utils_device_utils_proto_enumTypes[11].Descriptor()
+}
+
+func (Browser_HTTPFingerprint_FrameType) Type() protoreflect.EnumType {
+	return &file_proto_device_utils_device_utils_proto_enumTypes[11]
+}
+
+func (x Browser_HTTPFingerprint_FrameType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Browser_HTTPFingerprint_FrameType.Descriptor instead.
+func (Browser_HTTPFingerprint_FrameType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+// How the value was returned, needed to reproduce it
+type Browser_GLCapability_Shape int32
+
+const (
+	Browser_GLCapability_SCALAR      Browser_GLCapability_Shape = 0
+	Browser_GLCapability_SEQUENCE    Browser_GLCapability_Shape = 1
+	Browser_GLCapability_TYPED_ARRAY Browser_GLCapability_Shape = 2
+)
+
+// Enum value maps for Browser_GLCapability_Shape.
+var (
+	Browser_GLCapability_Shape_name = map[int32]string{
+		0: "SCALAR",
+		1: "SEQUENCE",
+		2: "TYPED_ARRAY",
+	}
+	Browser_GLCapability_Shape_value = map[string]int32{
+		"SCALAR":      0,
+		"SEQUENCE":    1,
+		"TYPED_ARRAY": 2,
+	}
+)
+
+func (x Browser_GLCapability_Shape) Enum() *Browser_GLCapability_Shape {
+	p := new(Browser_GLCapability_Shape)
+	*p = x
+	return p
+}
+
+func (x Browser_GLCapability_Shape) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Browser_GLCapability_Shape) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_device_utils_device_utils_proto_enumTypes[12].Descriptor()
+}
+
+func (Browser_GLCapability_Shape) Type() protoreflect.EnumType {
+	return &file_proto_device_utils_device_utils_proto_enumTypes[12]
+}
+
+func (x Browser_GLCapability_Shape) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Browser_GLCapability_Shape.Descriptor instead.
+func (Browser_GLCapability_Shape) EnumDescriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 2, 0}
 }
 
 type GPSLocation_LocationProvider int32
@@ -2020,6 +2140,7 @@ const (
 	GPSLocation_GPS                   GPSLocation_LocationProvider = 1
 	GPSLocation_NETWORK               GPSLocation_LocationProvider = 2
 	GPSLocation_PASSIVE               GPSLocation_LocationProvider = 3
+	GPSLocation_FUSED                 GPSLocation_LocationProvider = 4
 )
 
 // Enum value maps for GPSLocation_LocationProvider.
@@ -2029,12 +2150,14 @@ var (
 		1: "GPS",
 		2: "NETWORK",
 		3: "PASSIVE",
+		4: "FUSED",
 	}
 	GPSLocation_LocationProvider_value = map[string]int32{
 		"LocationProvider_NONE": 0,
 		"GPS":                   1,
 		"NETWORK":               2,
 		"PASSIVE":               3,
+		"FUSED":                 4,
 	}
 )
 
@@ -2049,11 +2172,11 @@ func (x GPSLocation_LocationProvider) String() string {
 }
 
 func (GPSLocation_LocationProvider) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_device_utils_device_utils_proto_enumTypes[11].Descriptor()
+	return file_proto_device_utils_device_utils_proto_enumTypes[13].Descriptor()
 }
 
 func (GPSLocation_LocationProvider) Type() protoreflect.EnumType {
-	return &file_proto_device_utils_device_utils_proto_enumTypes[11]
+	return &file_proto_device_utils_device_utils_proto_enumTypes[13]
 }
 
 func (x GPSLocation_LocationProvider) Number() protoreflect.EnumNumber {
@@ -2116,11 +2239,11 @@ func (x CPUData_Architecture) String() string {
 }
 
 func (CPUData_Architecture) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_device_utils_device_utils_proto_enumTypes[12].Descriptor()
+	return file_proto_device_utils_device_utils_proto_enumTypes[14].Descriptor()
 }
 
 func (CPUData_Architecture) Type() protoreflect.EnumType {
-	return &file_proto_device_utils_device_utils_proto_enumTypes[12]
+	return &file_proto_device_utils_device_utils_proto_enumTypes[14]
 }
 
 func (x CPUData_Architecture) Number() protoreflect.EnumNumber {
@@ -2263,11 +2386,11 @@ func (x AndroidDevice_Version) String() string {
 }
 
 func (AndroidDevice_Version) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_device_utils_device_utils_proto_enumTypes[13].Descriptor()
+	return file_proto_device_utils_device_utils_proto_enumTypes[15].Descriptor()
 }
 
 func (AndroidDevice_Version) Type() protoreflect.EnumType {
-	return &file_proto_device_utils_device_utils_proto_enumTypes[13]
+	return &file_proto_device_utils_device_utils_proto_enumTypes[15]
 }
 
 func (x AndroidDevice_Version) Number() protoreflect.EnumNumber {
@@ -2291,11 +2414,12 @@ type Browser struct {
 	TlsFingerprint  *Browser_TLSFingerprint  `protobuf:"bytes,5,opt,name=tlsFingerprint,proto3" json:"tlsFingerprint,omitempty"`
 	HttpFingerprint *Browser_HTTPFingerprint `protobuf:"bytes,6,opt,name=httpFingerprint,proto3" json:"httpFingerprint,omitempty"`
 	// src: https://kaliiiiiiiiii.github.io/driverless-fp-collector/
-	AppCodeName         string                     `protobuf:"bytes,20,opt,name=appCodeName,proto3" json:"appCodeName,omitempty"`
-	AppName             string                     `protobuf:"bytes,21,opt,name=appName,proto3" json:"appName,omitempty"`
-	AppVersion          string                     `protobuf:"bytes,22,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
-	CookieEnabled       bool                       `protobuf:"varint,23,opt,name=cookieEnabled,proto3" json:"cookieEnabled,omitempty"`
-	DeviceMemory        int64                      `protobuf:"varint,24,opt,name=deviceMemory,proto3" json:"deviceMemory,omitempty"`
+	AppCodeName   string `protobuf:"bytes,20,opt,name=appCodeName,proto3" json:"appCodeName,omitempty"`
+	AppName       string `protobuf:"bytes,21,opt,name=appName,proto3" json:"appName,omitempty"`
+	AppVersion    string `protobuf:"bytes,22,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
+	CookieEnabled bool   `protobuf:"varint,23,opt,name=cookieEnabled,proto3" json:"cookieEnabled,omitempty"`
+	DeviceMemory  int64  `protobuf:"varint,24,opt,name=deviceMemory,proto3" json:"deviceMemory,omitempty"`
+	// -1 is null and -2 is "unspecified"
 	DoNotTrack          int32                      `protobuf:"varint,25,opt,name=doNotTrack,proto3" json:"doNotTrack,omitempty"`
 	HardwareConcurrency int32                      `protobuf:"varint,26,opt,name=hardwareConcurrency,proto3" json:"hardwareConcurrency,omitempty"`
 	Language            string                     `protobuf:"bytes,27,opt,name=language,proto3" json:"language,omitempty"`
@@ -2319,17 +2443,29 @@ type Browser struct {
 	Plugins             []*Browser_Plugin          `protobuf:"bytes,45,rep,name=plugins,proto3" json:"plugins,omitempty"`
 	HighEntropyValues   *Browser_HighEntropyValues `protobuf:"bytes,46,opt,name=highEntropyValues,proto3" json:"highEntropyValues,omitempty"`
 	WebRTC              *Browser_WebRTC            `protobuf:"bytes,47,opt,name=webRTC,proto3" json:"webRTC,omitempty"`
-	AvailableFonts      *Browser_BrowserCollection `protobuf:"bytes,100,opt,name=availableFonts,proto3" json:"availableFonts,omitempty"`
-	Navigator           *Browser_BrowserCollection `protobuf:"bytes,101,opt,name=navigator,proto3" json:"navigator,omitempty"`
-	Window              *Browser_BrowserCollection `protobuf:"bytes,102,opt,name=window,proto3" json:"window,omitempty"`
-	Document            *Browser_BrowserCollection `protobuf:"bytes,103,opt,name=document,proto3" json:"document,omitempty"`
-	DocumentElement     *Browser_BrowserCollection `protobuf:"bytes,104,opt,name=documentElement,proto3" json:"documentElement,omitempty"`
-	AudioTypes          *Browser_BrowserCollection `protobuf:"bytes,105,opt,name=audioTypes,proto3" json:"audioTypes,omitempty"`
-	VideoTypes          *Browser_BrowserCollection `protobuf:"bytes,106,opt,name=videoTypes,proto3" json:"videoTypes,omitempty"`
-	Css                 *Browser_BrowserCollection `protobuf:"bytes,107,opt,name=css,proto3" json:"css,omitempty"`
-	Gl                  *Browser_BrowserCollection `protobuf:"bytes,108,opt,name=gl,proto3" json:"gl,omitempty"`
-	Gl2                 *Browser_BrowserCollection `protobuf:"bytes,109,opt,name=gl2,proto3" json:"gl2,omitempty"`
-	GlExperimental      *Browser_BrowserCollection `protobuf:"bytes,110,opt,name=glExperimental,proto3" json:"glExperimental,omitempty"`
+	UserActivation      *Browser_UserActivation    `protobuf:"bytes,48,opt,name=userActivation,proto3" json:"userActivation,omitempty"`
+	ChromeApp           *Browser_ChromeApp         `protobuf:"bytes,49,opt,name=chromeApp,proto3" json:"chromeApp,omitempty"`
+	Wow64               bool                       `protobuf:"varint,50,opt,name=wow64,proto3" json:"wow64,omitempty"`
+	DarkMode            bool                       `protobuf:"varint,51,opt,name=darkMode,proto3" json:"darkMode,omitempty"`
+	Permissions         map[string]string          `protobuf:"bytes,52,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AudioContext        *Browser_AudioContext      `protobuf:"bytes,53,opt,name=audioContext,proto3" json:"audioContext,omitempty"`
+	MediaDevices        []*Browser_MediaDevice     `protobuf:"bytes,54,rep,name=mediaDevices,proto3" json:"mediaDevices,omitempty"`
+	StackNative         *Browser_StackProbe        `protobuf:"bytes,55,opt,name=stackNative,proto3" json:"stackNative,omitempty"`
+	StackWorker         *Browser_StackProbe        `protobuf:"bytes,56,opt,name=stackWorker,proto3" json:"stackWorker,omitempty"`
+	// Verdict of the collector
+	IsBot           bool                       `protobuf:"varint,57,opt,name=isBot,proto3" json:"isBot,omitempty"`
+	Status          string                     `protobuf:"bytes,58,opt,name=status,proto3" json:"status,omitempty"`
+	AvailableFonts  *Browser_BrowserCollection `protobuf:"bytes,100,opt,name=availableFonts,proto3" json:"availableFonts,omitempty"`
+	Navigator       *Browser_BrowserCollection `protobuf:"bytes,101,opt,name=navigator,proto3" json:"navigator,omitempty"`
+	Window          *Browser_BrowserCollection `protobuf:"bytes,102,opt,name=window,proto3" json:"window,omitempty"`
+	Document        *Browser_BrowserCollection `protobuf:"bytes,103,opt,name=document,proto3" json:"document,omitempty"`
+	DocumentElement *Browser_BrowserCollection `protobuf:"bytes,104,opt,name=documentElement,proto3" json:"documentElement,omitempty"`
+	AudioTypes      *Browser_BrowserCollection `protobuf:"bytes,105,opt,name=audioTypes,proto3" json:"audioTypes,omitempty"`
+	VideoTypes      *Browser_BrowserCollection `protobuf:"bytes,106,opt,name=videoTypes,proto3" json:"videoTypes,omitempty"`
+	Css             *Browser_BrowserCollection `protobuf:"bytes,107,opt,name=css,proto3" json:"css,omitempty"`
+	Gl              *Browser_BrowserCollection `protobuf:"bytes,108,opt,name=gl,proto3" json:"gl,omitempty"`
+	Gl2             *Browser_BrowserCollection `protobuf:"bytes,109,opt,name=gl2,proto3" json:"gl2,omitempty"`
+	GlExperimental  *Browser_BrowserCollection `protobuf:"bytes,110,opt,name=glExperimental,proto3" json:"glExperimental,omitempty"`
 }
 
 func (x *Browser) Reset() {
@@ -2602,6 +2738,83 @@ func (x *Browser) GetWebRTC() *Browser_WebRTC {
 	return nil
 }
 
+func (x *Browser) GetUserActivation() *Browser_UserActivation {
+	if x != nil {
+		return x.UserActivation
+	}
+	return nil
+}
+
+func (x *Browser) GetChromeApp() *Browser_ChromeApp {
+	if x != nil {
+		return x.ChromeApp
+	}
+	return nil
+}
+
+func (x *Browser) GetWow64() bool {
+	if x != nil {
+		return x.Wow64
+	}
+	return false
+}
+
+func (x *Browser) GetDarkMode() bool {
+	if x != nil {
+		return x.DarkMode
+	}
+	return false
+}
+
+func (x *Browser) GetPermissions() map[string]string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *Browser) GetAudioContext() *Browser_AudioContext {
+	if x != nil {
+		return x.AudioContext
+	}
+	return nil
+}
+
+func (x *Browser) GetMediaDevices() []*Browser_MediaDevice {
+	if x != nil {
+		return x.MediaDevices
+	}
+	return nil
+}
+
+func (x *Browser) GetStackNative() *Browser_StackProbe {
+	if x != nil {
+		return x.StackNative
+	}
+	return nil
+}
+
+func (x *Browser) GetStackWorker() *Browser_StackProbe {
+	if x != nil {
+		return x.StackWorker
+	}
+	return nil
+}
+
+func (x *Browser) GetIsBot() bool {
+	if x != nil {
+		return x.IsBot
+	}
+	return false
+}
+
+func (x *Browser) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 func (x *Browser) GetAvailableFonts() *Browser_BrowserCollection {
 	if x != nil {
 		return x.AvailableFonts
@@ -2689,6 +2902,16 @@ type GPSLocation struct {
 	Latitude  float64                      `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
 	Altitude  float64                      `protobuf:"fixed64,3,opt,name=altitude,proto3" json:"altitude,omitempty"`
 	Provider  GPSLocation_LocationProvider `protobuf:"varint,4,opt,name=provider,proto3,enum=device_utils.GPSLocation_LocationProvider" json:"provider,omitempty"`
+	// Accuracies are in meters at 68% confidence, like android.location.Location reports them, 0 if unknown
+	HorizontalAccuracy float32 `protobuf:"fixed32,5,opt,name=horizontalAccuracy,proto3" json:"horizontalAccuracy,omitempty"`
+	VerticalAccuracy   float32 `protobuf:"fixed32,6,opt,name=verticalAccuracy,proto3" json:"verticalAccuracy,omitempty"`
+	// speed is in meters per second and bearing in degrees clockwise from north
+	Speed   float32 `protobuf:"fixed32,7,opt,name=speed,proto3" json:"speed,omitempty"`
+	Bearing float32 `protobuf:"fixed32,8,opt,name=bearing,proto3" json:"bearing,omitempty"`
+	// timestamp is the UTC time of the fix in milliseconds since the epoch
+	Timestamp int64 `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// elapsedRealtimeNanos is the time of the fix since boot, like SystemClock.elapsedRealtimeNanos
+	ElapsedRealtimeNanos int64 `protobuf:"varint,10,opt,name=elapsedRealtimeNanos,proto3" json:"elapsedRealtimeNanos,omitempty"`
 }
 
 func (x *GPSLocation) Reset() {
@@ -2751,6 +2974,48 @@ func (x *GPSLocation) GetProvider() GPSLocation_LocationProvider {
 	return GPSLocation_LocationProvider_NONE
 }
 
+func (x *GPSLocation) GetHorizontalAccuracy() float32 {
+	if x != nil {
+		return x.HorizontalAccuracy
+	}
+	return 0
+}
+
+func (x *GPSLocation) GetVerticalAccuracy() float32 {
+	if x != nil {
+		return x.VerticalAccuracy
+	}
+	return 0
+}
+
+func (x *GPSLocation) GetSpeed() float32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *GPSLocation) GetBearing() float32 {
+	if x != nil {
+		return x.Bearing
+	}
+	return 0
+}
+
+func (x *GPSLocation) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *GPSLocation) GetElapsedRealtimeNanos() int64 {
+	if x != nil {
+		return x.ElapsedRealtimeNanos
+	}
+	return 0
+}
+
 type Locale struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2758,6 +3023,15 @@ type Locale struct {
 
 	Language   string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
 	CountryISO string `protobuf:"bytes,2,opt,name=countryISO,proto3" json:"countryISO,omitempty"`
+	// BCP 47 subtags beyond language and region: script like "Hant" and variants like "valencia"
+	Script   string   `protobuf:"bytes,3,opt,name=script,proto3" json:"script,omitempty"`
+	Variants []string `protobuf:"bytes,4,rep,name=variants,proto3" json:"variants,omitempty"`
+	// calendar and numberingSystem are the -u-ca- and -u-nu- keywords, keywords the other -u- ones as "key-type" like "hc-h12"
+	Calendar        string   `protobuf:"bytes,5,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	NumberingSystem string   `protobuf:"bytes,6,opt,name=numberingSystem,proto3" json:"numberingSystem,omitempty"`
+	Keywords        []string `protobuf:"bytes,7,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	// extensions are the other extensions and the private use part as they appear in the tag, like "t-ja" or "x-private"
+	Extensions []string `protobuf:"bytes,8,rep,name=extensions,proto3" json:"extensions,omitempty"`
 }
 
 func (x *Locale) Reset() {
@@ -2806,6 +3080,48 @@ func (x *Locale) GetCountryISO() string {
 	return ""
 }
 
+func (x *Locale) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *Locale) GetVariants() []string {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Locale) GetCalendar() string {
+	if x != nil {
+		return x.Calendar
+	}
+	return ""
+}
+
+func (x *Locale) GetNumberingSystem() string {
+	if x != nil {
+		return x.NumberingSystem
+	}
+	return ""
+}
+
+func (x *Locale) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *Locale) GetExtensions() []string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
 type SIMCard struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3393,6 +3709,10 @@ type Browser_HTTPFingerprint struct {
 	WindowUpdateIncrement int64                                        `protobuf:"varint,4,opt,name=windowUpdateIncrement,proto3" json:"windowUpdateIncrement,omitempty"`
 	PriorityFrames        []*Browser_HTTPFingerprint_PriorityFrameOpts `protobuf:"bytes,5,rep,name=priorityFrames,proto3" json:"priorityFrames,omitempty"`
 	HeaderFramePriority   *Browser_HTTPFingerprint_PriorityFrameOpts   `protobuf:"bytes,6,opt,name=headerFramePriority,proto3" json:"headerFramePriority,omitempty"`
+	// Every frame observed up to and including the first HEADERS frame, in order
+	Frames []*Browser_HTTPFingerprint_Frame `protobuf:"bytes,7,rep,name=frames,proto3" json:"frames,omitempty"`
+	// SETTINGS parameters in the order they are sent, takes precedence over settingsFrame
+	Settings []*Browser_HTTPFingerprint_Setting `protobuf:"bytes,8,rep,name=settings,proto3" json:"settings,omitempty"`
 }
 
 func (x *Browser_HTTPFingerprint) Reset() {
@@ -3469,17 +3789,32 @@ func (x *Browser_HTTPFingerprint) GetHeaderFramePriority() *Browser_HTTPFingerpr
 	return nil
 }
 
+func (x *Browser_HTTPFingerprint) GetFrames() []*Browser_HTTPFingerprint_Frame {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+func (x *Browser_HTTPFingerprint) GetSettings() []*Browser_HTTPFingerprint_Setting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
 type Browser_GLCapability struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BoolValue   []bool    `protobuf:"varint,1,rep,packed,name=boolValue,proto3" json:"boolValue,omitempty"`
-	IntValue    []int64   `protobuf:"varint,2,rep,packed,name=intValue,proto3" json:"intValue,omitempty"`
-	FloatValue  []float64 `protobuf:"fixed64,3,rep,packed,name=floatValue,proto3" json:"floatValue,omitempty"`
-	StringValue []string  `protobuf:"bytes,4,rep,name=stringValue,proto3" json:"stringValue,omitempty"`
-	EnumValue   int64     `protobuf:"varint,10,opt,name=enumValue,proto3" json:"enumValue,omitempty"`
-	EnumName    string    `protobuf:"bytes,11,opt,name=enumName,proto3" json:"enumName,omitempty"`
+	BoolValue   []bool                     `protobuf:"varint,1,rep,packed,name=boolValue,proto3" json:"boolValue,omitempty"`
+	IntValue    []int64                    `protobuf:"varint,2,rep,packed,name=intValue,proto3" json:"intValue,omitempty"`
+	FloatValue  []float64                  `protobuf:"fixed64,3,rep,packed,name=floatValue,proto3" json:"floatValue,omitempty"`
+	StringValue []string                   `protobuf:"bytes,4,rep,name=stringValue,proto3" json:"stringValue,omitempty"`
+	EnumValue   int64                      `protobuf:"varint,10,opt,name=enumValue,proto3" json:"enumValue,omitempty"`
+	EnumName    string                     `protobuf:"bytes,11,opt,name=enumName,proto3" json:"enumName,omitempty"`
+	Shape       Browser_GLCapability_Shape `protobuf:"varint,12,opt,name=shape,proto3,enum=device_utils.Browser_GLCapability_Shape" json:"shape,omitempty"`
 }
 
 func (x *Browser_GLCapability) Reset() {
@@ -3556,6 +3891,13 @@ func (x *Browser_GLCapability) GetEnumName() string {
 	return ""
 }
 
+func (x *Browser_GLCapability) GetShape() Browser_GLCapability_Shape {
+	if x != nil {
+		return x.Shape
+	}
+	return Browser_GLCapability_SCALAR
+}
+
 type Browser_BrowserCollection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3566,6 +3908,7 @@ type Browser_BrowserCollection struct {
 	ListData       []string                         `protobuf:"bytes,3,rep,name=listData,proto3" json:"listData,omitempty"`
 	MapData        map[string]string                `protobuf:"bytes,4,rep,name=mapData,proto3" json:"mapData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	GlCapabilities map[string]*Browser_GLCapability `protobuf:"bytes,5,rep,name=glCapabilities,proto3" json:"glCapabilities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NumberData     map[string]float64               `protobuf:"bytes,6,rep,name=numberData,proto3" json:"numberData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
 }
 
 func (x *Browser_BrowserCollection) Reset() {
@@ -3635,6 +3978,13 @@ func (x *Browser_BrowserCollection) GetGlCapabilities() map[string]*Browser_GLCa
 	return nil
 }
 
+func (x *Browser_BrowserCollection) GetNumberData() map[string]float64 {
+	if x != nil {
+		return x.NumberData
+	}
+	return nil
+}
+
 type Browser_BrowserScreen struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3937,6 +4287,8 @@ type Browser_Plugin struct {
 	FileName           string                              `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
 	Description        string                              `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	SupportedMIMETypes map[string]*Browser_Plugin_MIMEType `protobuf:"bytes,4,rep,name=supportedMIMETypes,proto3" json:"supportedMIMETypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// supportedMIMETypes in the order the plugin lists them
+	MimeTypes []*Browser_Plugin_MIMEType `protobuf:"bytes,5,rep,name=mimeTypes,proto3" json:"mimeTypes,omitempty"`
 }
 
 func (x *Browser_Plugin) Reset() {
@@ -3999,24 +4351,24 @@ func (x *Browser_Plugin) GetSupportedMIMETypes() map[string]*Browser_Plugin_MIME
 	return nil
 }
 
-type Browser_HighEntropyValues struct {
+func (x *Browser_Plugin) GetMimeTypes() []*Browser_Plugin_MIMEType {
+	if x != nil {
+		return x.MimeTypes
+	}
+	return nil
+}
+
+type Browser_UserActivation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Architecture    string                             `protobuf:"bytes,1,opt,name=architecture,proto3" json:"architecture,omitempty"`
-	Bitness         string                             `protobuf:"bytes,2,opt,name=bitness,proto3" json:"bitness,omitempty"`
-	Mobile          bool                               `protobuf:"varint,3,opt,name=mobile,proto3" json:"mobile,omitempty"`
-	Model           string                             `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
-	Platform        string                             `protobuf:"bytes,5,opt,name=platform,proto3" json:"platform,omitempty"`
-	PlatformVersion string                             `protobuf:"bytes,6,opt,name=platformVersion,proto3" json:"platformVersion,omitempty"`
-	UsFullVersion   string                             `protobuf:"bytes,7,opt,name=usFullVersion,proto3" json:"usFullVersion,omitempty"`
-	Brands          []*Browser_HighEntropyValues_Brand `protobuf:"bytes,8,rep,name=brands,proto3" json:"brands,omitempty"`
-	FullVersionList []*Browser_HighEntropyValues_Brand `protobuf:"bytes,9,rep,name=fullVersionList,proto3" json:"fullVersionList,omitempty"`
+	HasBeenActive bool `protobuf:"varint,1,opt,name=hasBeenActive,proto3" json:"hasBeenActive,omitempty"`
+	IsActive      bool `protobuf:"varint,2,opt,name=isActive,proto3" json:"isActive,omitempty"`
 }
 
-func (x *Browser_HighEntropyValues) Reset() {
-	*x = Browser_HighEntropyValues{}
+func (x *Browser_UserActivation) Reset() {
+	*x = Browser_UserActivation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_device_utils_device_utils_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4024,13 +4376,13 @@ func (x *Browser_HighEntropyValues) Reset() {
 	}
 }
 
-func (x *Browser_HighEntropyValues) String() string {
+func (x *Browser_UserActivation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_HighEntropyValues) ProtoMessage() {}
+func (*Browser_UserActivation) ProtoMessage() {}
 
-func (x *Browser_HighEntropyValues) ProtoReflect() protoreflect.Message {
+func (x *Browser_UserActivation) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_device_utils_device_utils_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4042,100 +4394,116 @@ func (x *Browser_HighEntropyValues) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_HighEntropyValues.ProtoReflect.Descriptor instead.
-func (*Browser_HighEntropyValues) Descriptor() ([]byte, []int) {
+// Deprecated: Use Browser_UserActivation.ProtoReflect.Descriptor instead.
+func (*Browser_UserActivation) Descriptor() ([]byte, []int) {
 	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 8}
 }
 
-func (x *Browser_HighEntropyValues) GetArchitecture() string {
-	if x != nil {
-		return x.Architecture
-	}
-	return ""
-}
-
-func (x *Browser_HighEntropyValues) GetBitness() string {
+func (x *Browser_UserActivation) GetHasBeenActive() bool {
 	if x != nil {
-		return x.Bitness
+		return x.HasBeenActive
 	}
-	return ""
+	return false
 }
 
-func (x *Browser_HighEntropyValues) GetMobile() bool {
+func (x *Browser_UserActivation) GetIsActive() bool {
 	if x != nil {
-		return x.Mobile
+		return x.IsActive
 	}
 	return false
 }
 
-func (x *Browser_HighEntropyValues) GetModel() string {
-	if x != nil {
-		return x.Model
-	}
-	return ""
+type Browser_ChromeApp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsInstalled  bool              `protobuf:"varint,1,opt,name=isInstalled,proto3" json:"isInstalled,omitempty"`
+	InstallState map[string]string `protobuf:"bytes,2,rep,name=installState,proto3" json:"installState,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RunningState map[string]string `protobuf:"bytes,3,rep,name=runningState,proto3" json:"runningState,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *Browser_HighEntropyValues) GetPlatform() string {
-	if x != nil {
-		return x.Platform
+func (x *Browser_ChromeApp) Reset() {
+	*x = Browser_ChromeApp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Browser_HighEntropyValues) GetPlatformVersion() string {
-	if x != nil {
-		return x.PlatformVersion
-	}
-	return ""
+func (x *Browser_ChromeApp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Browser_HighEntropyValues) GetUsFullVersion() string {
+func (*Browser_ChromeApp) ProtoMessage() {}
+
+func (x *Browser_ChromeApp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Browser_ChromeApp.ProtoReflect.Descriptor instead.
+func (*Browser_ChromeApp) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 9}
+}
+
+func (x *Browser_ChromeApp) GetIsInstalled() bool {
 	if x != nil {
-		return x.UsFullVersion
+		return x.IsInstalled
 	}
-	return ""
+	return false
 }
 
-func (x *Browser_HighEntropyValues) GetBrands() []*Browser_HighEntropyValues_Brand {
+func (x *Browser_ChromeApp) GetInstallState() map[string]string {
 	if x != nil {
-		return x.Brands
+		return x.InstallState
 	}
 	return nil
 }
 
-func (x *Browser_HighEntropyValues) GetFullVersionList() []*Browser_HighEntropyValues_Brand {
+func (x *Browser_ChromeApp) GetRunningState() map[string]string {
 	if x != nil {
-		return x.FullVersionList
+		return x.RunningState
 	}
 	return nil
 }
 
-type Browser_WebRTC struct {
+type Browser_MediaDevice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Video *Browser_WebRTC_CodecInformation `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
-	Audio *Browser_WebRTC_CodecInformation `protobuf:"bytes,2,opt,name=audio,proto3" json:"audio,omitempty"`
+	DeviceId string `protobuf:"bytes,1,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	Kind     string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Label    string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	GroupId  string `protobuf:"bytes,4,opt,name=groupId,proto3" json:"groupId,omitempty"`
 }
 
-func (x *Browser_WebRTC) Reset() {
-	*x = Browser_WebRTC{}
+func (x *Browser_MediaDevice) Reset() {
+	*x = Browser_MediaDevice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[18]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_WebRTC) String() string {
+func (x *Browser_MediaDevice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_WebRTC) ProtoMessage() {}
+func (*Browser_MediaDevice) ProtoMessage() {}
 
-func (x *Browser_WebRTC) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[18]
+func (x *Browser_MediaDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4146,60 +4514,68 @@ func (x *Browser_WebRTC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_WebRTC.ProtoReflect.Descriptor instead.
-func (*Browser_WebRTC) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 9}
+// Deprecated: Use Browser_MediaDevice.ProtoReflect.Descriptor instead.
+func (*Browser_MediaDevice) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 10}
 }
 
-func (x *Browser_WebRTC) GetVideo() *Browser_WebRTC_CodecInformation {
+func (x *Browser_MediaDevice) GetDeviceId() string {
 	if x != nil {
-		return x.Video
+		return x.DeviceId
 	}
-	return nil
+	return ""
 }
 
-func (x *Browser_WebRTC) GetAudio() *Browser_WebRTC_CodecInformation {
+func (x *Browser_MediaDevice) GetKind() string {
 	if x != nil {
-		return x.Audio
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-type Browser_TLSFingerprint_ExtensionData struct {
+func (x *Browser_MediaDevice) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Browser_MediaDevice) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type Browser_AudioParam struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExtensionId                         Browser_TLSFingerprint_Extension                                          `protobuf:"varint,1,opt,name=extensionId,proto3,enum=device_utils.Browser_TLSFingerprint_Extension" json:"extensionId,omitempty"`
-	SignatureAlgorithms                 *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms                 `protobuf:"bytes,13,opt,name=signatureAlgorithms,proto3" json:"signatureAlgorithms,omitempty"`
-	ApplicationLayerProtocolNegotiation *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation `protobuf:"bytes,16,opt,name=applicationLayerProtocolNegotiation,proto3" json:"applicationLayerProtocolNegotiation,omitempty"`
-	CompressCertificate                 *Browser_TLSFingerprint_ExtensionData_CompressCertificate                 `protobuf:"bytes,27,opt,name=compressCertificate,proto3" json:"compressCertificate,omitempty"`
-	RecordSizeLimit                     *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit                     `protobuf:"bytes,28,opt,name=recordSizeLimit,proto3" json:"recordSizeLimit,omitempty"`
-	SupportedVersions                   *Browser_TLSFingerprint_ExtensionData_SupportedVersions                   `protobuf:"bytes,43,opt,name=supportedVersions,proto3" json:"supportedVersions,omitempty"`
-	PskKeyExchangeModes                 *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes                 `protobuf:"bytes,45,opt,name=pskKeyExchangeModes,proto3" json:"pskKeyExchangeModes,omitempty"`
-	KeyShareExtension                   *Browser_TLSFingerprint_ExtensionData_KeyShareExtension                   `protobuf:"bytes,51,opt,name=keyShareExtension,proto3" json:"keyShareExtension,omitempty"`
-	ExtensionApplicationsSettings       *Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings       `protobuf:"bytes,17513,opt,name=extensionApplicationsSettings,proto3" json:"extensionApplicationsSettings,omitempty"`
-	ExtensionEncryptedClientHello       *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello       `protobuf:"bytes,65037,opt,name=extensionEncryptedClientHello,proto3" json:"extensionEncryptedClientHello,omitempty"`
-	ExtensionRenegotiationInfo          *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo          `protobuf:"bytes,65281,opt,name=extensionRenegotiationInfo,proto3" json:"extensionRenegotiationInfo,omitempty"`
+	Value          float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	AutomationRate string  `protobuf:"bytes,2,opt,name=automationRate,proto3" json:"automationRate,omitempty"`
+	DefaultValue   float64 `protobuf:"fixed64,3,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`
+	MinValue       float64 `protobuf:"fixed64,4,opt,name=minValue,proto3" json:"minValue,omitempty"`
+	MaxValue       float64 `protobuf:"fixed64,5,opt,name=maxValue,proto3" json:"maxValue,omitempty"`
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData{}
+func (x *Browser_AudioParam) Reset() {
+	*x = Browser_AudioParam{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[19]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) String() string {
+func (x *Browser_AudioParam) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_TLSFingerprint_ExtensionData) ProtoMessage() {}
+func (*Browser_AudioParam) ProtoMessage() {}
 
-func (x *Browser_TLSFingerprint_ExtensionData) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[19]
+func (x *Browser_AudioParam) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4210,113 +4586,173 @@ func (x *Browser_TLSFingerprint_ExtensionData) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0}
+// Deprecated: Use Browser_AudioParam.ProtoReflect.Descriptor instead.
+func (*Browser_AudioParam) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 11}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) GetExtensionId() Browser_TLSFingerprint_Extension {
+func (x *Browser_AudioParam) GetValue() float64 {
 	if x != nil {
-		return x.ExtensionId
+		return x.Value
 	}
-	return Browser_TLSFingerprint_SERVER_NAME
+	return 0
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) GetSignatureAlgorithms() *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms {
+func (x *Browser_AudioParam) GetAutomationRate() string {
 	if x != nil {
-		return x.SignatureAlgorithms
+		return x.AutomationRate
 	}
-	return nil
+	return ""
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) GetApplicationLayerProtocolNegotiation() *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation {
+func (x *Browser_AudioParam) GetDefaultValue() float64 {
 	if x != nil {
-		return x.ApplicationLayerProtocolNegotiation
+		return x.DefaultValue
 	}
-	return nil
+	return 0
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) GetCompressCertificate() *Browser_TLSFingerprint_ExtensionData_CompressCertificate {
+func (x *Browser_AudioParam) GetMinValue() float64 {
 	if x != nil {
-		return x.CompressCertificate
+		return x.MinValue
 	}
-	return nil
+	return 0
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) GetRecordSizeLimit() *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit {
+func (x *Browser_AudioParam) GetMaxValue() float64 {
 	if x != nil {
-		return x.RecordSizeLimit
+		return x.MaxValue
 	}
-	return nil
+	return 0
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) GetSupportedVersions() *Browser_TLSFingerprint_ExtensionData_SupportedVersions {
+type Browser_AudioDestination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxChannelCount       int64                 `protobuf:"varint,1,opt,name=maxChannelCount,proto3" json:"maxChannelCount,omitempty"`
+	Context               *Browser_AudioContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	NumberOfInputs        int64                 `protobuf:"varint,3,opt,name=numberOfInputs,proto3" json:"numberOfInputs,omitempty"`
+	NumberOfOutputs       int64                 `protobuf:"varint,4,opt,name=numberOfOutputs,proto3" json:"numberOfOutputs,omitempty"`
+	ChannelCount          int64                 `protobuf:"varint,5,opt,name=channelCount,proto3" json:"channelCount,omitempty"`
+	ChannelCountMode      string                `protobuf:"bytes,6,opt,name=channelCountMode,proto3" json:"channelCountMode,omitempty"`
+	ChannelInterpretation string                `protobuf:"bytes,7,opt,name=channelInterpretation,proto3" json:"channelInterpretation,omitempty"`
+}
+
+func (x *Browser_AudioDestination) Reset() {
+	*x = Browser_AudioDestination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Browser_AudioDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Browser_AudioDestination) ProtoMessage() {}
+
+func (x *Browser_AudioDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Browser_AudioDestination.ProtoReflect.Descriptor instead.
+func (*Browser_AudioDestination) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 12}
+}
+
+func (x *Browser_AudioDestination) GetMaxChannelCount() int64 {
 	if x != nil {
-		return x.SupportedVersions
+		return x.MaxChannelCount
 	}
-	return nil
+	return 0
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) GetPskKeyExchangeModes() *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes {
+func (x *Browser_AudioDestination) GetContext() *Browser_AudioContext {
 	if x != nil {
-		return x.PskKeyExchangeModes
+		return x.Context
 	}
 	return nil
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) GetKeyShareExtension() *Browser_TLSFingerprint_ExtensionData_KeyShareExtension {
+func (x *Browser_AudioDestination) GetNumberOfInputs() int64 {
 	if x != nil {
-		return x.KeyShareExtension
+		return x.NumberOfInputs
 	}
-	return nil
+	return 0
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) GetExtensionApplicationsSettings() *Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings {
+func (x *Browser_AudioDestination) GetNumberOfOutputs() int64 {
 	if x != nil {
-		return x.ExtensionApplicationsSettings
+		return x.NumberOfOutputs
 	}
-	return nil
+	return 0
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) GetExtensionEncryptedClientHello() *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello {
+func (x *Browser_AudioDestination) GetChannelCount() int64 {
 	if x != nil {
-		return x.ExtensionEncryptedClientHello
+		return x.ChannelCount
 	}
-	return nil
+	return 0
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData) GetExtensionRenegotiationInfo() *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo {
+func (x *Browser_AudioDestination) GetChannelCountMode() string {
 	if x != nil {
-		return x.ExtensionRenegotiationInfo
+		return x.ChannelCountMode
 	}
-	return nil
+	return ""
 }
 
-type Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms struct {
+func (x *Browser_AudioDestination) GetChannelInterpretation() string {
+	if x != nil {
+		return x.ChannelInterpretation
+	}
+	return ""
+}
+
+type Browser_AudioContext struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SupportedSignatureAlgorithms []Browser_TLSFingerprint_SignatureScheme `protobuf:"varint,1,rep,packed,name=supportedSignatureAlgorithms,proto3,enum=device_utils.Browser_TLSFingerprint_SignatureScheme" json:"supportedSignatureAlgorithms,omitempty"`
+	BaseLatency   float64                        `protobuf:"fixed64,1,opt,name=baseLatency,proto3" json:"baseLatency,omitempty"`
+	OutputLatency float64                        `protobuf:"fixed64,2,opt,name=outputLatency,proto3" json:"outputLatency,omitempty"`
+	SinkId        string                         `protobuf:"bytes,3,opt,name=sinkId,proto3" json:"sinkId,omitempty"`
+	Destination   *Browser_AudioDestination      `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	CurrentTime   float64                        `protobuf:"fixed64,5,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	SampleRate    float64                        `protobuf:"fixed64,6,opt,name=sampleRate,proto3" json:"sampleRate,omitempty"`
+	Listener      map[string]*Browser_AudioParam `protobuf:"bytes,7,rep,name=listener,proto3" json:"listener,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	State         string                         `protobuf:"bytes,8,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms{}
+func (x *Browser_AudioContext) Reset() {
+	*x = Browser_AudioContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[20]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) String() string {
+func (x *Browser_AudioContext) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) ProtoMessage() {}
+func (*Browser_AudioContext) ProtoMessage() {}
 
-func (x *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[20]
+func (x *Browser_AudioContext) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4327,43 +4763,94 @@ func (x *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) ProtoReflect(
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+// Deprecated: Use Browser_AudioContext.ProtoReflect.Descriptor instead.
+func (*Browser_AudioContext) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 13}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) GetSupportedSignatureAlgorithms() []Browser_TLSFingerprint_SignatureScheme {
+func (x *Browser_AudioContext) GetBaseLatency() float64 {
 	if x != nil {
-		return x.SupportedSignatureAlgorithms
+		return x.BaseLatency
+	}
+	return 0
+}
+
+func (x *Browser_AudioContext) GetOutputLatency() float64 {
+	if x != nil {
+		return x.OutputLatency
+	}
+	return 0
+}
+
+func (x *Browser_AudioContext) GetSinkId() string {
+	if x != nil {
+		return x.SinkId
+	}
+	return ""
+}
+
+func (x *Browser_AudioContext) GetDestination() *Browser_AudioDestination {
+	if x != nil {
+		return x.Destination
 	}
 	return nil
 }
 
-type Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation struct {
+func (x *Browser_AudioContext) GetCurrentTime() float64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *Browser_AudioContext) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *Browser_AudioContext) GetListener() map[string]*Browser_AudioParam {
+	if x != nil {
+		return x.Listener
+	}
+	return nil
+}
+
+func (x *Browser_AudioContext) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// Timing of a stack overflow probe, used to tell engines apart
+type Browser_StackProbe struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Protocols []string `protobuf:"bytes,1,rep,name=protocols,proto3" json:"protocols,omitempty"`
+	Stack  []float64 `protobuf:"fixed64,1,rep,packed,name=stack,proto3" json:"stack,omitempty"`
+	Timing float64   `protobuf:"fixed64,2,opt,name=timing,proto3" json:"timing,omitempty"`
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation{}
+func (x *Browser_StackProbe) Reset() {
+	*x = Browser_StackProbe{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[21]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation) String() string {
+func (x *Browser_StackProbe) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation) ProtoMessage() {}
+func (*Browser_StackProbe) ProtoMessage() {}
 
-func (x *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[21]
+func (x *Browser_StackProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4374,43 +4861,58 @@ func (x *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiatio
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 1}
+// Deprecated: Use Browser_StackProbe.ProtoReflect.Descriptor instead.
+func (*Browser_StackProbe) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 14}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation) GetProtocols() []string {
+func (x *Browser_StackProbe) GetStack() []float64 {
 	if x != nil {
-		return x.Protocols
+		return x.Stack
 	}
 	return nil
 }
 
-type Browser_TLSFingerprint_ExtensionData_RecordSizeLimit struct {
+func (x *Browser_StackProbe) GetTiming() float64 {
+	if x != nil {
+		return x.Timing
+	}
+	return 0
+}
+
+type Browser_HighEntropyValues struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Architecture    string                             `protobuf:"bytes,1,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	Bitness         string                             `protobuf:"bytes,2,opt,name=bitness,proto3" json:"bitness,omitempty"`
+	Mobile          bool                               `protobuf:"varint,3,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Model           string                             `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	Platform        string                             `protobuf:"bytes,5,opt,name=platform,proto3" json:"platform,omitempty"`
+	PlatformVersion string                             `protobuf:"bytes,6,opt,name=platformVersion,proto3" json:"platformVersion,omitempty"`
+	UsFullVersion   string                             `protobuf:"bytes,7,opt,name=usFullVersion,proto3" json:"usFullVersion,omitempty"`
+	Brands          []*Browser_HighEntropyValues_Brand `protobuf:"bytes,8,rep,name=brands,proto3" json:"brands,omitempty"`
+	FullVersionList []*Browser_HighEntropyValues_Brand `protobuf:"bytes,9,rep,name=fullVersionList,proto3" json:"fullVersionList,omitempty"`
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData_RecordSizeLimit{}
+func (x *Browser_HighEntropyValues) Reset() {
+	*x = Browser_HighEntropyValues{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[22]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) String() string {
+func (x *Browser_HighEntropyValues) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) ProtoMessage() {}
+func (*Browser_HighEntropyValues) ProtoMessage() {}
 
-func (x *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[22]
+func (x *Browser_HighEntropyValues) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4421,90 +4923,100 @@ func (x *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) ProtoReflect() pr
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData_RecordSizeLimit.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 2}
+// Deprecated: Use Browser_HighEntropyValues.ProtoReflect.Descriptor instead.
+func (*Browser_HighEntropyValues) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 15}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) GetLimit() uint32 {
+func (x *Browser_HighEntropyValues) GetArchitecture() string {
 	if x != nil {
-		return x.Limit
+		return x.Architecture
 	}
-	return 0
+	return ""
 }
 
-type Browser_TLSFingerprint_ExtensionData_CompressCertificate struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Browser_HighEntropyValues) GetBitness() string {
+	if x != nil {
+		return x.Bitness
+	}
+	return ""
+}
 
-	Algorithms []Browser_TLSFingerprint_ExtensionData_CompressCertificate_CertificateCompression `protobuf:"varint,1,rep,packed,name=algorithms,proto3,enum=device_utils.Browser_TLSFingerprint_ExtensionData_CompressCertificate_CertificateCompression" json:"algorithms,omitempty"`
+func (x *Browser_HighEntropyValues) GetMobile() bool {
+	if x != nil {
+		return x.Mobile
+	}
+	return false
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_CompressCertificate) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData_CompressCertificate{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Browser_HighEntropyValues) GetModel() string {
+	if x != nil {
+		return x.Model
 	}
+	return ""
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_CompressCertificate) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Browser_HighEntropyValues) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
 }
 
-func (*Browser_TLSFingerprint_ExtensionData_CompressCertificate) ProtoMessage() {}
+func (x *Browser_HighEntropyValues) GetPlatformVersion() string {
+	if x != nil {
+		return x.PlatformVersion
+	}
+	return ""
+}
 
-func (x *Browser_TLSFingerprint_ExtensionData_CompressCertificate) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Browser_HighEntropyValues) GetUsFullVersion() string {
+	if x != nil {
+		return x.UsFullVersion
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData_CompressCertificate.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData_CompressCertificate) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 3}
+func (x *Browser_HighEntropyValues) GetBrands() []*Browser_HighEntropyValues_Brand {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_CompressCertificate) GetAlgorithms() []Browser_TLSFingerprint_ExtensionData_CompressCertificate_CertificateCompression {
+func (x *Browser_HighEntropyValues) GetFullVersionList() []*Browser_HighEntropyValues_Brand {
 	if x != nil {
-		return x.Algorithms
+		return x.FullVersionList
 	}
 	return nil
 }
 
-type Browser_TLSFingerprint_ExtensionData_SupportedVersions struct {
+type Browser_WebRTC struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Versions []Browser_TLSFingerprint_ProtocolVersion `protobuf:"varint,1,rep,packed,name=versions,proto3,enum=device_utils.Browser_TLSFingerprint_ProtocolVersion" json:"versions,omitempty"`
+	Video *Browser_WebRTC_CodecInformation `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	Audio *Browser_WebRTC_CodecInformation `protobuf:"bytes,2,opt,name=audio,proto3" json:"audio,omitempty"`
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_SupportedVersions) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData_SupportedVersions{}
+func (x *Browser_WebRTC) Reset() {
+	*x = Browser_WebRTC{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[24]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_SupportedVersions) String() string {
+func (x *Browser_WebRTC) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_TLSFingerprint_ExtensionData_SupportedVersions) ProtoMessage() {}
+func (*Browser_WebRTC) ProtoMessage() {}
 
-func (x *Browser_TLSFingerprint_ExtensionData_SupportedVersions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[24]
+func (x *Browser_WebRTC) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4515,43 +5027,65 @@ func (x *Browser_TLSFingerprint_ExtensionData_SupportedVersions) ProtoReflect()
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData_SupportedVersions.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData_SupportedVersions) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 4}
+// Deprecated: Use Browser_WebRTC.ProtoReflect.Descriptor instead.
+func (*Browser_WebRTC) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 16}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_SupportedVersions) GetVersions() []Browser_TLSFingerprint_ProtocolVersion {
+func (x *Browser_WebRTC) GetVideo() *Browser_WebRTC_CodecInformation {
 	if x != nil {
-		return x.Versions
+		return x.Video
 	}
 	return nil
 }
 
-type Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes struct {
+func (x *Browser_WebRTC) GetAudio() *Browser_WebRTC_CodecInformation {
+	if x != nil {
+		return x.Audio
+	}
+	return nil
+}
+
+type Browser_TLSFingerprint_ExtensionData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Modes []Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode `protobuf:"varint,1,rep,packed,name=modes,proto3,enum=device_utils.Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode" json:"modes,omitempty"`
+	ExtensionId                         Browser_TLSFingerprint_Extension                                          `protobuf:"varint,1,opt,name=extensionId,proto3,enum=device_utils.Browser_TLSFingerprint_Extension" json:"extensionId,omitempty"`
+	StatusRequest                       *Browser_TLSFingerprint_ExtensionData_StatusRequest                       `protobuf:"bytes,5,opt,name=statusRequest,proto3" json:"statusRequest,omitempty"`
+	SupportedGroups                     *Browser_TLSFingerprint_ExtensionData_SupportedGroups                     `protobuf:"bytes,10,opt,name=supportedGroups,proto3" json:"supportedGroups,omitempty"`
+	SignatureAlgorithms                 *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms                 `protobuf:"bytes,13,opt,name=signatureAlgorithms,proto3" json:"signatureAlgorithms,omitempty"`
+	ApplicationLayerProtocolNegotiation *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation `protobuf:"bytes,16,opt,name=applicationLayerProtocolNegotiation,proto3" json:"applicationLayerProtocolNegotiation,omitempty"`
+	Padding                             *Browser_TLSFingerprint_ExtensionData_Padding                             `protobuf:"bytes,21,opt,name=padding,proto3" json:"padding,omitempty"`
+	CompressCertificate                 *Browser_TLSFingerprint_ExtensionData_CompressCertificate                 `protobuf:"bytes,27,opt,name=compressCertificate,proto3" json:"compressCertificate,omitempty"`
+	RecordSizeLimit                     *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit                     `protobuf:"bytes,28,opt,name=recordSizeLimit,proto3" json:"recordSizeLimit,omitempty"`
+	DelegatedCredentials                *Browser_TLSFingerprint_ExtensionData_DelegatedCredentials                `protobuf:"bytes,34,opt,name=delegatedCredentials,proto3" json:"delegatedCredentials,omitempty"`
+	SessionTicket                       *Browser_TLSFingerprint_ExtensionData_SessionTicket                       `protobuf:"bytes,35,opt,name=sessionTicket,proto3" json:"sessionTicket,omitempty"`
+	SupportedVersions                   *Browser_TLSFingerprint_ExtensionData_SupportedVersions                   `protobuf:"bytes,43,opt,name=supportedVersions,proto3" json:"supportedVersions,omitempty"`
+	PskKeyExchangeModes                 *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes                 `protobuf:"bytes,45,opt,name=pskKeyExchangeModes,proto3" json:"pskKeyExchangeModes,omitempty"`
+	KeyShareExtension                   *Browser_TLSFingerprint_ExtensionData_KeyShareExtension                   `protobuf:"bytes,51,opt,name=keyShareExtension,proto3" json:"keyShareExtension,omitempty"`
+	ExtensionApplicationsSettings       *Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings       `protobuf:"bytes,17513,opt,name=extensionApplicationsSettings,proto3" json:"extensionApplicationsSettings,omitempty"`
+	ExtensionEncryptedClientHello       *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello       `protobuf:"bytes,65037,opt,name=extensionEncryptedClientHello,proto3" json:"extensionEncryptedClientHello,omitempty"`
+	ExtensionRenegotiationInfo          *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo          `protobuf:"bytes,65281,opt,name=extensionRenegotiationInfo,proto3" json:"extensionRenegotiationInfo,omitempty"`
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes{}
+func (x *Browser_TLSFingerprint_ExtensionData) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[25]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData) ProtoMessage() {}
 
-func (x *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[25]
+func (x *Browser_TLSFingerprint_ExtensionData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4562,122 +5096,133 @@ func (x *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) ProtoReflect(
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 5}
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) GetModes() []Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode {
+func (x *Browser_TLSFingerprint_ExtensionData) GetExtensionId() Browser_TLSFingerprint_Extension {
 	if x != nil {
-		return x.Modes
+		return x.ExtensionId
 	}
-	return nil
+	return Browser_TLSFingerprint_SERVER_NAME
 }
 
-type Browser_TLSFingerprint_ExtensionData_KeyShareExtension struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	KeyShares []*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare `protobuf:"bytes,1,rep,name=keyShares,proto3" json:"keyShares,omitempty"`
+func (x *Browser_TLSFingerprint_ExtensionData) GetStatusRequest() *Browser_TLSFingerprint_ExtensionData_StatusRequest {
+	if x != nil {
+		return x.StatusRequest
+	}
+	return nil
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData_KeyShareExtension{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Browser_TLSFingerprint_ExtensionData) GetSupportedGroups() *Browser_TLSFingerprint_ExtensionData_SupportedGroups {
+	if x != nil {
+		return x.SupportedGroups
 	}
+	return nil
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Browser_TLSFingerprint_ExtensionData) GetSignatureAlgorithms() *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms {
+	if x != nil {
+		return x.SignatureAlgorithms
+	}
+	return nil
 }
 
-func (*Browser_TLSFingerprint_ExtensionData_KeyShareExtension) ProtoMessage() {}
-
-func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Browser_TLSFingerprint_ExtensionData) GetApplicationLayerProtocolNegotiation() *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation {
+	if x != nil {
+		return x.ApplicationLayerProtocolNegotiation
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData_KeyShareExtension.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData_KeyShareExtension) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 6}
+func (x *Browser_TLSFingerprint_ExtensionData) GetPadding() *Browser_TLSFingerprint_ExtensionData_Padding {
+	if x != nil {
+		return x.Padding
+	}
+	return nil
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension) GetKeyShares() []*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare {
+func (x *Browser_TLSFingerprint_ExtensionData) GetCompressCertificate() *Browser_TLSFingerprint_ExtensionData_CompressCertificate {
 	if x != nil {
-		return x.KeyShares
+		return x.CompressCertificate
 	}
 	return nil
 }
 
-type Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Protocols []string `protobuf:"bytes,1,rep,name=protocols,proto3" json:"protocols,omitempty"`
+func (x *Browser_TLSFingerprint_ExtensionData) GetRecordSizeLimit() *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit {
+	if x != nil {
+		return x.RecordSizeLimit
+	}
+	return nil
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Browser_TLSFingerprint_ExtensionData) GetDelegatedCredentials() *Browser_TLSFingerprint_ExtensionData_DelegatedCredentials {
+	if x != nil {
+		return x.DelegatedCredentials
 	}
+	return nil
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Browser_TLSFingerprint_ExtensionData) GetSessionTicket() *Browser_TLSFingerprint_ExtensionData_SessionTicket {
+	if x != nil {
+		return x.SessionTicket
+	}
+	return nil
 }
 
-func (*Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings) ProtoMessage() {}
+func (x *Browser_TLSFingerprint_ExtensionData) GetSupportedVersions() *Browser_TLSFingerprint_ExtensionData_SupportedVersions {
+	if x != nil {
+		return x.SupportedVersions
+	}
+	return nil
+}
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Browser_TLSFingerprint_ExtensionData) GetPskKeyExchangeModes() *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes {
+	if x != nil {
+		return x.PskKeyExchangeModes
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 7}
+func (x *Browser_TLSFingerprint_ExtensionData) GetKeyShareExtension() *Browser_TLSFingerprint_ExtensionData_KeyShareExtension {
+	if x != nil {
+		return x.KeyShareExtension
+	}
+	return nil
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings) GetProtocols() []string {
+func (x *Browser_TLSFingerprint_ExtensionData) GetExtensionApplicationsSettings() *Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings {
 	if x != nil {
-		return x.Protocols
+		return x.ExtensionApplicationsSettings
 	}
 	return nil
 }
 
-type Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Browser_TLSFingerprint_ExtensionData) GetExtensionEncryptedClientHello() *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello {
+	if x != nil {
+		return x.ExtensionEncryptedClientHello
+	}
+	return nil
+}
 
-	RenegotiationSupport Browser_TLSFingerprint_RenegotiationSupport `protobuf:"varint,1,opt,name=renegotiationSupport,proto3,enum=device_utils.Browser_TLSFingerprint_RenegotiationSupport" json:"renegotiationSupport,omitempty"`
+func (x *Browser_TLSFingerprint_ExtensionData) GetExtensionRenegotiationInfo() *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo {
+	if x != nil {
+		return x.ExtensionRenegotiationInfo
+	}
+	return nil
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo{}
+type Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SupportedSignatureAlgorithms []Browser_TLSFingerprint_SignatureScheme `protobuf:"varint,1,rep,packed,name=supportedSignatureAlgorithms,proto3,enum=device_utils.Browser_TLSFingerprint_SignatureScheme" json:"supportedSignatureAlgorithms,omitempty"`
+}
+
+func (x *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_device_utils_device_utils_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4685,13 +5230,13 @@ func (x *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) Reset(
 	}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) ProtoMessage() {}
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) ProtoReflect() protoreflect.Message {
+func (x *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_device_utils_device_utils_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4703,29 +5248,28 @@ func (x *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) ProtoR
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 8}
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 0}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) GetRenegotiationSupport() Browser_TLSFingerprint_RenegotiationSupport {
+func (x *Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms) GetSupportedSignatureAlgorithms() []Browser_TLSFingerprint_SignatureScheme {
 	if x != nil {
-		return x.RenegotiationSupport
+		return x.SupportedSignatureAlgorithms
 	}
-	return Browser_TLSFingerprint_RENEGOTIATE_NEVER
+	return nil
 }
 
-type Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello struct {
+type Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CandidateCipherSuites []*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite `protobuf:"bytes,1,rep,name=candidateCipherSuites,proto3" json:"candidateCipherSuites,omitempty"`
-	CandidatePayloadLens  []uint32                                                                                       `protobuf:"varint,2,rep,packed,name=candidatePayloadLens,proto3" json:"candidatePayloadLens,omitempty"`
+	Protocols []string `protobuf:"bytes,1,rep,name=protocols,proto3" json:"protocols,omitempty"`
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello{}
+func (x *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_device_utils_device_utils_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4733,13 +5277,13 @@ func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) Res
 	}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation) ProtoMessage() {}
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) ProtoReflect() protoreflect.Message {
+func (x *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_device_utils_device_utils_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4751,36 +5295,28 @@ func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) Pro
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 9}
-}
-
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) GetCandidateCipherSuites() []*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite {
-	if x != nil {
-		return x.CandidateCipherSuites
-	}
-	return nil
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 1}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) GetCandidatePayloadLens() []uint32 {
+func (x *Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation) GetProtocols() []string {
 	if x != nil {
-		return x.CandidatePayloadLens
+		return x.Protocols
 	}
 	return nil
 }
 
-type Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare struct {
+type Browser_TLSFingerprint_ExtensionData_RecordSizeLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Group Browser_TLSFingerprint_EllipticCurve `protobuf:"varint,1,opt,name=group,proto3,enum=device_utils.Browser_TLSFingerprint_EllipticCurve" json:"group,omitempty"`
-	Data  []byte                               `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare{}
+func (x *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_RecordSizeLimit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_device_utils_device_utils_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4788,13 +5324,13 @@ func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) Reset(
 	}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) ProtoMessage() {}
 
-func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) ProtoReflect() protoreflect.Message {
+func (x *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_device_utils_device_utils_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4806,36 +5342,28 @@ func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) ProtoR
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 6, 0}
-}
-
-func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) GetGroup() Browser_TLSFingerprint_EllipticCurve {
-	if x != nil {
-		return x.Group
-	}
-	return Browser_TLSFingerprint_RESERVED
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_RecordSizeLimit.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 2}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) GetData() []byte {
+func (x *Browser_TLSFingerprint_ExtensionData_RecordSizeLimit) GetLimit() uint32 {
 	if x != nil {
-		return x.Data
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite struct {
+type Browser_TLSFingerprint_ExtensionData_CompressCertificate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KdfId  Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF     `protobuf:"varint,1,opt,name=kdfId,proto3,enum=device_utils.Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF" json:"kdfId,omitempty"`
-	AeadId Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD `protobuf:"varint,2,opt,name=aeadId,proto3,enum=device_utils.Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD" json:"aeadId,omitempty"`
+	Algorithms []Browser_TLSFingerprint_ExtensionData_CompressCertificate_CertificateCompression `protobuf:"varint,1,rep,packed,name=algorithms,proto3,enum=device_utils.Browser_TLSFingerprint_ExtensionData_CompressCertificate_CertificateCompression" json:"algorithms,omitempty"`
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) Reset() {
-	*x = Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite{}
+func (x *Browser_TLSFingerprint_ExtensionData_CompressCertificate) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_CompressCertificate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_device_utils_device_utils_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4843,14 +5371,13 @@ func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKE
 	}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_CompressCertificate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) ProtoMessage() {
-}
+func (*Browser_TLSFingerprint_ExtensionData_CompressCertificate) ProtoMessage() {}
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) ProtoReflect() protoreflect.Message {
+func (x *Browser_TLSFingerprint_ExtensionData_CompressCertificate) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_device_utils_device_utils_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4862,38 +5389,28 @@ func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKE
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite.ProtoReflect.Descriptor instead.
-func (*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 9, 0}
-}
-
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) GetKdfId() Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF {
-	if x != nil {
-		return x.KdfId
-	}
-	return Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF_RESERVED
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_CompressCertificate.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_CompressCertificate) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 3}
 }
 
-func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) GetAeadId() Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD {
+func (x *Browser_TLSFingerprint_ExtensionData_CompressCertificate) GetAlgorithms() []Browser_TLSFingerprint_ExtensionData_CompressCertificate_CertificateCompression {
 	if x != nil {
-		return x.AeadId
+		return x.Algorithms
 	}
-	return Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD_RESERVED
+	return nil
 }
 
-type Browser_HTTPFingerprint_PriorityFrameOpts struct {
+type Browser_TLSFingerprint_ExtensionData_SupportedVersions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId  int64 `protobuf:"varint,1,opt,name=streamId,proto3" json:"streamId,omitempty"`
-	StreamDep int64 `protobuf:"varint,2,opt,name=streamDep,proto3" json:"streamDep,omitempty"`
-	Exclusive bool  `protobuf:"varint,3,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
-	Weight    int32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Versions []Browser_TLSFingerprint_ProtocolVersion `protobuf:"varint,1,rep,packed,name=versions,proto3,enum=device_utils.Browser_TLSFingerprint_ProtocolVersion" json:"versions,omitempty"`
 }
 
-func (x *Browser_HTTPFingerprint_PriorityFrameOpts) Reset() {
-	*x = Browser_HTTPFingerprint_PriorityFrameOpts{}
+func (x *Browser_TLSFingerprint_ExtensionData_SupportedVersions) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_SupportedVersions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_device_utils_device_utils_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4901,13 +5418,13 @@ func (x *Browser_HTTPFingerprint_PriorityFrameOpts) Reset() {
 	}
 }
 
-func (x *Browser_HTTPFingerprint_PriorityFrameOpts) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_SupportedVersions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_HTTPFingerprint_PriorityFrameOpts) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_SupportedVersions) ProtoMessage() {}
 
-func (x *Browser_HTTPFingerprint_PriorityFrameOpts) ProtoReflect() protoreflect.Message {
+func (x *Browser_TLSFingerprint_ExtensionData_SupportedVersions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_device_utils_device_utils_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4919,54 +5436,28 @@ func (x *Browser_HTTPFingerprint_PriorityFrameOpts) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_HTTPFingerprint_PriorityFrameOpts.ProtoReflect.Descriptor instead.
-func (*Browser_HTTPFingerprint_PriorityFrameOpts) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 1, 0}
-}
-
-func (x *Browser_HTTPFingerprint_PriorityFrameOpts) GetStreamId() int64 {
-	if x != nil {
-		return x.StreamId
-	}
-	return 0
-}
-
-func (x *Browser_HTTPFingerprint_PriorityFrameOpts) GetStreamDep() int64 {
-	if x != nil {
-		return x.StreamDep
-	}
-	return 0
-}
-
-func (x *Browser_HTTPFingerprint_PriorityFrameOpts) GetExclusive() bool {
-	if x != nil {
-		return x.Exclusive
-	}
-	return false
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_SupportedVersions.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_SupportedVersions) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 4}
 }
 
-func (x *Browser_HTTPFingerprint_PriorityFrameOpts) GetWeight() int32 {
+func (x *Browser_TLSFingerprint_ExtensionData_SupportedVersions) GetVersions() []Browser_TLSFingerprint_ProtocolVersion {
 	if x != nil {
-		return x.Weight
+		return x.Versions
 	}
-	return 0
+	return nil
 }
 
-type Browser_HTTPFingerprint_SettingsFrameOpts struct {
+type Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HeaderTableSize      int64 `protobuf:"varint,1,opt,name=headerTableSize,proto3" json:"headerTableSize,omitempty"`
-	EnablePush           int64 `protobuf:"varint,2,opt,name=enablePush,proto3" json:"enablePush,omitempty"`
-	MaxConcurrentStreams int64 `protobuf:"varint,3,opt,name=maxConcurrentStreams,proto3" json:"maxConcurrentStreams,omitempty"`
-	InitialWindowSize    int64 `protobuf:"varint,4,opt,name=initialWindowSize,proto3" json:"initialWindowSize,omitempty"`
-	MaxFrameSize         int64 `protobuf:"varint,5,opt,name=maxFrameSize,proto3" json:"maxFrameSize,omitempty"`
-	MaxHeaderListSize    int64 `protobuf:"varint,6,opt,name=maxHeaderListSize,proto3" json:"maxHeaderListSize,omitempty"`
+	Modes []Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode `protobuf:"varint,1,rep,packed,name=modes,proto3,enum=device_utils.Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode" json:"modes,omitempty"`
 }
 
-func (x *Browser_HTTPFingerprint_SettingsFrameOpts) Reset() {
-	*x = Browser_HTTPFingerprint_SettingsFrameOpts{}
+func (x *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_device_utils_device_utils_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4974,13 +5465,13 @@ func (x *Browser_HTTPFingerprint_SettingsFrameOpts) Reset() {
 	}
 }
 
-func (x *Browser_HTTPFingerprint_SettingsFrameOpts) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_HTTPFingerprint_SettingsFrameOpts) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) ProtoMessage() {}
 
-func (x *Browser_HTTPFingerprint_SettingsFrameOpts) ProtoReflect() protoreflect.Message {
+func (x *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_device_utils_device_utils_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4992,79 +5483,90 @@ func (x *Browser_HTTPFingerprint_SettingsFrameOpts) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_HTTPFingerprint_SettingsFrameOpts.ProtoReflect.Descriptor instead.
-func (*Browser_HTTPFingerprint_SettingsFrameOpts) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 1, 1}
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 5}
 }
 
-func (x *Browser_HTTPFingerprint_SettingsFrameOpts) GetHeaderTableSize() int64 {
+func (x *Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes) GetModes() []Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode {
 	if x != nil {
-		return x.HeaderTableSize
+		return x.Modes
 	}
-	return 0
+	return nil
 }
 
-func (x *Browser_HTTPFingerprint_SettingsFrameOpts) GetEnablePush() int64 {
-	if x != nil {
-		return x.EnablePush
-	}
-	return 0
-}
+type Browser_TLSFingerprint_ExtensionData_KeyShareExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Browser_HTTPFingerprint_SettingsFrameOpts) GetMaxConcurrentStreams() int64 {
-	if x != nil {
-		return x.MaxConcurrentStreams
-	}
-	return 0
+	KeyShares []*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare `protobuf:"bytes,1,rep,name=keyShares,proto3" json:"keyShares,omitempty"`
 }
 
-func (x *Browser_HTTPFingerprint_SettingsFrameOpts) GetInitialWindowSize() int64 {
-	if x != nil {
-		return x.InitialWindowSize
+func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_KeyShareExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Browser_HTTPFingerprint_SettingsFrameOpts) GetMaxFrameSize() int64 {
-	if x != nil {
-		return x.MaxFrameSize
-	}
-	return 0
+func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Browser_HTTPFingerprint_SettingsFrameOpts) GetMaxHeaderListSize() int64 {
+func (*Browser_TLSFingerprint_ExtensionData_KeyShareExtension) ProtoMessage() {}
+
+func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_KeyShareExtension.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_KeyShareExtension) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 6}
+}
+
+func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension) GetKeyShares() []*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare {
 	if x != nil {
-		return x.MaxHeaderListSize
+		return x.KeyShares
 	}
-	return 0
+	return nil
 }
 
-type Browser_BrowserScreen_Orientation struct {
+type Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Angle int32  `protobuf:"varint,1,opt,name=angle,proto3" json:"angle,omitempty"`
-	Type  string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Protocols []string `protobuf:"bytes,1,rep,name=protocols,proto3" json:"protocols,omitempty"`
 }
 
-func (x *Browser_BrowserScreen_Orientation) Reset() {
-	*x = Browser_BrowserScreen_Orientation{}
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[36]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_BrowserScreen_Orientation) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_BrowserScreen_Orientation) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings) ProtoMessage() {}
 
-func (x *Browser_BrowserScreen_Orientation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[36]
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5075,50 +5577,43 @@ func (x *Browser_BrowserScreen_Orientation) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_BrowserScreen_Orientation.ProtoReflect.Descriptor instead.
-func (*Browser_BrowserScreen_Orientation) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 4, 0}
-}
-
-func (x *Browser_BrowserScreen_Orientation) GetAngle() int32 {
-	if x != nil {
-		return x.Angle
-	}
-	return 0
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 7}
 }
 
-func (x *Browser_BrowserScreen_Orientation) GetType() string {
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings) GetProtocols() []string {
 	if x != nil {
-		return x.Type
+		return x.Protocols
 	}
-	return ""
+	return nil
 }
 
-type Browser_WebGPU_Features struct {
+type Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Size int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	RenegotiationSupport Browser_TLSFingerprint_RenegotiationSupport `protobuf:"varint,1,opt,name=renegotiationSupport,proto3,enum=device_utils.Browser_TLSFingerprint_RenegotiationSupport" json:"renegotiationSupport,omitempty"`
 }
 
-func (x *Browser_WebGPU_Features) Reset() {
-	*x = Browser_WebGPU_Features{}
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[37]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_WebGPU_Features) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_WebGPU_Features) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) ProtoMessage() {}
 
-func (x *Browser_WebGPU_Features) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[37]
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5129,45 +5624,45 @@ func (x *Browser_WebGPU_Features) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_WebGPU_Features.ProtoReflect.Descriptor instead.
-func (*Browser_WebGPU_Features) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 5, 0}
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 8}
 }
 
-func (x *Browser_WebGPU_Features) GetSize() int64 {
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo) GetRenegotiationSupport() Browser_TLSFingerprint_RenegotiationSupport {
 	if x != nil {
-		return x.Size
+		return x.RenegotiationSupport
 	}
-	return 0
+	return Browser_TLSFingerprint_RENEGOTIATE_NEVER
 }
 
-type Browser_Plugin_MIMEType struct {
+type Browser_TLSFingerprint_ExtensionData_StatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Suffixes    string `protobuf:"bytes,2,opt,name=suffixes,proto3" json:"suffixes,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StatusType              uint32 `protobuf:"varint,1,opt,name=statusType,proto3" json:"statusType,omitempty"`
+	ResponderIdListLength   uint32 `protobuf:"varint,2,opt,name=responderIdListLength,proto3" json:"responderIdListLength,omitempty"`
+	RequestExtensionsLength uint32 `protobuf:"varint,3,opt,name=requestExtensionsLength,proto3" json:"requestExtensionsLength,omitempty"`
 }
 
-func (x *Browser_Plugin_MIMEType) Reset() {
-	*x = Browser_Plugin_MIMEType{}
+func (x *Browser_TLSFingerprint_ExtensionData_StatusRequest) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_StatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[39]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_Plugin_MIMEType) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_StatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_Plugin_MIMEType) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_StatusRequest) ProtoMessage() {}
 
-func (x *Browser_Plugin_MIMEType) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[39]
+func (x *Browser_TLSFingerprint_ExtensionData_StatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5178,58 +5673,57 @@ func (x *Browser_Plugin_MIMEType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_Plugin_MIMEType.ProtoReflect.Descriptor instead.
-func (*Browser_Plugin_MIMEType) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 7, 0}
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_StatusRequest.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_StatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 9}
 }
 
-func (x *Browser_Plugin_MIMEType) GetType() string {
+func (x *Browser_TLSFingerprint_ExtensionData_StatusRequest) GetStatusType() uint32 {
 	if x != nil {
-		return x.Type
+		return x.StatusType
 	}
-	return ""
+	return 0
 }
 
-func (x *Browser_Plugin_MIMEType) GetSuffixes() string {
+func (x *Browser_TLSFingerprint_ExtensionData_StatusRequest) GetResponderIdListLength() uint32 {
 	if x != nil {
-		return x.Suffixes
+		return x.ResponderIdListLength
 	}
-	return ""
+	return 0
 }
 
-func (x *Browser_Plugin_MIMEType) GetDescription() string {
+func (x *Browser_TLSFingerprint_ExtensionData_StatusRequest) GetRequestExtensionsLength() uint32 {
 	if x != nil {
-		return x.Description
+		return x.RequestExtensionsLength
 	}
-	return ""
+	return 0
 }
 
-type Browser_HighEntropyValues_Brand struct {
+type Browser_TLSFingerprint_ExtensionData_SupportedGroups struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Brand   string `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Groups []Browser_TLSFingerprint_EllipticCurve `protobuf:"varint,1,rep,packed,name=groups,proto3,enum=device_utils.Browser_TLSFingerprint_EllipticCurve" json:"groups,omitempty"`
 }
 
-func (x *Browser_HighEntropyValues_Brand) Reset() {
-	*x = Browser_HighEntropyValues_Brand{}
+func (x *Browser_TLSFingerprint_ExtensionData_SupportedGroups) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_SupportedGroups{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[41]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_HighEntropyValues_Brand) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_SupportedGroups) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_HighEntropyValues_Brand) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_SupportedGroups) ProtoMessage() {}
 
-func (x *Browser_HighEntropyValues_Brand) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[41]
+func (x *Browser_TLSFingerprint_ExtensionData_SupportedGroups) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5240,53 +5734,43 @@ func (x *Browser_HighEntropyValues_Brand) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_HighEntropyValues_Brand.ProtoReflect.Descriptor instead.
-func (*Browser_HighEntropyValues_Brand) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 8, 0}
-}
-
-func (x *Browser_HighEntropyValues_Brand) GetBrand() string {
-	if x != nil {
-		return x.Brand
-	}
-	return ""
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_SupportedGroups.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_SupportedGroups) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 10}
 }
 
-func (x *Browser_HighEntropyValues_Brand) GetVersion() string {
+func (x *Browser_TLSFingerprint_ExtensionData_SupportedGroups) GetGroups() []Browser_TLSFingerprint_EllipticCurve {
 	if x != nil {
-		return x.Version
+		return x.Groups
 	}
-	return ""
+	return nil
 }
 
-type Browser_WebRTC_Codec struct {
+type Browser_TLSFingerprint_ExtensionData_Padding struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channels   int32  `protobuf:"varint,1,opt,name=channels,proto3" json:"channels,omitempty"`
-	ClockRate  int64  `protobuf:"varint,2,opt,name=clockRate,proto3" json:"clockRate,omitempty"`
-	MimeType   string `protobuf:"bytes,3,opt,name=mimeType,proto3" json:"mimeType,omitempty"`
-	SdpFmtLine string `protobuf:"bytes,4,opt,name=sdpFmtLine,proto3" json:"sdpFmtLine,omitempty"`
+	Length uint32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
 }
 
-func (x *Browser_WebRTC_Codec) Reset() {
-	*x = Browser_WebRTC_Codec{}
+func (x *Browser_TLSFingerprint_ExtensionData_Padding) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_Padding{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[42]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_WebRTC_Codec) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_Padding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_WebRTC_Codec) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_Padding) ProtoMessage() {}
 
-func (x *Browser_WebRTC_Codec) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[42]
+func (x *Browser_TLSFingerprint_ExtensionData_Padding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5297,65 +5781,43 @@ func (x *Browser_WebRTC_Codec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_WebRTC_Codec.ProtoReflect.Descriptor instead.
-func (*Browser_WebRTC_Codec) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 9, 0}
-}
-
-func (x *Browser_WebRTC_Codec) GetChannels() int32 {
-	if x != nil {
-		return x.Channels
-	}
-	return 0
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_Padding.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_Padding) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 11}
 }
 
-func (x *Browser_WebRTC_Codec) GetClockRate() int64 {
+func (x *Browser_TLSFingerprint_ExtensionData_Padding) GetLength() uint32 {
 	if x != nil {
-		return x.ClockRate
+		return x.Length
 	}
 	return 0
 }
 
-func (x *Browser_WebRTC_Codec) GetMimeType() string {
-	if x != nil {
-		return x.MimeType
-	}
-	return ""
-}
-
-func (x *Browser_WebRTC_Codec) GetSdpFmtLine() string {
-	if x != nil {
-		return x.SdpFmtLine
-	}
-	return ""
-}
-
-type Browser_WebRTC_HeaderExtension struct {
+type Browser_TLSFingerprint_ExtensionData_DelegatedCredentials struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Direction string `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"`
-	Uri       string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	SupportedSignatureAlgorithms []Browser_TLSFingerprint_SignatureScheme `protobuf:"varint,1,rep,packed,name=supportedSignatureAlgorithms,proto3,enum=device_utils.Browser_TLSFingerprint_SignatureScheme" json:"supportedSignatureAlgorithms,omitempty"`
 }
 
-func (x *Browser_WebRTC_HeaderExtension) Reset() {
-	*x = Browser_WebRTC_HeaderExtension{}
+func (x *Browser_TLSFingerprint_ExtensionData_DelegatedCredentials) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_DelegatedCredentials{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[43]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_WebRTC_HeaderExtension) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_DelegatedCredentials) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_WebRTC_HeaderExtension) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_DelegatedCredentials) ProtoMessage() {}
 
-func (x *Browser_WebRTC_HeaderExtension) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[43]
+func (x *Browser_TLSFingerprint_ExtensionData_DelegatedCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5366,51 +5828,43 @@ func (x *Browser_WebRTC_HeaderExtension) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_WebRTC_HeaderExtension.ProtoReflect.Descriptor instead.
-func (*Browser_WebRTC_HeaderExtension) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 9, 1}
-}
-
-func (x *Browser_WebRTC_HeaderExtension) GetDirection() string {
-	if x != nil {
-		return x.Direction
-	}
-	return ""
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_DelegatedCredentials.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_DelegatedCredentials) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 12}
 }
 
-func (x *Browser_WebRTC_HeaderExtension) GetUri() string {
+func (x *Browser_TLSFingerprint_ExtensionData_DelegatedCredentials) GetSupportedSignatureAlgorithms() []Browser_TLSFingerprint_SignatureScheme {
 	if x != nil {
-		return x.Uri
+		return x.SupportedSignatureAlgorithms
 	}
-	return ""
+	return nil
 }
 
-type Browser_WebRTC_CodecInformation struct {
+type Browser_TLSFingerprint_ExtensionData_SessionTicket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Codecs           []*Browser_WebRTC_Codec           `protobuf:"bytes,1,rep,name=codecs,proto3" json:"codecs,omitempty"`
-	HeaderExtensions []*Browser_WebRTC_HeaderExtension `protobuf:"bytes,2,rep,name=headerExtensions,proto3" json:"headerExtensions,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *Browser_WebRTC_CodecInformation) Reset() {
-	*x = Browser_WebRTC_CodecInformation{}
+func (x *Browser_TLSFingerprint_ExtensionData_SessionTicket) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_SessionTicket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[44]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Browser_WebRTC_CodecInformation) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_SessionTicket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Browser_WebRTC_CodecInformation) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_SessionTicket) ProtoMessage() {}
 
-func (x *Browser_WebRTC_CodecInformation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[44]
+func (x *Browser_TLSFingerprint_ExtensionData_SessionTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5421,54 +5875,44 @@ func (x *Browser_WebRTC_CodecInformation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Browser_WebRTC_CodecInformation.ProtoReflect.Descriptor instead.
-func (*Browser_WebRTC_CodecInformation) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 9, 2}
-}
-
-func (x *Browser_WebRTC_CodecInformation) GetCodecs() []*Browser_WebRTC_Codec {
-	if x != nil {
-		return x.Codecs
-	}
-	return nil
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_SessionTicket.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_SessionTicket) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 13}
 }
 
-func (x *Browser_WebRTC_CodecInformation) GetHeaderExtensions() []*Browser_WebRTC_HeaderExtension {
+func (x *Browser_TLSFingerprint_ExtensionData_SessionTicket) GetData() []byte {
 	if x != nil {
-		return x.HeaderExtensions
+		return x.Data
 	}
 	return nil
 }
 
-type SIMCard_IMEI struct {
+type Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TAC string `protobuf:"bytes,1,opt,name=TAC,proto3" json:"TAC,omitempty"`
-	// src: https://en.wikipedia.org/wiki/International_Mobile_Equipment_Identity
-	// AA	-	BB	BB	BB	-	CC	CC	CC	D
-	// Where AA and BB = TAC, CC = serial (randomized) and D = Luhn validation
-	Imei string `protobuf:"bytes,2,opt,name=imei,proto3" json:"imei,omitempty"`
+	CandidateCipherSuites []*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite `protobuf:"bytes,1,rep,name=candidateCipherSuites,proto3" json:"candidateCipherSuites,omitempty"`
+	CandidatePayloadLens  []uint32                                                                                       `protobuf:"varint,2,rep,packed,name=candidatePayloadLens,proto3" json:"candidatePayloadLens,omitempty"`
 }
 
-func (x *SIMCard_IMEI) Reset() {
-	*x = SIMCard_IMEI{}
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[45]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SIMCard_IMEI) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SIMCard_IMEI) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) ProtoMessage() {}
 
-func (x *SIMCard_IMEI) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[45]
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5479,55 +5923,51 @@ func (x *SIMCard_IMEI) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SIMCard_IMEI.ProtoReflect.Descriptor instead.
-func (*SIMCard_IMEI) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{3, 0}
-}
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 14}
+}
 
-func (x *SIMCard_IMEI) GetTAC() string {
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) GetCandidateCipherSuites() []*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite {
 	if x != nil {
-		return x.TAC
+		return x.CandidateCipherSuites
 	}
-	return ""
+	return nil
 }
 
-func (x *SIMCard_IMEI) GetImei() string {
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello) GetCandidatePayloadLens() []uint32 {
 	if x != nil {
-		return x.Imei
+		return x.CandidatePayloadLens
 	}
-	return ""
+	return nil
 }
 
-type SIMCard_MEID struct {
+type Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RegionCode       string `protobuf:"bytes,1,opt,name=RegionCode,proto3" json:"RegionCode,omitempty"`
-	ManufacturerCode string `protobuf:"bytes,2,opt,name=ManufacturerCode,proto3" json:"ManufacturerCode,omitempty"`
-	// src: https://en.wikipedia.org/wiki/Mobile_equipment_identifier
-	// R R	X	X	X	X	X	X	Z	Z	Z	Z	Z	Z	C
-	// Where R = region, X = manufacturer, Z = serial (randomized) and C = Luhn validation
-	Meid string `protobuf:"bytes,3,opt,name=meid,proto3" json:"meid,omitempty"`
+	Group Browser_TLSFingerprint_EllipticCurve `protobuf:"varint,1,opt,name=group,proto3,enum=device_utils.Browser_TLSFingerprint_EllipticCurve" json:"group,omitempty"`
+	Data  []byte                               `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *SIMCard_MEID) Reset() {
-	*x = SIMCard_MEID{}
+func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[46]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SIMCard_MEID) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SIMCard_MEID) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) ProtoMessage() {}
 
-func (x *SIMCard_MEID) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[46]
+func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5538,57 +5978,52 @@ func (x *SIMCard_MEID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SIMCard_MEID.ProtoReflect.Descriptor instead.
-func (*SIMCard_MEID) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{3, 1}
-}
-
-func (x *SIMCard_MEID) GetRegionCode() string {
-	if x != nil {
-		return x.RegionCode
-	}
-	return ""
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 6, 0}
 }
 
-func (x *SIMCard_MEID) GetManufacturerCode() string {
+func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) GetGroup() Browser_TLSFingerprint_EllipticCurve {
 	if x != nil {
-		return x.ManufacturerCode
+		return x.Group
 	}
-	return ""
+	return Browser_TLSFingerprint_RESERVED
 }
 
-func (x *SIMCard_MEID) GetMeid() string {
+func (x *Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare) GetData() []byte {
 	if x != nil {
-		return x.Meid
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type AndroidDevice_ID struct {
+type Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KdfId  Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF     `protobuf:"varint,1,opt,name=kdfId,proto3,enum=device_utils.Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF" json:"kdfId,omitempty"`
+	AeadId Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD `protobuf:"varint,2,opt,name=aeadId,proto3,enum=device_utils.Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD" json:"aeadId,omitempty"`
 }
 
-func (x *AndroidDevice_ID) Reset() {
-	*x = AndroidDevice_ID{}
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) Reset() {
+	*x = Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[47]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AndroidDevice_ID) String() string {
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AndroidDevice_ID) ProtoMessage() {}
+func (*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) ProtoMessage() {
+}
 
-func (x *AndroidDevice_ID) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[47]
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5599,61 +6034,53 @@ func (x *AndroidDevice_ID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AndroidDevice_ID.ProtoReflect.Descriptor instead.
-func (*AndroidDevice_ID) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite.ProtoReflect.Descriptor instead.
+func (*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 0, 0, 14, 0}
 }
 
-func (x *AndroidDevice_ID) GetId() uint64 {
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) GetKdfId() Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF {
 	if x != nil {
-		return x.Id
+		return x.KdfId
 	}
-	return 0
+	return Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF_RESERVED
 }
 
-// https://developer.android.com/reference/android/os/Build
-type AndroidDevice_BuildData struct {
+func (x *Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite) GetAeadId() Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD {
+	if x != nil {
+		return x.AeadId
+	}
+	return Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD_RESERVED
+}
+
+type Browser_HTTPFingerprint_PriorityFrameOpts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Board              string `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
-	Bootloader         string `protobuf:"bytes,2,opt,name=bootloader,proto3" json:"bootloader,omitempty"`
-	Brand              string `protobuf:"bytes,3,opt,name=brand,proto3" json:"brand,omitempty"`
-	Device             string `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
-	Display            string `protobuf:"bytes,5,opt,name=display,proto3" json:"display,omitempty"`
-	Fingerprint        string `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	Hardware           string `protobuf:"bytes,7,opt,name=hardware,proto3" json:"hardware,omitempty"`
-	Id                 string `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
-	Manufacturer       string `protobuf:"bytes,9,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
-	Model              string `protobuf:"bytes,10,opt,name=model,proto3" json:"model,omitempty"`
-	OdmSku             string `protobuf:"bytes,11,opt,name=odmSku,proto3" json:"odmSku,omitempty"`
-	Product            string `protobuf:"bytes,12,opt,name=product,proto3" json:"product,omitempty"`
-	Sku                string `protobuf:"bytes,13,opt,name=sku,proto3" json:"sku,omitempty"`
-	SocManufacturer    string `protobuf:"bytes,14,opt,name=socManufacturer,proto3" json:"socManufacturer,omitempty"`
-	SocModel           string `protobuf:"bytes,15,opt,name=socModel,proto3" json:"socModel,omitempty"`
-	Tags               string `protobuf:"bytes,16,opt,name=tags,proto3" json:"tags,omitempty"`
-	Type               string `protobuf:"bytes,17,opt,name=type,proto3" json:"type,omitempty"`
-	IncrementalVersion string `protobuf:"bytes,18,opt,name=incrementalVersion,proto3" json:"incrementalVersion,omitempty"`
+	StreamId  int64 `protobuf:"varint,1,opt,name=streamId,proto3" json:"streamId,omitempty"`
+	StreamDep int64 `protobuf:"varint,2,opt,name=streamDep,proto3" json:"streamDep,omitempty"`
+	Exclusive bool  `protobuf:"varint,3,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
+	Weight    int32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func (x *AndroidDevice_BuildData) Reset() {
-	*x = AndroidDevice_BuildData{}
+func (x *Browser_HTTPFingerprint_PriorityFrameOpts) Reset() {
+	*x = Browser_HTTPFingerprint_PriorityFrameOpts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[48]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AndroidDevice_BuildData) String() string {
+func (x *Browser_HTTPFingerprint_PriorityFrameOpts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AndroidDevice_BuildData) ProtoMessage() {}
+func (*Browser_HTTPFingerprint_PriorityFrameOpts) ProtoMessage() {}
 
-func (x *AndroidDevice_BuildData) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[48]
+func (x *Browser_HTTPFingerprint_PriorityFrameOpts) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5664,164 +6091,364 @@ func (x *AndroidDevice_BuildData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AndroidDevice_BuildData.ProtoReflect.Descriptor instead.
-func (*AndroidDevice_BuildData) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{8, 1}
+// Deprecated: Use Browser_HTTPFingerprint_PriorityFrameOpts.ProtoReflect.Descriptor instead.
+func (*Browser_HTTPFingerprint_PriorityFrameOpts) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 1, 0}
 }
 
-func (x *AndroidDevice_BuildData) GetBoard() string {
+func (x *Browser_HTTPFingerprint_PriorityFrameOpts) GetStreamId() int64 {
 	if x != nil {
-		return x.Board
+		return x.StreamId
 	}
-	return ""
+	return 0
 }
 
-func (x *AndroidDevice_BuildData) GetBootloader() string {
+func (x *Browser_HTTPFingerprint_PriorityFrameOpts) GetStreamDep() int64 {
 	if x != nil {
-		return x.Bootloader
+		return x.StreamDep
 	}
-	return ""
+	return 0
 }
 
-func (x *AndroidDevice_BuildData) GetBrand() string {
+func (x *Browser_HTTPFingerprint_PriorityFrameOpts) GetExclusive() bool {
 	if x != nil {
-		return x.Brand
+		return x.Exclusive
 	}
-	return ""
+	return false
 }
 
-func (x *AndroidDevice_BuildData) GetDevice() string {
+func (x *Browser_HTTPFingerprint_PriorityFrameOpts) GetWeight() int32 {
 	if x != nil {
-		return x.Device
+		return x.Weight
 	}
-	return ""
+	return 0
 }
 
-func (x *AndroidDevice_BuildData) GetDisplay() string {
-	if x != nil {
-		return x.Display
-	}
-	return ""
+type Browser_HTTPFingerprint_SettingsFrameOpts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeaderTableSize      int64 `protobuf:"varint,1,opt,name=headerTableSize,proto3" json:"headerTableSize,omitempty"`
+	EnablePush           int64 `protobuf:"varint,2,opt,name=enablePush,proto3" json:"enablePush,omitempty"`
+	MaxConcurrentStreams int64 `protobuf:"varint,3,opt,name=maxConcurrentStreams,proto3" json:"maxConcurrentStreams,omitempty"`
+	InitialWindowSize    int64 `protobuf:"varint,4,opt,name=initialWindowSize,proto3" json:"initialWindowSize,omitempty"`
+	MaxFrameSize         int64 `protobuf:"varint,5,opt,name=maxFrameSize,proto3" json:"maxFrameSize,omitempty"`
+	MaxHeaderListSize    int64 `protobuf:"varint,6,opt,name=maxHeaderListSize,proto3" json:"maxHeaderListSize,omitempty"`
 }
 
-func (x *AndroidDevice_BuildData) GetFingerprint() string {
-	if x != nil {
-		return x.Fingerprint
+func (x *Browser_HTTPFingerprint_SettingsFrameOpts) Reset() {
+	*x = Browser_HTTPFingerprint_SettingsFrameOpts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AndroidDevice_BuildData) GetHardware() string {
-	if x != nil {
-		return x.Hardware
-	}
-	return ""
+func (x *Browser_HTTPFingerprint_SettingsFrameOpts) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AndroidDevice_BuildData) GetId() string {
-	if x != nil {
-		return x.Id
+func (*Browser_HTTPFingerprint_SettingsFrameOpts) ProtoMessage() {}
+
+func (x *Browser_HTTPFingerprint_SettingsFrameOpts) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AndroidDevice_BuildData) GetManufacturer() string {
+// Deprecated: Use Browser_HTTPFingerprint_SettingsFrameOpts.ProtoReflect.Descriptor instead.
+func (*Browser_HTTPFingerprint_SettingsFrameOpts) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 1, 1}
+}
+
+func (x *Browser_HTTPFingerprint_SettingsFrameOpts) GetHeaderTableSize() int64 {
 	if x != nil {
-		return x.Manufacturer
+		return x.HeaderTableSize
 	}
-	return ""
+	return 0
 }
 
-func (x *AndroidDevice_BuildData) GetModel() string {
+func (x *Browser_HTTPFingerprint_SettingsFrameOpts) GetEnablePush() int64 {
 	if x != nil {
-		return x.Model
+		return x.EnablePush
 	}
-	return ""
+	return 0
 }
 
-func (x *AndroidDevice_BuildData) GetOdmSku() string {
+func (x *Browser_HTTPFingerprint_SettingsFrameOpts) GetMaxConcurrentStreams() int64 {
 	if x != nil {
-		return x.OdmSku
+		return x.MaxConcurrentStreams
 	}
-	return ""
+	return 0
 }
 
-func (x *AndroidDevice_BuildData) GetProduct() string {
+func (x *Browser_HTTPFingerprint_SettingsFrameOpts) GetInitialWindowSize() int64 {
 	if x != nil {
-		return x.Product
+		return x.InitialWindowSize
 	}
-	return ""
+	return 0
 }
 
-func (x *AndroidDevice_BuildData) GetSku() string {
+func (x *Browser_HTTPFingerprint_SettingsFrameOpts) GetMaxFrameSize() int64 {
 	if x != nil {
-		return x.Sku
+		return x.MaxFrameSize
 	}
-	return ""
+	return 0
 }
 
-func (x *AndroidDevice_BuildData) GetSocManufacturer() string {
+func (x *Browser_HTTPFingerprint_SettingsFrameOpts) GetMaxHeaderListSize() int64 {
 	if x != nil {
-		return x.SocManufacturer
+		return x.MaxHeaderListSize
 	}
-	return ""
+	return 0
 }
 
-func (x *AndroidDevice_BuildData) GetSocModel() string {
+type Browser_HTTPFingerprint_Setting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value uint32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Browser_HTTPFingerprint_Setting) Reset() {
+	*x = Browser_HTTPFingerprint_Setting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Browser_HTTPFingerprint_Setting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Browser_HTTPFingerprint_Setting) ProtoMessage() {}
+
+func (x *Browser_HTTPFingerprint_Setting) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Browser_HTTPFingerprint_Setting.ProtoReflect.Descriptor instead.
+func (*Browser_HTTPFingerprint_Setting) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 1, 2}
+}
+
+func (x *Browser_HTTPFingerprint_Setting) GetId() uint32 {
 	if x != nil {
-		return x.SocModel
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *AndroidDevice_BuildData) GetTags() string {
+func (x *Browser_HTTPFingerprint_Setting) GetValue() uint32 {
 	if x != nil {
-		return x.Tags
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-func (x *AndroidDevice_BuildData) GetType() string {
+type Browser_HTTPFingerprint_Frame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        Browser_HTTPFingerprint_FrameType          `protobuf:"varint,1,opt,name=type,proto3,enum=device_utils.Browser_HTTPFingerprint_FrameType" json:"type,omitempty"`
+	StreamId    uint32                                     `protobuf:"varint,2,opt,name=streamId,proto3" json:"streamId,omitempty"`
+	Length      uint32                                     `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	Flags       uint32                                     `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	Settings    []*Browser_HTTPFingerprint_Setting         `protobuf:"bytes,5,rep,name=settings,proto3" json:"settings,omitempty"`
+	Increment   uint32                                     `protobuf:"varint,6,opt,name=increment,proto3" json:"increment,omitempty"`
+	Priority    *Browser_HTTPFingerprint_PriorityFrameOpts `protobuf:"bytes,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	HeaderOrder []string                                   `protobuf:"bytes,8,rep,name=headerOrder,proto3" json:"headerOrder,omitempty"`
+}
+
+func (x *Browser_HTTPFingerprint_Frame) Reset() {
+	*x = Browser_HTTPFingerprint_Frame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Browser_HTTPFingerprint_Frame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Browser_HTTPFingerprint_Frame) ProtoMessage() {}
+
+func (x *Browser_HTTPFingerprint_Frame) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Browser_HTTPFingerprint_Frame.ProtoReflect.Descriptor instead.
+func (*Browser_HTTPFingerprint_Frame) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 1, 3}
+}
+
+func (x *Browser_HTTPFingerprint_Frame) GetType() Browser_HTTPFingerprint_FrameType {
 	if x != nil {
 		return x.Type
 	}
-	return ""
+	return Browser_HTTPFingerprint_DATA
 }
 
-func (x *AndroidDevice_BuildData) GetIncrementalVersion() string {
+func (x *Browser_HTTPFingerprint_Frame) GetStreamId() uint32 {
 	if x != nil {
-		return x.IncrementalVersion
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *Browser_HTTPFingerprint_Frame) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Browser_HTTPFingerprint_Frame) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *Browser_HTTPFingerprint_Frame) GetSettings() []*Browser_HTTPFingerprint_Setting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Browser_HTTPFingerprint_Frame) GetIncrement() uint32 {
+	if x != nil {
+		return x.Increment
+	}
+	return 0
+}
+
+func (x *Browser_HTTPFingerprint_Frame) GetPriority() *Browser_HTTPFingerprint_PriorityFrameOpts {
+	if x != nil {
+		return x.Priority
+	}
+	return nil
+}
+
+func (x *Browser_HTTPFingerprint_Frame) GetHeaderOrder() []string {
+	if x != nil {
+		return x.HeaderOrder
+	}
+	return nil
+}
+
+type Browser_BrowserScreen_Orientation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Angle int32  `protobuf:"varint,1,opt,name=angle,proto3" json:"angle,omitempty"`
+	Type  string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *Browser_BrowserScreen_Orientation) Reset() {
+	*x = Browser_BrowserScreen_Orientation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Browser_BrowserScreen_Orientation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Browser_BrowserScreen_Orientation) ProtoMessage() {}
+
+func (x *Browser_BrowserScreen_Orientation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Browser_BrowserScreen_Orientation.ProtoReflect.Descriptor instead.
+func (*Browser_BrowserScreen_Orientation) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 4, 0}
+}
+
+func (x *Browser_BrowserScreen_Orientation) GetAngle() int32 {
+	if x != nil {
+		return x.Angle
+	}
+	return 0
+}
+
+func (x *Browser_BrowserScreen_Orientation) GetType() string {
+	if x != nil {
+		return x.Type
 	}
 	return ""
 }
 
-type AndroidDevice_DeviceSoftware struct {
+type Browser_WebGPU_Features struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SoftwareMetaData map[string]string `protobuf:"bytes,1,rep,name=softwareMetaData,proto3" json:"softwareMetaData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// app package : version string
-	AppMetaData map[string]string `protobuf:"bytes,2,rep,name=appMetaData,proto3" json:"appMetaData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Size int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *AndroidDevice_DeviceSoftware) Reset() {
-	*x = AndroidDevice_DeviceSoftware{}
+func (x *Browser_WebGPU_Features) Reset() {
+	*x = Browser_WebGPU_Features{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_device_utils_device_utils_proto_msgTypes[49]
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AndroidDevice_DeviceSoftware) String() string {
+func (x *Browser_WebGPU_Features) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AndroidDevice_DeviceSoftware) ProtoMessage() {}
+func (*Browser_WebGPU_Features) ProtoMessage() {}
 
-func (x *AndroidDevice_DeviceSoftware) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_utils_device_utils_proto_msgTypes[49]
+func (x *Browser_WebGPU_Features) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5832,41 +6459,744 @@ func (x *AndroidDevice_DeviceSoftware) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AndroidDevice_DeviceSoftware.ProtoReflect.Descriptor instead.
-func (*AndroidDevice_DeviceSoftware) Descriptor() ([]byte, []int) {
-	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{8, 2}
+// Deprecated: Use Browser_WebGPU_Features.ProtoReflect.Descriptor instead.
+func (*Browser_WebGPU_Features) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 5, 0}
 }
 
-func (x *AndroidDevice_DeviceSoftware) GetSoftwareMetaData() map[string]string {
+func (x *Browser_WebGPU_Features) GetSize() int64 {
 	if x != nil {
-		return x.SoftwareMetaData
+		return x.Size
 	}
-	return nil
+	return 0
 }
 
-func (x *AndroidDevice_DeviceSoftware) GetAppMetaData() map[string]string {
+type Browser_Plugin_MIMEType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Suffixes    string `protobuf:"bytes,2,opt,name=suffixes,proto3" json:"suffixes,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *Browser_Plugin_MIMEType) Reset() {
+	*x = Browser_Plugin_MIMEType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Browser_Plugin_MIMEType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Browser_Plugin_MIMEType) ProtoMessage() {}
+
+func (x *Browser_Plugin_MIMEType) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Browser_Plugin_MIMEType.ProtoReflect.Descriptor instead.
+func (*Browser_Plugin_MIMEType) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 7, 0}
+}
+
+func (x *Browser_Plugin_MIMEType) GetType() string {
 	if x != nil {
-		return x.AppMetaData
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-var File_proto_device_utils_device_utils_proto protoreflect.FileDescriptor
+func (x *Browser_Plugin_MIMEType) GetSuffixes() string {
+	if x != nil {
+		return x.Suffixes
+	}
+	return ""
+}
 
-var file_proto_device_utils_device_utils_proto_rawDesc = []byte{
-	0x0a, 0x25, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x75,
-	0x74, 0x69, 0x6c, 0x73, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x75, 0x74, 0x69, 0x6c, 0x73, 0x22, 0xa1, 0xd7, 0x01, 0x0a, 0x07, 0x42, 0x72, 0x6f, 0x77, 0x73,
-	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x4c, 0x0a, 0x0e, 0x74, 0x6c, 0x73, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
-	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
+func (x *Browser_Plugin_MIMEType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type Browser_HighEntropyValues_Brand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand   string `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Browser_HighEntropyValues_Brand) Reset() {
+	*x = Browser_HighEntropyValues_Brand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Browser_HighEntropyValues_Brand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Browser_HighEntropyValues_Brand) ProtoMessage() {}
+
+func (x *Browser_HighEntropyValues_Brand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Browser_HighEntropyValues_Brand.ProtoReflect.Descriptor instead.
+func (*Browser_HighEntropyValues_Brand) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 15, 0}
+}
+
+func (x *Browser_HighEntropyValues_Brand) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *Browser_HighEntropyValues_Brand) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type Browser_WebRTC_Codec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channels   int32  `protobuf:"varint,1,opt,name=channels,proto3" json:"channels,omitempty"`
+	ClockRate  int64  `protobuf:"varint,2,opt,name=clockRate,proto3" json:"clockRate,omitempty"`
+	MimeType   string `protobuf:"bytes,3,opt,name=mimeType,proto3" json:"mimeType,omitempty"`
+	SdpFmtLine string `protobuf:"bytes,4,opt,name=sdpFmtLine,proto3" json:"sdpFmtLine,omitempty"`
+}
+
+func (x *Browser_WebRTC_Codec) Reset() {
+	*x = Browser_WebRTC_Codec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Browser_WebRTC_Codec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Browser_WebRTC_Codec) ProtoMessage() {}
+
+func (x *Browser_WebRTC_Codec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Browser_WebRTC_Codec.ProtoReflect.Descriptor instead.
+func (*Browser_WebRTC_Codec) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 16, 0}
+}
+
+func (x *Browser_WebRTC_Codec) GetChannels() int32 {
+	if x != nil {
+		return x.Channels
+	}
+	return 0
+}
+
+func (x *Browser_WebRTC_Codec) GetClockRate() int64 {
+	if x != nil {
+		return x.ClockRate
+	}
+	return 0
+}
+
+func (x *Browser_WebRTC_Codec) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *Browser_WebRTC_Codec) GetSdpFmtLine() string {
+	if x != nil {
+		return x.SdpFmtLine
+	}
+	return ""
+}
+
+type Browser_WebRTC_HeaderExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Direction string `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"`
+	Uri       string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *Browser_WebRTC_HeaderExtension) Reset() {
+	*x = Browser_WebRTC_HeaderExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Browser_WebRTC_HeaderExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Browser_WebRTC_HeaderExtension) ProtoMessage() {}
+
+func (x *Browser_WebRTC_HeaderExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Browser_WebRTC_HeaderExtension.ProtoReflect.Descriptor instead.
+func (*Browser_WebRTC_HeaderExtension) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 16, 1}
+}
+
+func (x *Browser_WebRTC_HeaderExtension) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *Browser_WebRTC_HeaderExtension) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type Browser_WebRTC_CodecInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Codecs           []*Browser_WebRTC_Codec           `protobuf:"bytes,1,rep,name=codecs,proto3" json:"codecs,omitempty"`
+	HeaderExtensions []*Browser_WebRTC_HeaderExtension `protobuf:"bytes,2,rep,name=headerExtensions,proto3" json:"headerExtensions,omitempty"`
+}
+
+func (x *Browser_WebRTC_CodecInformation) Reset() {
+	*x = Browser_WebRTC_CodecInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Browser_WebRTC_CodecInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Browser_WebRTC_CodecInformation) ProtoMessage() {}
+
+func (x *Browser_WebRTC_CodecInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Browser_WebRTC_CodecInformation.ProtoReflect.Descriptor instead.
+func (*Browser_WebRTC_CodecInformation) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{0, 16, 2}
+}
+
+func (x *Browser_WebRTC_CodecInformation) GetCodecs() []*Browser_WebRTC_Codec {
+	if x != nil {
+		return x.Codecs
+	}
+	return nil
+}
+
+func (x *Browser_WebRTC_CodecInformation) GetHeaderExtensions() []*Browser_WebRTC_HeaderExtension {
+	if x != nil {
+		return x.HeaderExtensions
+	}
+	return nil
+}
+
+type SIMCard_IMEI struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TAC string `protobuf:"bytes,1,opt,name=TAC,proto3" json:"TAC,omitempty"`
+	// src: https://en.wikipedia.org/wiki/International_Mobile_Equipment_Identity
+	// AA	-	BB	BB	BB	-	CC	CC	CC	D
+	// Where AA and BB = TAC, CC = serial (randomized) and D = Luhn validation
+	Imei string `protobuf:"bytes,2,opt,name=imei,proto3" json:"imei,omitempty"`
+}
+
+func (x *SIMCard_IMEI) Reset() {
+	*x = SIMCard_IMEI{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SIMCard_IMEI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SIMCard_IMEI) ProtoMessage() {}
+
+func (x *SIMCard_IMEI) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SIMCard_IMEI.ProtoReflect.Descriptor instead.
+func (*SIMCard_IMEI) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *SIMCard_IMEI) GetTAC() string {
+	if x != nil {
+		return x.TAC
+	}
+	return ""
+}
+
+func (x *SIMCard_IMEI) GetImei() string {
+	if x != nil {
+		return x.Imei
+	}
+	return ""
+}
+
+type SIMCard_MEID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RegionCode       string `protobuf:"bytes,1,opt,name=RegionCode,proto3" json:"RegionCode,omitempty"`
+	ManufacturerCode string `protobuf:"bytes,2,opt,name=ManufacturerCode,proto3" json:"ManufacturerCode,omitempty"`
+	// src: https://en.wikipedia.org/wiki/Mobile_equipment_identifier
+	// R R	X	X	X	X	X	X	Z	Z	Z	Z	Z	Z	C
+	// Where R = region, X = manufacturer, Z = serial (randomized) and C = Luhn validation
+	Meid string `protobuf:"bytes,3,opt,name=meid,proto3" json:"meid,omitempty"`
+}
+
+func (x *SIMCard_MEID) Reset() {
+	*x = SIMCard_MEID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SIMCard_MEID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SIMCard_MEID) ProtoMessage() {}
+
+func (x *SIMCard_MEID) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SIMCard_MEID.ProtoReflect.Descriptor instead.
+func (*SIMCard_MEID) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *SIMCard_MEID) GetRegionCode() string {
+	if x != nil {
+		return x.RegionCode
+	}
+	return ""
+}
+
+func (x *SIMCard_MEID) GetManufacturerCode() string {
+	if x != nil {
+		return x.ManufacturerCode
+	}
+	return ""
+}
+
+func (x *SIMCard_MEID) GetMeid() string {
+	if x != nil {
+		return x.Meid
+	}
+	return ""
+}
+
+type AndroidDevice_ID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AndroidDevice_ID) Reset() {
+	*x = AndroidDevice_ID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AndroidDevice_ID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AndroidDevice_ID) ProtoMessage() {}
+
+func (x *AndroidDevice_ID) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AndroidDevice_ID.ProtoReflect.Descriptor instead.
+func (*AndroidDevice_ID) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *AndroidDevice_ID) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// https://developer.android.com/reference/android/os/Build
+type AndroidDevice_BuildData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board              string `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Bootloader         string `protobuf:"bytes,2,opt,name=bootloader,proto3" json:"bootloader,omitempty"`
+	Brand              string `protobuf:"bytes,3,opt,name=brand,proto3" json:"brand,omitempty"`
+	Device             string `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	Display            string `protobuf:"bytes,5,opt,name=display,proto3" json:"display,omitempty"`
+	Fingerprint        string `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Hardware           string `protobuf:"bytes,7,opt,name=hardware,proto3" json:"hardware,omitempty"`
+	Id                 string `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
+	Manufacturer       string `protobuf:"bytes,9,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Model              string `protobuf:"bytes,10,opt,name=model,proto3" json:"model,omitempty"`
+	OdmSku             string `protobuf:"bytes,11,opt,name=odmSku,proto3" json:"odmSku,omitempty"`
+	Product            string `protobuf:"bytes,12,opt,name=product,proto3" json:"product,omitempty"`
+	Sku                string `protobuf:"bytes,13,opt,name=sku,proto3" json:"sku,omitempty"`
+	SocManufacturer    string `protobuf:"bytes,14,opt,name=socManufacturer,proto3" json:"socManufacturer,omitempty"`
+	SocModel           string `protobuf:"bytes,15,opt,name=socModel,proto3" json:"socModel,omitempty"`
+	Tags               string `protobuf:"bytes,16,opt,name=tags,proto3" json:"tags,omitempty"`
+	Type               string `protobuf:"bytes,17,opt,name=type,proto3" json:"type,omitempty"`
+	IncrementalVersion string `protobuf:"bytes,18,opt,name=incrementalVersion,proto3" json:"incrementalVersion,omitempty"`
+}
+
+func (x *AndroidDevice_BuildData) Reset() {
+	*x = AndroidDevice_BuildData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AndroidDevice_BuildData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AndroidDevice_BuildData) ProtoMessage() {}
+
+func (x *AndroidDevice_BuildData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AndroidDevice_BuildData.ProtoReflect.Descriptor instead.
+func (*AndroidDevice_BuildData) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *AndroidDevice_BuildData) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetBootloader() string {
+	if x != nil {
+		return x.Bootloader
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetDisplay() string {
+	if x != nil {
+		return x.Display
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetHardware() string {
+	if x != nil {
+		return x.Hardware
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetOdmSku() string {
+	if x != nil {
+		return x.OdmSku
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetSocManufacturer() string {
+	if x != nil {
+		return x.SocManufacturer
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetSocModel() string {
+	if x != nil {
+		return x.SocModel
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetTags() string {
+	if x != nil {
+		return x.Tags
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AndroidDevice_BuildData) GetIncrementalVersion() string {
+	if x != nil {
+		return x.IncrementalVersion
+	}
+	return ""
+}
+
+type AndroidDevice_DeviceSoftware struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SoftwareMetaData map[string]string `protobuf:"bytes,1,rep,name=softwareMetaData,proto3" json:"softwareMetaData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// app package : version string
+	AppMetaData map[string]string `protobuf:"bytes,2,rep,name=appMetaData,proto3" json:"appMetaData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *AndroidDevice_DeviceSoftware) Reset() {
+	*x = AndroidDevice_DeviceSoftware{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_device_utils_device_utils_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AndroidDevice_DeviceSoftware) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AndroidDevice_DeviceSoftware) ProtoMessage() {}
+
+func (x *AndroidDevice_DeviceSoftware) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_utils_device_utils_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AndroidDevice_DeviceSoftware.ProtoReflect.Descriptor instead.
+func (*AndroidDevice_DeviceSoftware) Descriptor() ([]byte, []int) {
+	return file_proto_device_utils_device_utils_proto_rawDescGZIP(), []int{8, 2}
+}
+
+func (x *AndroidDevice_DeviceSoftware) GetSoftwareMetaData() map[string]string {
+	if x != nil {
+		return x.SoftwareMetaData
+	}
+	return nil
+}
+
+func (x *AndroidDevice_DeviceSoftware) GetAppMetaData() map[string]string {
+	if x != nil {
+		return x.AppMetaData
+	}
+	return nil
+}
+
+var File_proto_device_utils_device_utils_proto protoreflect.FileDescriptor
+
+var file_proto_device_utils_device_utils_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x75,
+	0x74, 0x69, 0x6c, 0x73, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x75, 0x74, 0x69, 0x6c, 0x73, 0x22, 0xe2, 0xf8, 0x01, 0x0a, 0x07, 0x42, 0x72, 0x6f, 0x77, 0x73,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x4c, 0x0a, 0x0e, 0x74, 0x6c, 0x73, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
 	0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e,
 	0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x0e,
 	0x74, 0x6c, 0x73, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x4f,
@@ -5945,1485 +7275,1643 @@ var file_proto_device_utils_device_utils_proto_rawDesc = []byte{
 	0x65, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x77, 0x65, 0x62, 0x52, 0x54, 0x43, 0x18, 0x2f, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
 	0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x62, 0x52, 0x54, 0x43,
-	0x52, 0x06, 0x77, 0x65, 0x62, 0x52, 0x54, 0x43, 0x12, 0x4f, 0x0a, 0x0e, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x6e, 0x74, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
-	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x6e, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x09, 0x6e, 0x61, 0x76,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64,
+	0x52, 0x06, 0x77, 0x65, 0x62, 0x52, 0x54, 0x43, 0x12, 0x4c, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
+	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x09, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65,
+	0x41, 0x70, 0x70, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
+	0x2e, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x41, 0x70, 0x70, 0x52, 0x09, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x65, 0x41, 0x70, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x77, 0x36, 0x34, 0x18, 0x32,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x77, 0x6f, 0x77, 0x36, 0x34, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x61, 0x72, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
+	0x61, 0x72, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64,
 	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77,
-	0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x3f, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
-	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x12, 0x43, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x67, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69,
-	0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73,
-	0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
-	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0a, 0x61, 0x75, 0x64,
-	0x69, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x73, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x45, 0x0a, 0x0c, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x36, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
+	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x42, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x37, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
+	0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61,
+	0x63, 0x6b, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x4e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x18, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x42, 0x6f,
+	0x74, 0x18, 0x39, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x42, 0x6f, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x46, 0x6f, 0x6e, 0x74, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72,
+	0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x46, 0x6f, 0x6e, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x09, 0x6e, 0x61, 0x76, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65,
+	0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3f,
+	0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72,
+	0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x43, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x67, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73,
+	0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
 	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f,
 	0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x18, 0x6a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73,
+	0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x47, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x6a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74,
+	0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x03, 0x63, 0x73, 0x73,
+	0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
 	0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72,
 	0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x03, 0x63,
-	0x73, 0x73, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e,
-	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x03, 0x63, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x02, 0x67, 0x6c, 0x18, 0x6c, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
-	0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65,
-	0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x67, 0x6c, 0x12,
-	0x39, 0x0a, 0x03, 0x67, 0x6c, 0x32, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77,
-	0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x67, 0x6c, 0x32, 0x12, 0x4f, 0x0a, 0x0e, 0x67, 0x6c,
-	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x6e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
-	0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65,
-	0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x67, 0x6c, 0x45,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x1a, 0xa2, 0xa8, 0x01, 0x0a,
-	0x0e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12,
-	0x4e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x34, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
-	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x54, 0x0a, 0x0c, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
-	0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53,
-	0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x43, 0x69, 0x70, 0x68,
-	0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x0c, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53,
-	0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
-	0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5a, 0x0a, 0x0e, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69,
-	0x63, 0x43, 0x75, 0x72, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x32, 0x2e,
+	0x03, 0x63, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x02, 0x67, 0x6c, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
+	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x67, 0x6c, 0x12, 0x39, 0x0a,
+	0x03, 0x67, 0x6c, 0x32, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65,
+	0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x03, 0x67, 0x6c, 0x32, 0x12, 0x4f, 0x0a, 0x0e, 0x67, 0x6c, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
+	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x67, 0x6c, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x1a, 0x8f, 0xb0, 0x01, 0x0a, 0x0e, 0x54,
+	0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x4e, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72,
+	0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a,
+	0x0c, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69,
+	0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72,
+	0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x0c, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69,
+	0x74, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54,
+	0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x5a, 0x0a, 0x0e, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69, 0x63, 0x43,
+	0x75, 0x72, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73,
+	0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x2e, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69, 0x63, 0x43, 0x75, 0x72, 0x76, 0x65, 0x52,
+	0x0e, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69, 0x63, 0x43, 0x75, 0x72, 0x76, 0x65, 0x73, 0x12,
+	0x7b, 0x0a, 0x19, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69, 0x63, 0x43, 0x75, 0x72, 0x76, 0x65,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x3d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
+	0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69,
+	0x63, 0x43, 0x75, 0x72, 0x76, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x19, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69, 0x63, 0x43, 0x75, 0x72, 0x76, 0x65,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x0d,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69,
+	0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0xdb, 0x21, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x50, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e,
 	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f,
 	0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
-	0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69, 0x63, 0x43, 0x75, 0x72, 0x76,
-	0x65, 0x52, 0x0e, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69, 0x63, 0x43, 0x75, 0x72, 0x76, 0x65,
-	0x73, 0x12, 0x7b, 0x0a, 0x19, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69, 0x63, 0x43, 0x75, 0x72,
-	0x76, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x3d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74,
-	0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46,
-	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6c, 0x6c, 0x69, 0x70,
-	0x74, 0x69, 0x63, 0x43, 0x75, 0x72, 0x76, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x52, 0x19, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69, 0x63, 0x43, 0x75, 0x72,
-	0x76, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x58,
-	0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
-	0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53,
-	0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0xee, 0x19, 0x0a, 0x0d, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x50, 0x0a, 0x0b, 0x65, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x2e, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
-	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x0b, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x78, 0x0a, 0x13,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
-	0x68, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x64, 0x65, 0x76, 0x69,
+	0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x66, 0x0a, 0x0d, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73,
+	0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73,
+	0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e,
+	0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x0f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x12, 0x78, 0x0a, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f,
+	0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x73, 0x52, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0xa8, 0x01, 0x0a, 0x23, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x56, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e,
+	0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x23, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x79,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c,
+	0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x61, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x78, 0x0a, 0x13, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e,
+	0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x52, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53,
+	0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72,
+	0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x7b, 0x0a, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x22, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x47, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73,
+	0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x14, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x12, 0x66, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54,
+	0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x72, 0x0a, 0x11, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x2b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69,
+	0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x78, 0x0a, 0x13,
+	0x70, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x64, 0x65, 0x76, 0x69,
 	0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
 	0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x73, 0x52, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x41, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0xa8, 0x01, 0x0a, 0x23, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x56, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x53,
+	0x4b, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x13, 0x70, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x11, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x33, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x44, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73,
+	0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x98, 0x01, 0x0a, 0x1d, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0xe9, 0x88, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74,
 	0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46,
 	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x23, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x78, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x43, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x1d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x98, 0x01, 0x0a, 0x1d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x8d, 0xfc, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x50, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
+	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x6c, 0x6c,
+	0x6f, 0x52, 0x1d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x6c, 0x6c, 0x6f,
+	0x12, 0x8f, 0x01, 0x0a, 0x1a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18,
+	0x81, 0xfe, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54,
+	0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x1a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x1a, 0x8f, 0x01, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x78, 0x0a, 0x1c, 0x73, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x34, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
+	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x1c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x73, 0x1a, 0x43, 0x0a, 0x23, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x1a, 0x27, 0x0a, 0x0f, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x1a, 0xe2, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x7d, 0x0a, 0x0a, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x5d,
 	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72,
 	0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
 	0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61,
 	0x74, 0x61, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
-	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x1c,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74,
-	0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46,
-	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53,
-	0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x72, 0x0a, 0x11, 0x73, 0x75, 0x70,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x2b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74,
-	0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46,
-	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x78, 0x0a,
-	0x13, 0x70, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d,
-	0x6f, 0x64, 0x65, 0x73, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65,
-	0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74,
-	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x50,
-	0x53, 0x4b, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x73, 0x52, 0x13, 0x70, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x11, 0x6b, 0x65, 0x79, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x33, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x44, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
-	0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61,
-	0x72, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x98, 0x01, 0x0a, 0x1d,
-	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0xe9, 0x88,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x22, 0x4c, 0x0a, 0x16, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x4c, 0x49, 0x42, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x4f, 0x54, 0x4c, 0x49, 0x10, 0x02, 0x12, 0x08, 0x0a,
+	0x04, 0x5a, 0x53, 0x54, 0x44, 0x10, 0x03, 0x1a, 0x65, 0x0a, 0x11, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x34,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72,
+	0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x94,
+	0x01, 0x0a, 0x13, 0x50, 0x53, 0x4b, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x4b, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
 	0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53,
 	0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x1d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x98, 0x01, 0x0a, 0x1d, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x8d, 0xfc, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x50, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
-	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x6c,
-	0x6c, 0x6f, 0x52, 0x1d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x6c, 0x6c,
-	0x6f, 0x12, 0x8f, 0x01, 0x0a, 0x1a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x53, 0x4b, 0x4b, 0x65, 0x79,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x04, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x44, 0x48, 0x45, 0x10, 0x01, 0x1a, 0xea, 0x01, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x09, 0x6b,
+	0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4d,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72,
+	0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x09, 0x6b,
+	0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x1a, 0x68, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x48, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69,
+	0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x74,
+	0x69, 0x63, 0x43, 0x75, 0x72, 0x76, 0x65, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x1a, 0x3d, 0x0a, 0x1d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x73, 0x1a, 0x8b, 0x01, 0x0a, 0x1a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
 	0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x18, 0x81, 0xfe, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e,
-	0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x1a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x1a, 0x8f, 0x01, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x78, 0x0a, 0x1c, 0x73,
-	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0e, 0x32, 0x34, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73,
-	0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67,
-	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x1c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72,
-	0x69, 0x74, 0x68, 0x6d, 0x73, 0x1a, 0x43, 0x0a, 0x23, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x1a, 0x27, 0x0a, 0x0f, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x1a, 0xe2, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
-	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x7d, 0x0a, 0x0a, 0x61,
-	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x5d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
-	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
-	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x22, 0x4c, 0x0a, 0x16, 0x43, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52,
-	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x4c, 0x49, 0x42,
-	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x4f, 0x54, 0x4c, 0x49, 0x10, 0x02, 0x12, 0x08,
-	0x0a, 0x04, 0x5a, 0x53, 0x54, 0x44, 0x10, 0x03, 0x1a, 0x65, 0x0a, 0x11, 0x53, 0x75, 0x70, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a,
-	0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x34, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
-	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
-	0x94, 0x01, 0x0a, 0x13, 0x50, 0x53, 0x4b, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x4b, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c,
-	0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x53, 0x4b, 0x4b, 0x65,
-	0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x2e, 0x4d,
-	0x6f, 0x64, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x04, 0x4d, 0x6f,
-	0x64, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a,
-	0x03, 0x44, 0x48, 0x45, 0x10, 0x01, 0x1a, 0xea, 0x01, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x09,
-	0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x4d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
-	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x09,
-	0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x1a, 0x68, 0x0a, 0x08, 0x4b, 0x65, 0x79,
-	0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x48, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74,
+	0x12, 0x6d, 0x0a, 0x14, 0x72, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72,
+	0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x14, 0x72, 0x65, 0x6e, 0x65, 0x67,
+	0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x1a,
+	0x9f, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x34, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x15, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x1a, 0x5d, 0x0a, 0x0f, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x12, 0x4a, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74,
 	0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46,
 	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6c, 0x6c, 0x69, 0x70,
-	0x74, 0x69, 0x63, 0x43, 0x75, 0x72, 0x76, 0x65, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x1a, 0x3d, 0x0a, 0x1d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x73, 0x1a, 0x8b, 0x01, 0x0a, 0x1a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x6d, 0x0a, 0x14, 0x72, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x39, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
-	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x14, 0x72, 0x65, 0x6e, 0x65,
-	0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x1a, 0xb6, 0x05, 0x0a, 0x1d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x6c,
-	0x6c, 0x6f, 0x12, 0x9f, 0x01, 0x0a, 0x15, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x69, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
-	0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48,
-	0x65, 0x6c, 0x6c, 0x6f, 0x2e, 0x48, 0x50, 0x4b, 0x45, 0x53, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x15, 0x63,
-	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75,
-	0x69, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0d, 0x52, 0x14, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x65, 0x6e, 0x73, 0x1a, 0xfa, 0x01, 0x0a, 0x18, 0x48, 0x50, 0x4b,
-	0x45, 0x53, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72,
-	0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x6b, 0x0a, 0x05, 0x6b, 0x64, 0x66, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x55, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74,
-	0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46,
-	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x2e, 0x48, 0x4b, 0x44, 0x46, 0x52, 0x05, 0x6b, 0x64, 0x66,
-	0x49, 0x64, 0x12, 0x71, 0x0a, 0x06, 0x61, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x59, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
+	0x74, 0x69, 0x63, 0x43, 0x75, 0x72, 0x76, 0x65, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x1a, 0x21, 0x0a, 0x07, 0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x1a, 0x90, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x78, 0x0a, 0x1c,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x34, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
 	0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48,
-	0x65, 0x6c, 0x6c, 0x6f, 0x2e, 0x48, 0x50, 0x4b, 0x45, 0x41, 0x45, 0x41, 0x44, 0x52, 0x06, 0x61,
-	0x65, 0x61, 0x64, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x04, 0x48, 0x4b, 0x44, 0x46, 0x12, 0x11, 0x0a,
-	0x0d, 0x48, 0x4b, 0x44, 0x46, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x0f, 0x0a, 0x0b, 0x48, 0x4b, 0x44, 0x46, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x48, 0x4b, 0x44, 0x46, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34,
-	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x48, 0x4b, 0x44, 0x46, 0x5f, 0x53, 0x48, 0x41, 0x35, 0x31,
-	0x32, 0x10, 0x03, 0x22, 0x74, 0x0a, 0x08, 0x48, 0x50, 0x4b, 0x45, 0x41, 0x45, 0x41, 0x44, 0x12,
-	0x15, 0x0a, 0x11, 0x48, 0x50, 0x4b, 0x45, 0x41, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x45,
-	0x52, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x48, 0x50, 0x4b, 0x45, 0x41, 0x45,
-	0x41, 0x44, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x10, 0x01,
-	0x12, 0x18, 0x0a, 0x14, 0x48, 0x50, 0x4b, 0x45, 0x41, 0x45, 0x41, 0x44, 0x5f, 0x41, 0x45, 0x53,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x48, 0x50,
-	0x4b, 0x45, 0x41, 0x45, 0x41, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x43, 0x48, 0x41, 0x32, 0x30, 0x50,
-	0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35, 0x10, 0x03, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a,
-	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x04, 0x53, 0x53,
-	0x4c, 0x33, 0x10, 0x80, 0x06, 0x12, 0x09, 0x0a, 0x04, 0x54, 0x4c, 0x53, 0x31, 0x10, 0x81, 0x06,
-	0x12, 0x0b, 0x0a, 0x06, 0x54, 0x4c, 0x53, 0x31, 0x5f, 0x31, 0x10, 0x82, 0x06, 0x12, 0x0b, 0x0a,
-	0x06, 0x54, 0x4c, 0x53, 0x31, 0x5f, 0x32, 0x10, 0x83, 0x06, 0x12, 0x0b, 0x0a, 0x06, 0x54, 0x4c,
-	0x53, 0x31, 0x5f, 0x33, 0x10, 0x84, 0x06, 0x12, 0x0b, 0x0a, 0x05, 0x44, 0x54, 0x4c, 0x53, 0x31,
-	0x10, 0xff, 0xfd, 0x03, 0x12, 0x0d, 0x0a, 0x07, 0x44, 0x54, 0x4c, 0x53, 0x31, 0x5f, 0x32, 0x10,
-	0xfd, 0xfd, 0x03, 0x12, 0x0e, 0x0a, 0x09, 0x44, 0x54, 0x4c, 0x53, 0x31, 0x5f, 0x42, 0x41, 0x44,
-	0x10, 0x80, 0x02, 0x22, 0xac, 0x71, 0x0a, 0x0b, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75,
-	0x69, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x4c, 0x53, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00,
-	0x12, 0x19, 0x0a, 0x15, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x1c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x1a, 0x23, 0x0a, 0x0d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xb6, 0x05, 0x0a, 0x1d,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x9f, 0x01,
+	0x0a, 0x15, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x69, 0x70, 0x68, 0x65,
+	0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x69, 0x2e,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f,
+	0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x2e,
+	0x48, 0x50, 0x4b, 0x45, 0x53, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x69, 0x70,
+	0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x15, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12,
+	0x32, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x4c, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x14, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4c,
+	0x65, 0x6e, 0x73, 0x1a, 0xfa, 0x01, 0x0a, 0x18, 0x48, 0x50, 0x4b, 0x45, 0x53, 0x79, 0x6d, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65,
+	0x12, 0x6b, 0x0a, 0x05, 0x6b, 0x64, 0x66, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x55, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
+	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x6c, 0x6c,
+	0x6f, 0x2e, 0x48, 0x4b, 0x44, 0x46, 0x52, 0x05, 0x6b, 0x64, 0x66, 0x49, 0x64, 0x12, 0x71, 0x0a,
+	0x06, 0x61, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x59, 0x2e,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f,
+	0x77, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x2e,
+	0x48, 0x50, 0x4b, 0x45, 0x41, 0x45, 0x41, 0x44, 0x52, 0x06, 0x61, 0x65, 0x61, 0x64, 0x49, 0x64,
+	0x22, 0x4c, 0x0a, 0x04, 0x48, 0x4b, 0x44, 0x46, 0x12, 0x11, 0x0a, 0x0d, 0x48, 0x4b, 0x44, 0x46,
+	0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x48,
+	0x4b, 0x44, 0x46, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
+	0x48, 0x4b, 0x44, 0x46, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x02, 0x12, 0x0f, 0x0a,
+	0x0b, 0x48, 0x4b, 0x44, 0x46, 0x5f, 0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10, 0x03, 0x22, 0x74,
+	0x0a, 0x08, 0x48, 0x50, 0x4b, 0x45, 0x41, 0x45, 0x41, 0x44, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x50,
+	0x4b, 0x45, 0x41, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x48, 0x50, 0x4b, 0x45, 0x41, 0x45, 0x41, 0x44, 0x5f, 0x41, 0x45,
+	0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x48,
+	0x50, 0x4b, 0x45, 0x41, 0x45, 0x41, 0x44, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x47, 0x43, 0x4d, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x48, 0x50, 0x4b, 0x45, 0x41, 0x45, 0x41,
+	0x44, 0x5f, 0x43, 0x48, 0x41, 0x43, 0x48, 0x41, 0x32, 0x30, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33,
+	0x30, 0x35, 0x10, 0x03, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x04, 0x53, 0x53, 0x4c, 0x33, 0x10, 0x80, 0x06,
+	0x12, 0x09, 0x0a, 0x04, 0x54, 0x4c, 0x53, 0x31, 0x10, 0x81, 0x06, 0x12, 0x0b, 0x0a, 0x06, 0x54,
+	0x4c, 0x53, 0x31, 0x5f, 0x31, 0x10, 0x82, 0x06, 0x12, 0x0b, 0x0a, 0x06, 0x54, 0x4c, 0x53, 0x31,
+	0x5f, 0x32, 0x10, 0x83, 0x06, 0x12, 0x0b, 0x0a, 0x06, 0x54, 0x4c, 0x53, 0x31, 0x5f, 0x33, 0x10,
+	0x84, 0x06, 0x12, 0x0b, 0x0a, 0x05, 0x44, 0x54, 0x4c, 0x53, 0x31, 0x10, 0xff, 0xfd, 0x03, 0x12,
+	0x0d, 0x0a, 0x07, 0x44, 0x54, 0x4c, 0x53, 0x31, 0x5f, 0x32, 0x10, 0xfd, 0xfd, 0x03, 0x12, 0x0e,
+	0x0a, 0x09, 0x44, 0x54, 0x4c, 0x53, 0x31, 0x5f, 0x42, 0x41, 0x44, 0x10, 0x80, 0x02, 0x22, 0xac,
+	0x71, 0x0a, 0x0b, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1b,
+	0x0a, 0x17, 0x54, 0x4c, 0x53, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x54,
 	0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c,
-	0x5f, 0x53, 0x48, 0x41, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
-	0x41, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43,
-	0x34, 0x5f, 0x34, 0x30, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4c,
-	0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31,
-	0x32, 0x38, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f,
-	0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x53, 0x48, 0x41, 0x10, 0x05, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
-	0x41, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43,
-	0x32, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x34, 0x30, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x06, 0x12, 0x1d,
-	0x0a, 0x19, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x49,
-	0x44, 0x45, 0x41, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x07, 0x12, 0x25, 0x0a,
-	0x21, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x34, 0x30, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x10, 0x08, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x10, 0x09, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f,
-	0x53, 0x48, 0x41, 0x10, 0x0a, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f,
-	0x44, 0x53, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x44, 0x45, 0x53, 0x34, 0x30, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x0b, 0x12,
-	0x1f, 0x0a, 0x1b, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x0c,
-	0x12, 0x24, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57,
+	0x5f, 0x4d, 0x44, 0x35, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10,
+	0x02, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x34, 0x30, 0x5f,
+	0x4d, 0x44, 0x35, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x4d, 0x44,
+	0x35, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10,
+	0x05, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x32, 0x5f, 0x43, 0x42, 0x43,
+	0x5f, 0x34, 0x30, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4c, 0x53,
+	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x49, 0x44, 0x45, 0x41, 0x5f, 0x43,
+	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x07, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f,
+	0x52, 0x53, 0x41, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x44, 0x45, 0x53, 0x34, 0x30, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x08, 0x12,
+	0x1c, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x09, 0x12, 0x21, 0x0a,
+	0x1d, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44,
+	0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x0a,
+	0x12, 0x28, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x45,
+	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x34, 0x30,
+	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x0b, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x4c,
+	0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45,
+	0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x0c, 0x12, 0x24, 0x0a, 0x20, 0x54,
+	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33,
+	0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10,
+	0x0d, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f,
+	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x34,
+	0x30, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x0e, 0x12, 0x1f, 0x0a, 0x1b, 0x54,
+	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44,
+	0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x0f, 0x12, 0x24, 0x0a, 0x20,
+	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
+	0x10, 0x10, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53,
+	0x53, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45,
+	0x53, 0x34, 0x30, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x11, 0x12, 0x20, 0x0a,
+	0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x12, 0x12,
+	0x25, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57,
 	0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x10, 0x0d, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
-	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x44, 0x45, 0x53, 0x34, 0x30, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x0e,
-	0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
+	0x5f, 0x53, 0x48, 0x41, 0x10, 0x13, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x44, 0x45, 0x53, 0x34, 0x30, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10,
+	0x14, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
+	0x41, 0x10, 0x15, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52,
+	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45,
+	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x16, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x4c,
+	0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x34, 0x30, 0x5f, 0x4d, 0x44, 0x35,
+	0x10, 0x17, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f,
+	0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x4d,
+	0x44, 0x35, 0x10, 0x18, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61,
+	0x6e, 0x6f, 0x6e, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x44, 0x45, 0x53, 0x34, 0x30, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x19, 0x12,
+	0x20, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57,
 	0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10,
-	0x0f, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x10, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x44,
-	0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x34, 0x30, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x10, 0x11, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53,
-	0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x10, 0x12, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f,
-	0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44,
-	0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x13, 0x12, 0x29, 0x0a, 0x25, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52,
-	0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x34, 0x30, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x10, 0x14, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
-	0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43,
-	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x15, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f,
-	0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45,
-	0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x16, 0x12,
-	0x26, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x45,
-	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x34,
-	0x30, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x17, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44,
-	0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f,
-	0x31, 0x32, 0x38, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x18, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x4c, 0x53,
-	0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x34, 0x30, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x10, 0x19, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61,
-	0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x10, 0x1a, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
-	0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f,
-	0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x1b, 0x12, 0x1d, 0x0a,
-	0x19, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44,
-	0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x1e, 0x12, 0x22, 0x0a, 0x1e,
-	0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44,
-	0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x1f,
-	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x20, 0x12,
-	0x1e, 0x0a, 0x1a, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x49, 0x44, 0x45, 0x41, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x21, 0x12,
-	0x1d, 0x0a, 0x19, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x22, 0x12, 0x22,
-	0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x4d, 0x44, 0x35,
-	0x10, 0x23, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x4d, 0x44, 0x35, 0x10,
-	0x24, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x49, 0x44, 0x45, 0x41, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x4d, 0x44, 0x35, 0x10,
-	0x25, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x45, 0x58,
-	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x34, 0x30, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x26, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c,
-	0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x52, 0x43, 0x32, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x34, 0x30, 0x5f, 0x53, 0x48,
-	0x41, 0x10, 0x27, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f,
-	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f,
-	0x34, 0x30, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x28, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f,
-	0x4b, 0x52, 0x42, 0x35, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x34, 0x30, 0x5f, 0x4d, 0x44, 0x35, 0x10,
-	0x29, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x45, 0x58,
-	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x32, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x34, 0x30, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x2a, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4c,
-	0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x34, 0x30, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x2b, 0x12,
-	0x19, 0x0a, 0x15, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x2c, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4c,
-	0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e,
-	0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x2d, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4c, 0x53,
-	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55,
-	0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x2e, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f,
-	0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x2f, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4c,
-	0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
-	0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x30, 0x12,
-	0x23, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x10, 0x31, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f,
+	0x1a, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43,
+	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x1b, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4c, 0x53, 0x5f,
+	0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42,
+	0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x1e, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x4b,
+	0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44,
+	0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x1f, 0x12, 0x1d, 0x0a, 0x19, 0x54,
+	0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34,
+	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x20, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4c,
+	0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x49, 0x44, 0x45, 0x41,
+	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x21, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4c,
+	0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x22, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x4c, 0x53,
+	0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f,
+	0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x23, 0x12, 0x1d, 0x0a,
+	0x19, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52,
+	0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x24, 0x12, 0x1e, 0x0a, 0x1a,
+	0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x49, 0x44,
+	0x45, 0x41, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x25, 0x12, 0x27, 0x0a, 0x23,
+	0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x34, 0x30, 0x5f,
+	0x53, 0x48, 0x41, 0x10, 0x26, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42,
+	0x35, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43,
+	0x32, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x34, 0x30, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x27, 0x12, 0x23,
+	0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x34, 0x30, 0x5f, 0x53, 0x48,
+	0x41, 0x10, 0x28, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f,
+	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x34, 0x30, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x29, 0x12, 0x27, 0x0a, 0x23,
+	0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42, 0x35, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x32, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x34, 0x30, 0x5f,
+	0x4d, 0x44, 0x35, 0x10, 0x2a, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x4b, 0x52, 0x42,
+	0x35, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43,
+	0x34, 0x5f, 0x34, 0x30, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x2b, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4c,
+	0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f,
+	0x53, 0x48, 0x41, 0x10, 0x2c, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45,
+	0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53,
+	0x48, 0x41, 0x10, 0x2d, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f,
+	0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48,
+	0x41, 0x10, 0x2e, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x10, 0x2f, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f,
 	0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x32, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4c,
-	0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
-	0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x33,
-	0x12, 0x24, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x10, 0x34, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
-	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43,
-	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x35, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f,
-	0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f,
-	0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x36, 0x12, 0x23, 0x0a,
-	0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x10, 0x37, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53,
+	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x30, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4c,
+	0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
+	0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x31, 0x12,
+	0x24, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x10, 0x32, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45,
+	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32,
+	0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x33, 0x12, 0x24, 0x0a, 0x20, 0x54,
+	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10,
+	0x34, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
+	0x41, 0x10, 0x35, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53,
 	0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43,
-	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x38, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f,
-	0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x39, 0x12, 0x24,
-	0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49,
+	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x36, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f,
+	0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f,
+	0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x37, 0x12, 0x24, 0x0a,
+	0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
+	0x41, 0x10, 0x38, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52,
+	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x39, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4c, 0x53,
+	0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
+	0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x3a, 0x12,
+	0x1c, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x3b, 0x12, 0x23, 0x0a,
+	0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
+	0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
+	0x10, 0x3c, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
 	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x10, 0x3a, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
-	0x10, 0x3b, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x3d, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44,
+	0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31,
+	0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x3e, 0x12,
+	0x26, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
 	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x3c, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f,
-	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x3d, 0x12, 0x26, 0x0a, 0x22,
-	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32,
-	0x35, 0x36, 0x10, 0x3e, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f,
-	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x3f, 0x12, 0x27, 0x0a, 0x23,
-	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0x40, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31,
-	0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x41, 0x12, 0x28, 0x0a, 0x24,
-	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x10, 0x42, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
-	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c,
-	0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x43,
-	0x12, 0x29, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f,
+	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x3f, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44,
+	0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f,
+	0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x40,
+	0x12, 0x25, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42,
+	0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x41, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44,
+	0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c,
+	0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10,
+	0x42, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f,
 	0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32,
-	0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x44, 0x12, 0x29, 0x0a, 0x25, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x43, 0x12, 0x29, 0x0a, 0x25, 0x54,
+	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
 	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x10, 0x45, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
-	0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c,
+	0x5f, 0x53, 0x48, 0x41, 0x10, 0x44, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c,
 	0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10,
-	0x46, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x67, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x4c,
-	0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
-	0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
-	0x10, 0x68, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x69, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c,
-	0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
-	0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
-	0x36, 0x10, 0x6a, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f,
-	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x6b, 0x12, 0x27, 0x0a, 0x23,
-	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x45, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31,
+	0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x46, 0x12, 0x27, 0x0a, 0x23,
+	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
 	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0x6c, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f,
-	0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35,
-	0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x6d, 0x12, 0x26,
-	0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43,
-	0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f,
-	0x53, 0x48, 0x41, 0x10, 0x84, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
-	0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c,
-	0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x85,
-	0x01, 0x12, 0x29, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f,
+	0x32, 0x35, 0x36, 0x10, 0x67, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f,
+	0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36,
+	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x68, 0x12, 0x26, 0x0a,
+	0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
+	0x32, 0x35, 0x36, 0x10, 0x69, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45,
+	0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35,
+	0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x6a, 0x12, 0x27,
+	0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
+	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x6b, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44,
+	0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f,
+	0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x6c,
+	0x12, 0x27, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43,
+	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x6d, 0x12, 0x26, 0x0a, 0x21, 0x54, 0x4c, 0x53,
+	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c,
+	0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x84,
+	0x01, 0x12, 0x29, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f,
 	0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35,
-	0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x86, 0x01, 0x12, 0x2a, 0x0a, 0x25,
-	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x87, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f,
-	0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d,
-	0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
-	0x41, 0x10, 0x88, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61,
-	0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49,
-	0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x89, 0x01,
-	0x12, 0x1d, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8a, 0x01, 0x12,
-	0x22, 0x0a, 0x1d, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x10, 0x8b, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
+	0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x85, 0x01, 0x12, 0x29, 0x0a, 0x24,
+	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43,
+	0x5f, 0x53, 0x48, 0x41, 0x10, 0x86, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x44,
+	0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45,
+	0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
+	0x10, 0x87, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52,
+	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41,
+	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x88, 0x01, 0x12,
+	0x2a, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36,
+	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x89, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x54,
+	0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f,
+	0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8a, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x54, 0x4c,
+	0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f,
+	0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8b, 0x01, 0x12, 0x21,
+	0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8c,
+	0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
+	0x41, 0x10, 0x8d, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f,
+	0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38,
+	0x5f, 0x53, 0x48, 0x41, 0x10, 0x8e, 0x01, 0x12, 0x26, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x44,
+	0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53,
+	0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8f, 0x01, 0x12,
+	0x25, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
 	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f,
-	0x53, 0x48, 0x41, 0x10, 0x8c, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53,
-	0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43,
-	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8d, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53,
-	0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43,
-	0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8e, 0x01, 0x12, 0x26, 0x0a, 0x21,
-	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
-	0x41, 0x10, 0x8f, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f,
-	0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x90, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10,
-	0x91, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53,
-	0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53,
-	0x48, 0x41, 0x10, 0x92, 0x01, 0x12, 0x26, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41,
-	0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45,
-	0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x93, 0x01, 0x12, 0x25, 0x0a,
-	0x20, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
-	0x41, 0x10, 0x94, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f,
-	0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x95, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x54,
-	0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x53, 0x45, 0x45, 0x44,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x96, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x53,
-	0x45, 0x45, 0x44, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x97, 0x01, 0x12, 0x21,
-	0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x98,
-	0x01, 0x12, 0x22, 0x0a, 0x1d, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x10, 0x99, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45,
-	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x43,
-	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x9a, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x54, 0x4c, 0x53,
-	0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x53, 0x45,
-	0x45, 0x44, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x9b, 0x01, 0x12, 0x24, 0x0a,
-	0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
-	0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
-	0x10, 0x9c, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
-	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x9d, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53,
-	0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
-	0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
-	0x10, 0x9e, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f,
-	0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x9f, 0x01, 0x12, 0x27, 0x0a,
-	0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0xa0, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
-	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35,
-	0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xa1, 0x01, 0x12,
-	0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
-	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa2, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53,
-	0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
-	0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34,
-	0x10, 0xa3, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53,
-	0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47,
-	0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa4, 0x01, 0x12, 0x27, 0x0a, 0x22,
-	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33,
-	0x38, 0x34, 0x10, 0xa5, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f,
-	0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32,
-	0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa6, 0x01, 0x12,
-	0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
-	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xa7, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x54, 0x4c, 0x53,
-	0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32,
-	0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa8, 0x01, 0x12,
-	0x24, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33,
-	0x38, 0x34, 0x10, 0xa9, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45,
-	0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32,
-	0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xaa, 0x01, 0x12,
-	0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
-	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xab, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53,
-	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
-	0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
-	0x10, 0xac, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50,
-	0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f,
-	0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xad, 0x01, 0x12, 0x24, 0x0a,
-	0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
-	0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
-	0x10, 0xae, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
+	0x53, 0x48, 0x41, 0x10, 0x90, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32,
+	0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x91, 0x01, 0x12, 0x21, 0x0a,
+	0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x92, 0x01,
+	0x12, 0x26, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42,
+	0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x93, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f,
+	0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53,
+	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x94, 0x01, 0x12,
+	0x25, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
 	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f,
-	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xaf, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x54, 0x4c, 0x53,
-	0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53,
-	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb0, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f,
-	0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48,
-	0x41, 0x33, 0x38, 0x34, 0x10, 0xb1, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44,
-	0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f,
-	0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb2,
-	0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xb3, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb4, 0x01, 0x12, 0x21,
-	0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xb5,
-	0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb6, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54,
-	0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33,
-	0x38, 0x34, 0x10, 0xb7, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41,
-	0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53,
-	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb8, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f,
-	0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c,
-	0x4c, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xb9, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x54,
-	0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45,
-	0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0xba, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
-	0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c,
-	0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
-	0x36, 0x10, 0xbb, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0xbc, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53,
-	0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f,
-	0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xbd,
-	0x01, 0x12, 0x2d, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31,
-	0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xbe, 0x01,
-	0x12, 0x2d, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f,
+	0x53, 0x48, 0x41, 0x10, 0x95, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x43, 0x42, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x10, 0x96, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x43,
+	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x97, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53,
+	0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x53, 0x45, 0x45,
+	0x44, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x98, 0x01, 0x12, 0x22, 0x0a, 0x1d,
+	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x99, 0x01,
+	0x12, 0x22, 0x0a, 0x1d, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
+	0x41, 0x10, 0x9a, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61,
+	0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x43, 0x42,
+	0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x9b, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f,
+	0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38,
+	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x9c, 0x01, 0x12, 0x24,
+	0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38,
+	0x34, 0x10, 0x9d, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f,
+	0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38,
+	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x9e, 0x01, 0x12, 0x28,
+	0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53,
+	0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x9f, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f,
+	0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f,
+	0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa0,
+	0x01, 0x12, 0x27, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d,
+	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xa1, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c,
+	0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
+	0x36, 0x10, 0xa2, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f,
+	0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36,
+	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xa3, 0x01, 0x12, 0x27,
+	0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48,
+	0x41, 0x32, 0x35, 0x36, 0x10, 0xa4, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x44,
+	0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32,
+	0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xa5, 0x01,
+	0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d,
+	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa6, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c,
+	0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38,
+	0x34, 0x10, 0xa7, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d,
+	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa8, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x54, 0x4c,
+	0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32,
+	0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xa9, 0x01,
+	0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d,
+	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xaa, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c,
+	0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38,
+	0x34, 0x10, 0xab, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f,
+	0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38,
+	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xac, 0x01, 0x12, 0x28,
+	0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53,
+	0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xad, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f,
+	0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38,
+	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xae, 0x01, 0x12, 0x24,
+	0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38,
+	0x34, 0x10, 0xaf, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
+	0x10, 0xb0, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
+	0xb1, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53,
+	0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43,
+	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb2, 0x01, 0x12, 0x28, 0x0a, 0x23,
+	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
+	0x33, 0x38, 0x34, 0x10, 0xb3, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f,
+	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb4, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53,
+	0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55,
+	0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xb5, 0x01, 0x12, 0x28, 0x0a, 0x23,
+	0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
+	0x32, 0x35, 0x36, 0x10, 0xb6, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32,
+	0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xb7, 0x01,
+	0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
+	0x10, 0xb8, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50,
+	0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41,
+	0x33, 0x38, 0x34, 0x10, 0xb9, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f,
+	0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xba,
+	0x01, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f,
 	0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32,
-	0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xbf, 0x01, 0x12,
-	0x29, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xc0, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x4c,
-	0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41,
-	0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xc1, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f,
-	0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45,
-	0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0xc2, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
-	0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c,
+	0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xbb, 0x01, 0x12,
+	0x2c, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xbc, 0x01, 0x12, 0x2d, 0x0a,
+	0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43,
+	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xbd, 0x01, 0x12, 0x2d, 0x0a, 0x28,
+	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42,
+	0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xbe, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x54,
+	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43,
+	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xbf, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x54, 0x4c,
+	0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c,
 	0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32,
-	0x35, 0x36, 0x10, 0xc3, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45,
-	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c,
-	0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
-	0x36, 0x10, 0xc4, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61,
-	0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49,
+	0x35, 0x36, 0x10, 0xc0, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f,
+	0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49,
 	0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
-	0x10, 0xc5, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x4d, 0x34, 0x5f, 0x47,
-	0x43, 0x4d, 0x5f, 0x53, 0x4d, 0x33, 0x10, 0xc6, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x54, 0x4c, 0x53,
-	0x5f, 0x53, 0x4d, 0x34, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x53, 0x4d, 0x33, 0x10, 0xc7, 0x01, 0x12,
-	0x26, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x4e,
-	0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x5f,
-	0x53, 0x43, 0x53, 0x56, 0x10, 0xff, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x64, 0x10, 0x8a, 0x14, 0x12, 0x0f, 0x0a, 0x0a, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x10, 0x80, 0x26, 0x12, 0x1b, 0x0a, 0x16, 0x54, 0x4c, 0x53, 0x5f, 0x41,
-	0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
-	0x36, 0x10, 0x81, 0x26, 0x12, 0x1b, 0x0a, 0x16, 0x54, 0x4c, 0x53, 0x5f, 0x41, 0x45, 0x53, 0x5f,
-	0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x82,
-	0x26, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x43, 0x48, 0x41, 0x32,
-	0x30, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
-	0x36, 0x10, 0x83, 0x26, 0x12, 0x1b, 0x0a, 0x16, 0x54, 0x4c, 0x53, 0x5f, 0x41, 0x45, 0x53, 0x5f,
-	0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x84,
-	0x26, 0x12, 0x1d, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x85, 0x26,
-	0x12, 0x19, 0x0a, 0x14, 0x54, 0x4c, 0x53, 0x5f, 0x41, 0x45, 0x47, 0x49, 0x53, 0x5f, 0x32, 0x35,
-	0x36, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x86, 0x26, 0x12, 0x1a, 0x0a, 0x15, 0x54,
-	0x4c, 0x53, 0x5f, 0x41, 0x45, 0x47, 0x49, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x4c, 0x5f, 0x53, 0x48,
-	0x41, 0x32, 0x35, 0x36, 0x10, 0x87, 0x26, 0x12, 0x17, 0x0a, 0x11, 0x54, 0x4c, 0x53, 0x5f, 0x46,
-	0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x43, 0x53, 0x56, 0x10, 0x80, 0xac, 0x01,
-	0x12, 0x22, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41,
-	0x10, 0x81, 0x80, 0x03, 0x12, 0x25, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48,
-	0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f,
-	0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x82, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54,
+	0x10, 0xc1, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f,
+	0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xc2,
+	0x01, 0x12, 0x2d, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32,
+	0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xc3, 0x01,
+	0x12, 0x2d, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35,
+	0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xc4, 0x01, 0x12,
+	0x2d, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36,
+	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xc5, 0x01, 0x12, 0x14,
+	0x0a, 0x0f, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x4d, 0x34, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x4d,
+	0x33, 0x10, 0xc6, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x4d, 0x34, 0x5f,
+	0x43, 0x43, 0x4d, 0x5f, 0x53, 0x4d, 0x33, 0x10, 0xc7, 0x01, 0x12, 0x26, 0x0a, 0x21, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x5f, 0x53, 0x43, 0x53, 0x56, 0x10,
+	0xff, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x10, 0x8a,
+	0x14, 0x12, 0x0f, 0x0a, 0x0a, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x10,
+	0x80, 0x26, 0x12, 0x1b, 0x0a, 0x16, 0x54, 0x4c, 0x53, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32,
+	0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x81, 0x26, 0x12,
+	0x1b, 0x0a, 0x16, 0x54, 0x4c, 0x53, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47,
+	0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x82, 0x26, 0x12, 0x21, 0x0a, 0x1c,
+	0x54, 0x4c, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x43, 0x48, 0x41, 0x32, 0x30, 0x5f, 0x50, 0x4f, 0x4c,
+	0x59, 0x31, 0x33, 0x30, 0x35, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x83, 0x26, 0x12,
+	0x1b, 0x0a, 0x16, 0x54, 0x4c, 0x53, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43,
+	0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x84, 0x26, 0x12, 0x1d, 0x0a, 0x18,
+	0x54, 0x4c, 0x53, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f,
+	0x38, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x85, 0x26, 0x12, 0x19, 0x0a, 0x14, 0x54,
+	0x4c, 0x53, 0x5f, 0x41, 0x45, 0x47, 0x49, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x53, 0x48, 0x41,
+	0x33, 0x38, 0x34, 0x10, 0x86, 0x26, 0x12, 0x1a, 0x0a, 0x15, 0x54, 0x4c, 0x53, 0x5f, 0x41, 0x45,
+	0x47, 0x49, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
+	0x87, 0x26, 0x12, 0x17, 0x0a, 0x11, 0x54, 0x4c, 0x53, 0x5f, 0x46, 0x41, 0x4c, 0x4c, 0x42, 0x41,
+	0x43, 0x4b, 0x5f, 0x53, 0x43, 0x53, 0x56, 0x10, 0x80, 0xac, 0x01, 0x12, 0x22, 0x0a, 0x1c, 0x54,
 	0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f,
-	0x53, 0x48, 0x41, 0x10, 0x83, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x45,
-	0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
-	0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x84,
+	0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x81, 0x80, 0x03, 0x12,
+	0x25, 0x0a, 0x1f, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53,
+	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53,
+	0x48, 0x41, 0x10, 0x82, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43,
+	0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44,
+	0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x83,
 	0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45,
-	0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35,
-	0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x85, 0x80, 0x03, 0x12, 0x23, 0x0a,
-	0x1d, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x86,
-	0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f,
-	0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31,
-	0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x87, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c,
-	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f,
-	0x53, 0x48, 0x41, 0x10, 0x88, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x45,
-	0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10,
-	0x89, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45,
-	0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f,
-	0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8a, 0x80, 0x03, 0x12,
-	0x20, 0x0a, 0x1a, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8b, 0x80,
-	0x03, 0x12, 0x23, 0x0a, 0x1d, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53,
+	0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32,
+	0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x84, 0x80, 0x03, 0x12, 0x29, 0x0a,
+	0x23, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43,
+	0x5f, 0x53, 0x48, 0x41, 0x10, 0x85, 0x80, 0x03, 0x12, 0x23, 0x0a, 0x1d, 0x54, 0x4c, 0x53, 0x5f,
+	0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x86, 0x80, 0x03, 0x12, 0x26, 0x0a,
+	0x20, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53, 0x48,
+	0x41, 0x10, 0x87, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
+	0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44,
+	0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x88,
+	0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f,
+	0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31,
+	0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x89, 0x80, 0x03, 0x12, 0x2a,
+	0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53,
+	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43,
+	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8a, 0x80, 0x03, 0x12, 0x20, 0x0a, 0x1a, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8b, 0x80, 0x03, 0x12, 0x23, 0x0a, 0x1d,
+	0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8c, 0x80,
+	0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8d, 0x80, 0x03, 0x12, 0x27, 0x0a, 0x21, 0x54,
+	0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
+	0x10, 0x8e, 0x80, 0x03, 0x12, 0x27, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48,
+	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35,
+	0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8f, 0x80, 0x03, 0x12, 0x21, 0x0a,
+	0x1b, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x90, 0x80, 0x03,
+	0x12, 0x24, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53,
 	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53,
-	0x48, 0x41, 0x10, 0x8c, 0x80, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43,
-	0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53,
-	0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8d, 0x80, 0x03,
-	0x12, 0x27, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8e, 0x80, 0x03, 0x12, 0x27, 0x0a, 0x21, 0x54, 0x4c, 0x53,
-	0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
-	0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x8f,
-	0x80, 0x03, 0x12, 0x21, 0x0a, 0x1b, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f,
-	0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48,
-	0x41, 0x10, 0x90, 0x80, 0x03, 0x12, 0x24, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
-	0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f,
-	0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x91, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54,
+	0x48, 0x41, 0x10, 0x91, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43,
+	0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45,
+	0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x92, 0x80,
+	0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52,
+	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x93, 0x80, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54,
 	0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
+	0x41, 0x10, 0x94, 0x80, 0x03, 0x12, 0x21, 0x0a, 0x1b, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
+	0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c,
+	0x5f, 0x53, 0x48, 0x41, 0x10, 0x95, 0x80, 0x03, 0x12, 0x24, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f,
+	0x45, 0x43, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52,
+	0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x96, 0x80, 0x03, 0x12, 0x29,
+	0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42,
+	0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x97, 0x80, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53,
+	0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10,
+	0x98, 0x80, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f,
+	0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35,
+	0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x99, 0x80, 0x03, 0x12, 0x27, 0x0a,
+	0x21, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x52, 0x50, 0x5f, 0x53, 0x48, 0x41, 0x5f, 0x57, 0x49, 0x54,
 	0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x10, 0x92, 0x80, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43,
-	0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x93, 0x80, 0x03,
-	0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53,
-	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43,
-	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x94, 0x80, 0x03, 0x12, 0x21, 0x0a, 0x1b, 0x54, 0x4c,
-	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x95, 0x80, 0x03, 0x12, 0x24, 0x0a,
-	0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10,
-	0x96, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f,
-	0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45,
-	0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x97, 0x80, 0x03, 0x12, 0x28,
-	0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f,
+	0x48, 0x41, 0x10, 0x9a, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x52,
+	0x50, 0x5f, 0x53, 0x48, 0x41, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33,
+	0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10,
+	0x9b, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x52, 0x50, 0x5f, 0x53,
+	0x48, 0x41, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53,
+	0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x9c, 0x80, 0x03,
+	0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x52, 0x50, 0x5f, 0x53, 0x48, 0x41, 0x5f,
 	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x10, 0x98, 0x80, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f,
-	0x45, 0x43, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
-	0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x99,
-	0x80, 0x03, 0x12, 0x27, 0x0a, 0x21, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x52, 0x50, 0x5f, 0x53, 0x48,
-	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f,
-	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x9a, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54,
-	0x4c, 0x53, 0x5f, 0x53, 0x52, 0x50, 0x5f, 0x53, 0x48, 0x41, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x10, 0x9b, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f,
-	0x53, 0x52, 0x50, 0x5f, 0x53, 0x48, 0x41, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
-	0x41, 0x10, 0x9c, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x52, 0x50,
-	0x5f, 0x53, 0x48, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32,
-	0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x9d, 0x80, 0x03, 0x12, 0x2a, 0x0a,
-	0x24, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x52, 0x50, 0x5f, 0x53, 0x48, 0x41, 0x5f, 0x52, 0x53, 0x41,
+	0x5f, 0x53, 0x48, 0x41, 0x10, 0x9d, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f,
+	0x53, 0x52, 0x50, 0x5f, 0x53, 0x48, 0x41, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
+	0x10, 0x9e, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x52, 0x50, 0x5f,
+	0x53, 0x48, 0x41, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53,
+	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x9f, 0x80, 0x03,
+	0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x52, 0x50, 0x5f, 0x53, 0x48, 0x41, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43,
+	0x5f, 0x53, 0x48, 0x41, 0x10, 0xa0, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f,
+	0x53, 0x52, 0x50, 0x5f, 0x53, 0x48, 0x41, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
+	0x10, 0xa1, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x52, 0x50, 0x5f,
+	0x53, 0x48, 0x41, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53,
+	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0xa2, 0x80, 0x03,
+	0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43,
+	0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38,
+	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa3, 0x80, 0x03, 0x12,
+	0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44,
+	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xa4, 0x80, 0x03, 0x12, 0x2c,
+	0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41,
 	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0x9e, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53,
-	0x5f, 0x53, 0x52, 0x50, 0x5f, 0x53, 0x48, 0x41, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
-	0x41, 0x10, 0x9f, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x52, 0x50,
-	0x5f, 0x53, 0x48, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35,
-	0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0xa0, 0x80, 0x03, 0x12, 0x2a, 0x0a,
-	0x24, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x52, 0x50, 0x5f, 0x53, 0x48, 0x41, 0x5f, 0x52, 0x53, 0x41,
+	0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa5, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26,
+	0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xa6, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
+	0x32, 0x35, 0x36, 0x10, 0xa7, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45,
+	0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
+	0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34,
+	0x10, 0xa8, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48,
+	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32,
+	0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa9, 0x80, 0x03,
+	0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41,
 	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0xa1, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53,
-	0x5f, 0x53, 0x52, 0x50, 0x5f, 0x53, 0x48, 0x41, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
-	0x41, 0x10, 0xa2, 0x80, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
-	0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
-	0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
-	0x10, 0xa3, 0x80, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48,
-	0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0xa4, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f,
-	0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31,
-	0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa5, 0x80,
-	0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43,
-	0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xa6, 0x80, 0x03, 0x12,
-	0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa7, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25,
-	0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xa8, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53,
-	0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
-	0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
-	0x36, 0x10, 0xa9, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
-	0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32,
-	0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xaa, 0x80,
-	0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45,
-	0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32,
-	0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xab, 0x80, 0x03,
-	0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43,
-	0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36,
-	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xac, 0x80, 0x03, 0x12,
-	0x2c, 0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53,
-	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47,
-	0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xad, 0x80, 0x03, 0x12, 0x2c, 0x0a,
-	0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xae, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54,
-	0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
+	0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xaa, 0x80, 0x03, 0x12, 0x2d, 0x0a, 0x27,
+	0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d,
+	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xab, 0x80, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54,
+	0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
+	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xac, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
 	0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48,
-	0x41, 0x32, 0x35, 0x36, 0x10, 0xaf, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f,
-	0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
-	0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38,
-	0x34, 0x10, 0xb0, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
-	0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31,
-	0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb1, 0x80,
-	0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53,
-	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47,
-	0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xb2, 0x80, 0x03, 0x12, 0x24, 0x0a,
-	0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x52, 0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10,
-	0xb3, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45,
-	0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45,
-	0x44, 0x45, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0xb4, 0x80, 0x03, 0x12, 0x28,
-	0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x10, 0xb5, 0x80, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f,
-	0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
-	0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0xb6,
-	0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f,
-	0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb7, 0x80, 0x03, 0x12,
-	0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xb8, 0x80, 0x03, 0x12, 0x21, 0x0a, 0x1b,
-	0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10, 0xb9, 0x80, 0x03, 0x12,
-	0x24, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
-	0x36, 0x10, 0xba, 0x80, 0x03, 0x12, 0x24, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
-	0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xbb, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54,
-	0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0xbc, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xbd, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
-	0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32,
-	0x35, 0x36, 0x10, 0xbe, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
-	0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32,
-	0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xbf, 0x80,
-	0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xc0, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23,
-	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x33, 0x38, 0x34, 0x10, 0xc1, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44,
-	0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0xc2, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44,
-	0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xc3, 0x80, 0x03, 0x12,
-	0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xc4, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x33, 0x38, 0x34, 0x10, 0xc5, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44,
-	0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0xc6, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e,
-	0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xc7, 0x80, 0x03, 0x12,
-	0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xc8, 0x80, 0x03, 0x12,
-	0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xc9, 0x80, 0x03, 0x12,
-	0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53,
+	0x41, 0x32, 0x35, 0x36, 0x10, 0xad, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f,
+	0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0xae, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43,
+	0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53,
+	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
+	0xaf, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45,
+	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35,
+	0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xb0, 0x80, 0x03,
+	0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43,
+	0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb1, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24,
+	0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48,
+	0x41, 0x33, 0x38, 0x34, 0x10, 0xb2, 0x80, 0x03, 0x12, 0x24, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f,
+	0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52,
+	0x43, 0x34, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x10, 0xb3, 0x80, 0x03, 0x12, 0x29,
+	0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x33, 0x44, 0x45, 0x53, 0x5f, 0x45, 0x44, 0x45, 0x5f, 0x43, 0x42,
+	0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0xb4, 0x80, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53,
+	0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10,
+	0xb5, 0x80, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45,
+	0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35,
+	0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x10, 0xb6, 0x80, 0x03, 0x12, 0x2b, 0x0a,
+	0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb7, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
+	0x33, 0x38, 0x34, 0x10, 0xb8, 0x80, 0x03, 0x12, 0x21, 0x0a, 0x1b, 0x54, 0x4c, 0x53, 0x5f, 0x45,
+	0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55,
+	0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x10, 0xb9, 0x80, 0x03, 0x12, 0x24, 0x0a, 0x1e, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xba, 0x80, 0x03,
+	0x12, 0x24, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53,
+	0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0xbb, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
 	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f,
-	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xca, 0x80, 0x03, 0x12, 0x2d,
-	0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43,
-	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xcb, 0x80, 0x03, 0x12, 0x2c, 0x0a,
-	0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xcc, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54,
-	0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xcd, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53,
-	0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xbc, 0x80, 0x03, 0x12, 0x26,
+	0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0xbd, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31,
+	0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xbe, 0x80,
+	0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42,
+	0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xbf, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23,
+	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
+	0x32, 0x35, 0x36, 0x10, 0xc0, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44,
+	0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f,
+	0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xc1,
+	0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53,
+	0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xc2, 0x80, 0x03, 0x12, 0x2a,
+	0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xc3, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c,
+	0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
 	0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32,
-	0x35, 0x36, 0x10, 0xce, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43,
-	0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0xcf, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d,
-	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xd0, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54,
-	0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0xd1, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xd2, 0x80, 0x03, 0x12,
-	0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xd3, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x35, 0x36, 0x10, 0xc4, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f,
+	0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xc5,
+	0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f,
+	0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xc6, 0x80, 0x03, 0x12, 0x2a,
+	0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xc7, 0x80, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xc8, 0x80, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xc9, 0x80, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
+	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xca, 0x80, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53,
+	0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48,
+	0x41, 0x33, 0x38, 0x34, 0x10, 0xcb, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f,
+	0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32,
+	0x35, 0x36, 0x10, 0xcc, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43,
+	0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49,
+	0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34,
+	0x10, 0xcd, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48,
+	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31,
+	0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xce, 0x80,
+	0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xcf, 0x80, 0x03, 0x12, 0x26,
+	0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
 	0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32,
-	0x35, 0x36, 0x10, 0xd4, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
-	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32,
-	0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xd5, 0x80,
-	0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47,
-	0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xd6, 0x80, 0x03, 0x12, 0x2a, 0x0a,
-	0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53,
-	0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xd7, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53,
-	0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49,
-	0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
-	0x10, 0xd8, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44,
-	0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36,
-	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xd9, 0x80, 0x03, 0x12,
-	0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d,
-	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xda, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41,
-	0x33, 0x38, 0x34, 0x10, 0xdb, 0x80, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x45,
-	0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0xdc, 0x80, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x45,
-	0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41,
-	0x33, 0x38, 0x34, 0x10, 0xdd, 0x80, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45,
-	0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x35, 0x36, 0x10, 0xd0, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xd1, 0x80, 0x03, 0x12, 0x2a,
+	0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
+	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xd2, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c,
+	0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0xd3, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31,
+	0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xd4, 0x80,
+	0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43,
+	0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xd5, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24,
+	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48,
+	0x41, 0x32, 0x35, 0x36, 0x10, 0xd6, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f,
+	0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49,
+	0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34,
+	0x10, 0xd7, 0x80, 0x03, 0x12, 0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44,
+	0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38,
+	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xd8, 0x80, 0x03, 0x12,
+	0x29, 0x0a, 0x23, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
+	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xd9, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c,
+	0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
 	0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32,
-	0x35, 0x36, 0x10, 0xde, 0x80, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43,
-	0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52,
-	0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38,
-	0x34, 0x10, 0xdf, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
-	0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0xe0, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45,
-	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32,
-	0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xe1, 0x80,
-	0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53,
+	0x35, 0x36, 0x10, 0xda, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f,
+	0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xdb,
+	0x80, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f,
+	0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f,
+	0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xdc,
+	0x80, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f,
+	0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f,
+	0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xdd,
+	0x80, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45,
+	0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31,
+	0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xde, 0x80,
+	0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43,
+	0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35,
+	0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xdf, 0x80, 0x03,
+	0x12, 0x2c, 0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53,
 	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f,
-	0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xe2, 0x80, 0x03, 0x12, 0x2b,
-	0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xe3, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54,
-	0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0xe4, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xe5, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0xe6, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44,
-	0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0xe7, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50,
-	0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xe8, 0x80, 0x03, 0x12,
-	0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xe9, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54,
-	0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0xea, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xeb, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0xec, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44,
-	0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0xed, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50,
-	0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xee, 0x80, 0x03, 0x12,
-	0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xef, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54,
-	0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xf0, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54, 0x4c, 0x53,
-	0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x33, 0x38, 0x34, 0x10, 0xf1, 0x80, 0x03, 0x12, 0x32, 0x0a, 0x2c, 0x54, 0x4c, 0x53, 0x5f, 0x45,
-	0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xf2, 0x80, 0x03, 0x12, 0x32, 0x0a, 0x2c, 0x54,
-	0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36,
-	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xf3, 0x80, 0x03, 0x12,
-	0x31, 0x0a, 0x2b, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53,
-	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f,
-	0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xf4,
-	0x80, 0x03, 0x12, 0x31, 0x0a, 0x2b, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45,
-	0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c,
-	0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38,
-	0x34, 0x10, 0xf5, 0x80, 0x03, 0x12, 0x30, 0x0a, 0x2a, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
-	0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45,
-	0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0xf6, 0x80, 0x03, 0x12, 0x30, 0x0a, 0x2a, 0x54, 0x4c, 0x53, 0x5f, 0x45,
-	0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41,
-	0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xf7, 0x80, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53,
-	0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43,
-	0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f,
-	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xf8, 0x80, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c,
-	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xf9, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54,
-	0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45,
-	0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0xfa, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0xfb, 0x80, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0xfc, 0x80, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0xfd, 0x80, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53,
-	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f,
-	0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xfe,
-	0x80, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32,
-	0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xff, 0x80,
-	0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31,
-	0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x80, 0x81,
-	0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32,
-	0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x81, 0x81,
-	0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32,
-	0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x82, 0x81, 0x03,
-	0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36,
-	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x83, 0x81, 0x03, 0x12,
-	0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x84, 0x81, 0x03, 0x12,
-	0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36,
-	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x85, 0x81, 0x03, 0x12,
-	0x32, 0x0a, 0x2c, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0x86, 0x81, 0x03, 0x12, 0x32, 0x0a, 0x2c, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45,
-	0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45,
-	0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41,
-	0x33, 0x38, 0x34, 0x10, 0x87, 0x81, 0x03, 0x12, 0x31, 0x0a, 0x2b, 0x54, 0x4c, 0x53, 0x5f, 0x45,
-	0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43,
-	0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
-	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x88, 0x81, 0x03, 0x12, 0x31, 0x0a, 0x2b, 0x54, 0x4c,
+	0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xe0, 0x80, 0x03, 0x12, 0x2c,
+	0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43,
+	0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xe1, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25,
+	0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53,
+	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xe2, 0x80, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53,
+	0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0xe3, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53,
+	0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xe4, 0x80, 0x03, 0x12, 0x26,
+	0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0xe5, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f,
+	0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xe6,
+	0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53,
+	0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xe7, 0x80, 0x03, 0x12, 0x2a,
+	0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xe8, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c,
+	0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0xe9, 0x80, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53,
+	0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f,
+	0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xea, 0x80, 0x03, 0x12, 0x26,
+	0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0xeb, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f,
+	0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xec,
+	0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53,
+	0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xed, 0x80, 0x03, 0x12, 0x2a,
+	0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
+	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xee, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c,
+	0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x52, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0xef, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43,
+	0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49,
+	0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
+	0x10, 0xf0, 0x80, 0x03, 0x12, 0x2c, 0x0a, 0x26, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48,
+	0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x52, 0x49, 0x41, 0x5f,
+	0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xf1,
+	0x80, 0x03, 0x12, 0x32, 0x0a, 0x2c, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f,
+	0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c,
+	0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32,
+	0x35, 0x36, 0x10, 0xf2, 0x80, 0x03, 0x12, 0x32, 0x0a, 0x2c, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43,
+	0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43,
+	0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xf3, 0x80, 0x03, 0x12, 0x31, 0x0a, 0x2b, 0x54, 0x4c,
 	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47,
-	0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x89, 0x81, 0x03, 0x12, 0x30, 0x0a,
-	0x2a, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x8a, 0x81, 0x03, 0x12,
-	0x30, 0x0a, 0x2a, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32,
-	0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x8b, 0x81,
-	0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53,
+	0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43,
+	0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xf4, 0x80, 0x03, 0x12, 0x31, 0x0a,
+	0x2b, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35,
+	0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xf5, 0x80, 0x03,
+	0x12, 0x30, 0x0a, 0x2a, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53,
 	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f,
-	0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x8c,
-	0x81, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0x8d, 0x81, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38,
-	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x8e, 0x81, 0x03, 0x12,
-	0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x8f, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d,
-	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x90, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x91, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54,
-	0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d,
-	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x92, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54,
-	0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d,
-	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x93, 0x81, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54,
-	0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45,
-	0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0x94, 0x81, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x50,
-	0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0x95, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50,
-	0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0x96, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50,
-	0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0x97, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50,
-	0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0x98, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50,
-	0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0x99, 0x81, 0x03, 0x12, 0x30, 0x0a, 0x2a, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45,
-	0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c,
+	0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xf6,
+	0x80, 0x03, 0x12, 0x30, 0x0a, 0x2a, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f,
+	0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49,
+	0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34,
+	0x10, 0xf7, 0x80, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48,
+	0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c,
 	0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
-	0x36, 0x10, 0x9a, 0x81, 0x03, 0x12, 0x30, 0x0a, 0x2a, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
-	0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45,
-	0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41,
-	0x33, 0x38, 0x34, 0x10, 0x9b, 0x81, 0x03, 0x12, 0x1e, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f,
-	0x43, 0x43, 0x4d, 0x10, 0x9c, 0x81, 0x03, 0x12, 0x1e, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f,
-	0x43, 0x43, 0x4d, 0x10, 0x9d, 0x81, 0x03, 0x12, 0x22, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44,
-	0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f,
-	0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0x9e, 0x81, 0x03, 0x12, 0x22, 0x0a, 0x1c, 0x54,
-	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0x9f, 0x81, 0x03, 0x12,
-	0x20, 0x0a, 0x1a, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xa0, 0x81,
-	0x03, 0x12, 0x20, 0x0a, 0x1a, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10,
-	0xa1, 0x81, 0x03, 0x12, 0x24, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f,
-	0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xa2, 0x81, 0x03, 0x12, 0x24, 0x0a, 0x1e, 0x54, 0x4c, 0x53,
-	0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
-	0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xa3, 0x81, 0x03, 0x12,
-	0x1e, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0xa4, 0x81, 0x03, 0x12,
-	0x1e, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0xa5, 0x81, 0x03, 0x12,
-	0x22, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x10,
-	0xa6, 0x81, 0x03, 0x12, 0x22, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50,
-	0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f,
-	0x43, 0x43, 0x4d, 0x10, 0xa7, 0x81, 0x03, 0x12, 0x20, 0x0a, 0x1a, 0x54, 0x4c, 0x53, 0x5f, 0x50,
-	0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f,
-	0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xa8, 0x81, 0x03, 0x12, 0x20, 0x0a, 0x1a, 0x54, 0x4c, 0x53,
-	0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35,
-	0x36, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xa9, 0x81, 0x03, 0x12, 0x24, 0x0a, 0x1e, 0x54,
-	0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xaa, 0x81,
-	0x03, 0x12, 0x24, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x44, 0x48, 0x45,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43,
-	0x4d, 0x5f, 0x38, 0x10, 0xab, 0x81, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x45,
-	0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0xac, 0x81, 0x03, 0x12,
-	0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44,
-	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f,
-	0x43, 0x43, 0x4d, 0x10, 0xad, 0x81, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45,
-	0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xae, 0x81,
-	0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45,
-	0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35,
-	0x36, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xaf, 0x81, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54,
-	0x4c, 0x53, 0x5f, 0x45, 0x43, 0x43, 0x50, 0x57, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
-	0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
-	0x36, 0x10, 0xb0, 0x81, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x43,
-	0x50, 0x57, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36,
-	0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xb1, 0x81, 0x03, 0x12,
-	0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x43, 0x50, 0x57, 0x44, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x53,
-	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb2, 0x81, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53,
-	0x5f, 0x45, 0x43, 0x43, 0x50, 0x57, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53,
-	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0xb3, 0x81, 0x03, 0x12, 0x17, 0x0a, 0x11, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
-	0x36, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb4, 0x81, 0x03, 0x12, 0x17, 0x0a, 0x11,
-	0x54, 0x4c, 0x53, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38,
-	0x34, 0x10, 0xb5, 0x81, 0x03, 0x12, 0x32, 0x0a, 0x2c, 0x54, 0x4c, 0x53, 0x5f, 0x47, 0x4f, 0x53,
-	0x54, 0x52, 0x33, 0x34, 0x31, 0x31, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x4b, 0x55, 0x5a, 0x4e, 0x59, 0x45, 0x43, 0x48, 0x49, 0x4b, 0x5f, 0x43, 0x54, 0x52,
-	0x5f, 0x4f, 0x4d, 0x41, 0x43, 0x10, 0x80, 0x82, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53,
-	0x5f, 0x47, 0x4f, 0x53, 0x54, 0x52, 0x33, 0x34, 0x31, 0x31, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4d, 0x41, 0x47, 0x4d, 0x41, 0x5f, 0x43, 0x54, 0x52, 0x5f,
-	0x4f, 0x4d, 0x41, 0x43, 0x10, 0x81, 0x82, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f,
-	0x47, 0x4f, 0x53, 0x54, 0x52, 0x33, 0x34, 0x31, 0x31, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x32, 0x38, 0x31, 0x34, 0x37, 0x5f, 0x43, 0x4e, 0x54, 0x5f, 0x49,
-	0x4d, 0x49, 0x54, 0x10, 0x82, 0x82, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53, 0x5f, 0x47,
-	0x4f, 0x53, 0x54, 0x52, 0x33, 0x34, 0x31, 0x31, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x4b, 0x55, 0x5a, 0x4e, 0x59, 0x45, 0x43, 0x48, 0x49, 0x4b, 0x5f, 0x4d,
-	0x47, 0x4d, 0x5f, 0x4c, 0x10, 0x83, 0x82, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f,
-	0x47, 0x4f, 0x53, 0x54, 0x52, 0x33, 0x34, 0x31, 0x31, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x4d, 0x41, 0x47, 0x4d, 0x41, 0x5f, 0x4d, 0x47, 0x4d, 0x5f, 0x4c,
-	0x10, 0x84, 0x82, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53, 0x5f, 0x47, 0x4f, 0x53, 0x54,
+	0x36, 0x10, 0xf8, 0x80, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
+	0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c,
+	0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0xf9, 0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f,
+	0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xfa,
+	0x80, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xfb, 0x80, 0x03, 0x12, 0x2e,
+	0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f,
+	0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xfc, 0x80, 0x03, 0x12, 0x2e,
+	0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xfd, 0x80, 0x03, 0x12, 0x2d,
+	0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47,
+	0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xfe, 0x80, 0x03, 0x12, 0x2d, 0x0a,
+	0x27, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43,
+	0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xff, 0x80, 0x03, 0x12, 0x2e, 0x0a, 0x28,
+	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43,
+	0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x80, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28,
+	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43,
+	0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x81, 0x81, 0x03, 0x12, 0x2d, 0x0a, 0x27,
+	0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d,
+	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x82, 0x81, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54,
+	0x4c, 0x53, 0x5f, 0x44, 0x48, 0x5f, 0x44, 0x53, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43,
+	0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
+	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x83, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c,
+	0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43,
+	0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
+	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x84, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c,
+	0x53, 0x5f, 0x44, 0x48, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43,
+	0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
+	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x85, 0x81, 0x03, 0x12, 0x32, 0x0a, 0x2c, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f,
+	0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x86, 0x81, 0x03, 0x12, 0x32,
+	0x0a, 0x2c, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53,
+	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f,
+	0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x87,
+	0x81, 0x03, 0x12, 0x31, 0x0a, 0x2b, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x45,
+	0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c,
+	0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
+	0x36, 0x10, 0x88, 0x81, 0x03, 0x12, 0x31, 0x0a, 0x2b, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
+	0x48, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d,
+	0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48,
+	0x41, 0x33, 0x38, 0x34, 0x10, 0x89, 0x81, 0x03, 0x12, 0x30, 0x0a, 0x2a, 0x54, 0x4c, 0x53, 0x5f,
+	0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43,
+	0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
+	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x8a, 0x81, 0x03, 0x12, 0x30, 0x0a, 0x2a, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43,
+	0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x8b, 0x81, 0x03, 0x12, 0x2f, 0x0a, 0x29,
+	0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47,
+	0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x8c, 0x81, 0x03, 0x12, 0x2f, 0x0a,
+	0x29, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x8d, 0x81, 0x03, 0x12, 0x2a,
+	0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43,
+	0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
+	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x8e, 0x81, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c,
+	0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c,
+	0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0x8f, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c,
+	0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32,
+	0x35, 0x36, 0x10, 0x90, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c,
+	0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0x91, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c,
+	0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32,
+	0x35, 0x36, 0x10, 0x92, 0x81, 0x03, 0x12, 0x2e, 0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c,
+	0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33,
+	0x38, 0x34, 0x10, 0x93, 0x81, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53,
+	0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f,
+	0x31, 0x32, 0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x94,
+	0x81, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x95, 0x81, 0x03, 0x12, 0x2e,
+	0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x96, 0x81, 0x03, 0x12, 0x2e,
+	0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x97, 0x81, 0x03, 0x12, 0x2e,
+	0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32, 0x38, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x98, 0x81, 0x03, 0x12, 0x2e,
+	0x0a, 0x28, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x99, 0x81, 0x03, 0x12, 0x30,
+	0x0a, 0x2a, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f, 0x31, 0x32,
+	0x38, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x9a, 0x81, 0x03,
+	0x12, 0x30, 0x0a, 0x2a, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53,
+	0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x4c, 0x49, 0x41, 0x5f,
+	0x32, 0x35, 0x36, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x9b,
+	0x81, 0x03, 0x12, 0x1e, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0x9c,
+	0x81, 0x03, 0x12, 0x1e, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0x9d,
+	0x81, 0x03, 0x12, 0x22, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43,
+	0x43, 0x4d, 0x10, 0x9e, 0x81, 0x03, 0x12, 0x22, 0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48,
+	0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32,
+	0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0x9f, 0x81, 0x03, 0x12, 0x20, 0x0a, 0x1a, 0x54, 0x4c,
+	0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31,
+	0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xa0, 0x81, 0x03, 0x12, 0x20, 0x0a, 0x1a,
+	0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53,
+	0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xa1, 0x81, 0x03, 0x12, 0x24,
+	0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38,
+	0x10, 0xa2, 0x81, 0x03, 0x12, 0x24, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f,
+	0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36,
+	0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xa3, 0x81, 0x03, 0x12, 0x1e, 0x0a, 0x18, 0x54, 0x4c,
+	0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31,
+	0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0xa4, 0x81, 0x03, 0x12, 0x1e, 0x0a, 0x18, 0x54, 0x4c,
+	0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32,
+	0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0xa5, 0x81, 0x03, 0x12, 0x22, 0x0a, 0x1c, 0x54, 0x4c,
+	0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41,
+	0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0xa6, 0x81, 0x03, 0x12, 0x22,
+	0x0a, 0x1c, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0xa7,
+	0x81, 0x03, 0x12, 0x20, 0x0a, 0x1a, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38,
+	0x10, 0xa8, 0x81, 0x03, 0x12, 0x20, 0x0a, 0x1a, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d,
+	0x5f, 0x38, 0x10, 0xa9, 0x81, 0x03, 0x12, 0x24, 0x0a, 0x1e, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53,
+	0x4b, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31,
+	0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xaa, 0x81, 0x03, 0x12, 0x24, 0x0a, 0x1e,
+	0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xab,
+	0x81, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f,
+	0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31,
+	0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0xac, 0x81, 0x03, 0x12, 0x26, 0x0a, 0x20, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d, 0x10, 0xad,
+	0x81, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f,
+	0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31,
+	0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x10, 0xae, 0x81, 0x03, 0x12, 0x28, 0x0a, 0x22,
+	0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x43, 0x4d,
+	0x5f, 0x38, 0x10, 0xaf, 0x81, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43,
+	0x43, 0x50, 0x57, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32,
+	0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xb0, 0x81, 0x03,
+	0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x43, 0x50, 0x57, 0x44, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f,
+	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xb1, 0x81, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c,
+	0x53, 0x5f, 0x45, 0x43, 0x43, 0x50, 0x57, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
+	0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
+	0x10, 0xb2, 0x81, 0x03, 0x12, 0x28, 0x0a, 0x22, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x43, 0x50,
+	0x57, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f,
+	0x43, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xb3, 0x81, 0x03, 0x12, 0x17,
+	0x0a, 0x11, 0x54, 0x4c, 0x53, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x5f, 0x53, 0x48, 0x41,
+	0x32, 0x35, 0x36, 0x10, 0xb4, 0x81, 0x03, 0x12, 0x17, 0x0a, 0x11, 0x54, 0x4c, 0x53, 0x5f, 0x53,
+	0x48, 0x41, 0x33, 0x38, 0x34, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0xb5, 0x81, 0x03,
+	0x12, 0x32, 0x0a, 0x2c, 0x54, 0x4c, 0x53, 0x5f, 0x47, 0x4f, 0x53, 0x54, 0x52, 0x33, 0x34, 0x31,
+	0x31, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4b, 0x55, 0x5a,
+	0x4e, 0x59, 0x45, 0x43, 0x48, 0x49, 0x4b, 0x5f, 0x43, 0x54, 0x52, 0x5f, 0x4f, 0x4d, 0x41, 0x43,
+	0x10, 0x80, 0x82, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x47, 0x4f, 0x53, 0x54,
 	0x52, 0x33, 0x34, 0x31, 0x31, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x4b, 0x55, 0x5a, 0x4e, 0x59, 0x45, 0x43, 0x48, 0x49, 0x4b, 0x5f, 0x4d, 0x47, 0x4d, 0x5f,
-	0x53, 0x10, 0x85, 0x82, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x47, 0x4f, 0x53,
-	0x54, 0x52, 0x33, 0x34, 0x31, 0x31, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x4d, 0x41, 0x47, 0x4d, 0x41, 0x5f, 0x4d, 0x47, 0x4d, 0x5f, 0x53, 0x10, 0x86, 0x82,
-	0x03, 0x12, 0x31, 0x0a, 0x2b, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52,
+	0x5f, 0x4d, 0x41, 0x47, 0x4d, 0x41, 0x5f, 0x43, 0x54, 0x52, 0x5f, 0x4f, 0x4d, 0x41, 0x43, 0x10,
+	0x81, 0x82, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x47, 0x4f, 0x53, 0x54, 0x52,
+	0x33, 0x34, 0x31, 0x31, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x32, 0x38, 0x31, 0x34, 0x37, 0x5f, 0x43, 0x4e, 0x54, 0x5f, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x82,
+	0x82, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53, 0x5f, 0x47, 0x4f, 0x53, 0x54, 0x52, 0x33,
+	0x34, 0x31, 0x31, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4b,
+	0x55, 0x5a, 0x4e, 0x59, 0x45, 0x43, 0x48, 0x49, 0x4b, 0x5f, 0x4d, 0x47, 0x4d, 0x5f, 0x4c, 0x10,
+	0x83, 0x82, 0x03, 0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x47, 0x4f, 0x53, 0x54, 0x52,
+	0x33, 0x34, 0x31, 0x31, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x4d, 0x41, 0x47, 0x4d, 0x41, 0x5f, 0x4d, 0x47, 0x4d, 0x5f, 0x4c, 0x10, 0x84, 0x82, 0x03, 0x12,
+	0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53, 0x5f, 0x47, 0x4f, 0x53, 0x54, 0x52, 0x33, 0x34, 0x31, 0x31,
+	0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4b, 0x55, 0x5a, 0x4e,
+	0x59, 0x45, 0x43, 0x48, 0x49, 0x4b, 0x5f, 0x4d, 0x47, 0x4d, 0x5f, 0x53, 0x10, 0x85, 0x82, 0x03,
+	0x12, 0x2a, 0x0a, 0x24, 0x54, 0x4c, 0x53, 0x5f, 0x47, 0x4f, 0x53, 0x54, 0x52, 0x33, 0x34, 0x31,
+	0x31, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4d, 0x41, 0x47,
+	0x4d, 0x41, 0x5f, 0x4d, 0x47, 0x4d, 0x5f, 0x53, 0x10, 0x86, 0x82, 0x03, 0x12, 0x31, 0x0a, 0x2b,
+	0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x43, 0x48, 0x41, 0x32, 0x30, 0x5f, 0x50, 0x4f, 0x4c, 0x59,
+	0x31, 0x33, 0x30, 0x35, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa8, 0x99, 0x03, 0x12,
+	0x33, 0x0a, 0x2d, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x45, 0x43, 0x44,
 	0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x43, 0x48, 0x41, 0x32, 0x30,
 	0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
-	0x10, 0xa8, 0x99, 0x03, 0x12, 0x33, 0x0a, 0x2d, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48,
-	0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x41,
-	0x43, 0x48, 0x41, 0x32, 0x30, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35, 0x5f, 0x53,
-	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xa9, 0x99, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53,
-	0x5f, 0x44, 0x48, 0x45, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48,
-	0x41, 0x43, 0x48, 0x41, 0x32, 0x30, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35, 0x5f,
-	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xaa, 0x99, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c,
-	0x53, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x43, 0x48,
+	0x10, 0xa9, 0x99, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f,
+	0x52, 0x53, 0x41, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x43, 0x48, 0x41, 0x32,
+	0x30, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
+	0x36, 0x10, 0xaa, 0x99, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x53, 0x4b,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x43, 0x48, 0x41, 0x32, 0x30, 0x5f, 0x50,
+	0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xab,
+	0x99, 0x03, 0x12, 0x31, 0x0a, 0x2b, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f,
+	0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x43, 0x48, 0x41, 0x32,
+	0x30, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
+	0x36, 0x10, 0xac, 0x99, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53, 0x5f, 0x44, 0x48, 0x45,
+	0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x43, 0x48, 0x41,
+	0x32, 0x30, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35, 0x5f, 0x53, 0x48, 0x41, 0x32,
+	0x35, 0x36, 0x10, 0xad, 0x99, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c, 0x53, 0x5f, 0x52, 0x53,
+	0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x43, 0x48,
 	0x41, 0x32, 0x30, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0xab, 0x99, 0x03, 0x12, 0x31, 0x0a, 0x2b, 0x54, 0x4c, 0x53, 0x5f, 0x45,
-	0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48,
-	0x41, 0x43, 0x48, 0x41, 0x32, 0x30, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35, 0x5f,
-	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xac, 0x99, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54, 0x4c,
-	0x53, 0x5f, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43,
-	0x48, 0x41, 0x43, 0x48, 0x41, 0x32, 0x30, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35,
-	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xad, 0x99, 0x03, 0x12, 0x2f, 0x0a, 0x29, 0x54,
-	0x4c, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x43, 0x48, 0x41, 0x43, 0x48, 0x41, 0x32, 0x30, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30,
-	0x35, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0xae, 0x99, 0x03, 0x12, 0x2b, 0x0a, 0x25,
-	0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53,
-	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x81, 0xa0, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53,
-	0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33,
-	0x38, 0x34, 0x10, 0x82, 0xa0, 0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43,
-	0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53,
-	0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
-	0x36, 0x10, 0x83, 0xa0, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44,
-	0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f,
-	0x31, 0x32, 0x38, 0x5f, 0x43, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x85,
-	0xa0, 0x03, 0x22, 0x93, 0x0b, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10,
-	0x00, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x41, 0x58, 0x5f, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4c,
-	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45,
-	0x5f, 0x55, 0x52, 0x4c, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x52, 0x55, 0x53, 0x54, 0x45,
-	0x44, 0x5f, 0x43, 0x41, 0x5f, 0x4b, 0x45, 0x59, 0x53, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x54,
-	0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x48, 0x4d, 0x41, 0x43, 0x10, 0x04, 0x12,
-	0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
-	0x54, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x50, 0x50,
-	0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f,
-	0x41, 0x55, 0x54, 0x48, 0x5a, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x52, 0x56, 0x45,
-	0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5a, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x45, 0x52,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x55, 0x50, 0x50,
-	0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x53, 0x10, 0x0a, 0x12, 0x14,
-	0x0a, 0x10, 0x45, 0x43, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
-	0x54, 0x53, 0x10, 0x0b, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x52, 0x50, 0x10, 0x0c, 0x12, 0x18, 0x0a,
-	0x14, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52,
-	0x49, 0x54, 0x48, 0x4d, 0x53, 0x10, 0x0d, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x53, 0x45, 0x5f, 0x53,
-	0x52, 0x54, 0x50, 0x10, 0x0e, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45,
-	0x41, 0x54, 0x10, 0x0f, 0x12, 0x2a, 0x0a, 0x26, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
-	0x4f, 0x4c, 0x5f, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x10,
-	0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
-	0x53, 0x54, 0x5f, 0x56, 0x32, 0x10, 0x11, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x49, 0x47, 0x4e, 0x45,
-	0x44, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49,
-	0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x12, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x49,
-	0x45, 0x4e, 0x54, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x10, 0x13, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52,
-	0x5f, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x10, 0x14, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x44, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x15,
-	0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x5f, 0x54, 0x48, 0x45, 0x4e,
-	0x5f, 0x4d, 0x41, 0x43, 0x10, 0x16, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44,
-	0x45, 0x44, 0x5f, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54,
-	0x10, 0x17, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x42, 0x49, 0x4e, 0x44,
-	0x49, 0x4e, 0x47, 0x10, 0x18, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x43, 0x48, 0x45, 0x44, 0x5f,
-	0x49, 0x4e, 0x46, 0x4f, 0x10, 0x19, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x4c, 0x53, 0x5f, 0x4c, 0x54,
-	0x53, 0x10, 0x1a, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x5f,
-	0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x1b, 0x12, 0x15, 0x0a,
-	0x11, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x4c, 0x49, 0x4d,
-	0x49, 0x54, 0x10, 0x1c, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x57, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x54,
-	0x45, 0x43, 0x54, 0x10, 0x1d, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x57, 0x44, 0x5f, 0x43, 0x4c, 0x45,
-	0x41, 0x52, 0x10, 0x1e, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44,
-	0x5f, 0x53, 0x41, 0x4c, 0x54, 0x10, 0x1f, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x49, 0x43, 0x4b, 0x45,
-	0x54, 0x5f, 0x50, 0x49, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x20, 0x12, 0x1c, 0x0a, 0x18, 0x54,
-	0x4c, 0x53, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x58, 0x54,
-	0x45, 0x52, 0x4e, 0x5f, 0x50, 0x53, 0x4b, 0x10, 0x21, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x4c,
-	0x45, 0x47, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41,
-	0x4c, 0x10, 0x22, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
-	0x49, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x23, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x4c, 0x4d, 0x53, 0x50,
-	0x10, 0x24, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4c, 0x4d, 0x53, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x58,
-	0x59, 0x49, 0x4e, 0x47, 0x10, 0x25, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4c, 0x4d, 0x53, 0x50, 0x5f,
-	0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x45, 0x10, 0x26, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x55,
-	0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x4b, 0x54, 0x5f, 0x43, 0x49, 0x50, 0x48,
-	0x45, 0x52, 0x53, 0x10, 0x27, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x45, 0x5f, 0x53, 0x48, 0x41,
-	0x52, 0x45, 0x44, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x29, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x41, 0x52,
-	0x4c, 0x59, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x2a, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x55, 0x50,
-	0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x53, 0x10,
-	0x2b, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4f, 0x4b, 0x49, 0x45, 0x10, 0x2c, 0x12, 0x1a, 0x0a,
-	0x16, 0x50, 0x53, 0x4b, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47,
-	0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x53, 0x10, 0x2d, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x45, 0x52,
-	0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49,
-	0x54, 0x49, 0x45, 0x53, 0x10, 0x2f, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x49, 0x44, 0x5f, 0x46, 0x49,
-	0x4c, 0x54, 0x45, 0x52, 0x53, 0x10, 0x30, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x53, 0x54, 0x5f,
-	0x48, 0x41, 0x4e, 0x44, 0x53, 0x48, 0x41, 0x4b, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x31,
-	0x12, 0x1d, 0x0a, 0x19, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x41, 0x4c,
-	0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x53, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x10, 0x32, 0x12,
-	0x0d, 0x0a, 0x09, 0x4b, 0x45, 0x59, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x10, 0x33, 0x12, 0x15,
-	0x0a, 0x11, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x50, 0x41, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x49,
-	0x4e, 0x46, 0x4f, 0x10, 0x34, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x52, 0x45, 0x43, 0x41, 0x54, 0x45,
-	0x44, 0x10, 0x35, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x49, 0x44, 0x10, 0x36, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e,
-	0x41, 0x4c, 0x5f, 0x49, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x37, 0x12, 0x17, 0x0a, 0x13,
-	0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
-	0x5f, 0x49, 0x44, 0x10, 0x38, 0x12, 0x1d, 0x0a, 0x19, 0x51, 0x55, 0x49, 0x43, 0x5f, 0x54, 0x52,
-	0x41, 0x4e, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45,
-	0x52, 0x53, 0x10, 0x39, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x52,
-	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x3a, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x4e, 0x53, 0x53,
-	0x45, 0x43, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x3b, 0x12, 0x29, 0x0a, 0x25, 0x53, 0x45,
-	0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x45, 0x4e,
-	0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54,
-	0x48, 0x4d, 0x53, 0x10, 0x3c, 0x12, 0x25, 0x0a, 0x1f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49,
-	0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f,
-	0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x53, 0x10, 0xe9, 0x88, 0x01, 0x12, 0x26, 0x0a, 0x20,
-	0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50,
-	0x54, 0x45, 0x44, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x45, 0x4c, 0x4c, 0x4f,
-	0x10, 0x8d, 0xfc, 0x03, 0x12, 0x22, 0x0a, 0x1c, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f,
-	0x4e, 0x5f, 0x52, 0x45, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x49, 0x4e, 0x46, 0x4f, 0x10, 0x81, 0xfe, 0x03, 0x22, 0x6f, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x65,
-	0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x45, 0x5f,
-	0x4e, 0x45, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x4e, 0x45, 0x47,
-	0x4f, 0x54, 0x49, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x4e, 0x43, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x43,
-	0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x4e, 0x45, 0x47,
-	0x4f, 0x54, 0x49, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x4c, 0x59, 0x5f, 0x41, 0x53,
-	0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x22, 0x98, 0x03, 0x0a, 0x0f, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x10, 0x0a,
-	0x0c, 0x4e, 0x4f, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x00, 0x12,
-	0x15, 0x0a, 0x10, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x4b, 0x43, 0x53, 0x31, 0x5f, 0x53, 0x48, 0x41,
-	0x32, 0x35, 0x36, 0x10, 0x81, 0x08, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x4b,
-	0x43, 0x53, 0x31, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x81, 0x0a, 0x12, 0x15, 0x0a,
-	0x10, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x4b, 0x43, 0x53, 0x31, 0x5f, 0x53, 0x48, 0x41, 0x35, 0x31,
-	0x32, 0x10, 0x81, 0x0c, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x53, 0x45,
-	0x43, 0x50, 0x32, 0x35, 0x36, 0x52, 0x31, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x83,
-	0x08, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x53, 0x45, 0x43, 0x50, 0x33,
-	0x38, 0x34, 0x52, 0x31, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x83, 0x0a, 0x12, 0x1b,
-	0x0a, 0x16, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x53, 0x45, 0x43, 0x50, 0x35, 0x32, 0x31, 0x52,
-	0x31, 0x5f, 0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10, 0x83, 0x0c, 0x12, 0x18, 0x0a, 0x13, 0x52,
-	0x53, 0x41, 0x5f, 0x50, 0x53, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x32,
-	0x35, 0x36, 0x10, 0x84, 0x10, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x53,
-	0x5f, 0x52, 0x53, 0x41, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x85, 0x10, 0x12,
-	0x18, 0x0a, 0x13, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x45, 0x5f,
-	0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10, 0x86, 0x10, 0x12, 0x0c, 0x0a, 0x07, 0x45, 0x44, 0x32,
-	0x35, 0x35, 0x31, 0x39, 0x10, 0x87, 0x10, 0x12, 0x0a, 0x0a, 0x05, 0x45, 0x44, 0x34, 0x34, 0x38,
-	0x10, 0x88, 0x10, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x53, 0x5f, 0x50,
-	0x53, 0x53, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x89, 0x10, 0x12, 0x17, 0x0a, 0x12,
-	0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x53, 0x5f, 0x50, 0x53, 0x53, 0x5f, 0x53, 0x48, 0x41, 0x33,
-	0x38, 0x34, 0x10, 0x8a, 0x10, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x53,
-	0x5f, 0x50, 0x53, 0x53, 0x5f, 0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10, 0x8b, 0x10, 0x12, 0x13,
-	0x0a, 0x0e, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x4b, 0x43, 0x53, 0x31, 0x5f, 0x53, 0x48, 0x41, 0x31,
-	0x10, 0x81, 0x04, 0x12, 0x0f, 0x0a, 0x0a, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x53, 0x48, 0x41,
-	0x31, 0x10, 0x83, 0x04, 0x22, 0xac, 0x07, 0x0a, 0x0d, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69,
-	0x63, 0x43, 0x75, 0x72, 0x76, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x31, 0x36, 0x33, 0x4b,
-	0x31, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x31, 0x36, 0x33, 0x52, 0x31,
-	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x31, 0x36, 0x33, 0x52, 0x32, 0x10,
-	0x03, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x31, 0x39, 0x33, 0x52, 0x31, 0x10, 0x04,
-	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x31, 0x39, 0x33, 0x52, 0x32, 0x10, 0x05, 0x12,
-	0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x32, 0x33, 0x33, 0x4b, 0x31, 0x10, 0x06, 0x12, 0x0d,
-	0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x32, 0x33, 0x33, 0x52, 0x31, 0x10, 0x07, 0x12, 0x0d, 0x0a,
-	0x09, 0x53, 0x45, 0x43, 0x54, 0x32, 0x33, 0x39, 0x4b, 0x31, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09,
-	0x53, 0x45, 0x43, 0x54, 0x32, 0x38, 0x33, 0x4b, 0x31, 0x10, 0x09, 0x12, 0x0d, 0x0a, 0x09, 0x53,
-	0x45, 0x43, 0x54, 0x32, 0x38, 0x33, 0x52, 0x31, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45,
-	0x43, 0x54, 0x34, 0x30, 0x39, 0x4b, 0x31, 0x10, 0x0b, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43,
-	0x54, 0x34, 0x30, 0x39, 0x52, 0x31, 0x10, 0x0c, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54,
-	0x35, 0x37, 0x31, 0x4b, 0x31, 0x10, 0x0d, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x35,
-	0x37, 0x31, 0x52, 0x31, 0x10, 0x0e, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x31, 0x36,
-	0x30, 0x4b, 0x31, 0x10, 0x0f, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x31, 0x36, 0x30,
-	0x52, 0x31, 0x10, 0x10, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x31, 0x36, 0x30, 0x52,
-	0x32, 0x10, 0x11, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x31, 0x39, 0x32, 0x4b, 0x31,
-	0x10, 0x12, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x31, 0x39, 0x32, 0x52, 0x31, 0x10,
-	0x13, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x32, 0x32, 0x34, 0x4b, 0x31, 0x10, 0x14,
-	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x32, 0x32, 0x34, 0x52, 0x31, 0x10, 0x15, 0x12,
-	0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x32, 0x35, 0x36, 0x4b, 0x31, 0x10, 0x16, 0x12, 0x0d,
-	0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x32, 0x35, 0x36, 0x52, 0x31, 0x10, 0x17, 0x12, 0x0d, 0x0a,
-	0x09, 0x53, 0x45, 0x43, 0x50, 0x33, 0x38, 0x34, 0x52, 0x31, 0x10, 0x18, 0x12, 0x0d, 0x0a, 0x09,
-	0x53, 0x45, 0x43, 0x50, 0x35, 0x32, 0x31, 0x52, 0x31, 0x10, 0x19, 0x12, 0x13, 0x0a, 0x0f, 0x42,
-	0x52, 0x41, 0x49, 0x4e, 0x50, 0x4f, 0x4f, 0x4c, 0x50, 0x32, 0x35, 0x36, 0x52, 0x31, 0x10, 0x1a,
-	0x12, 0x13, 0x0a, 0x0f, 0x42, 0x52, 0x41, 0x49, 0x4e, 0x50, 0x4f, 0x4f, 0x4c, 0x50, 0x33, 0x38,
-	0x34, 0x52, 0x31, 0x10, 0x1b, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x52, 0x41, 0x49, 0x4e, 0x50, 0x4f,
-	0x4f, 0x4c, 0x50, 0x35, 0x31, 0x32, 0x52, 0x31, 0x10, 0x1c, 0x12, 0x0a, 0x0a, 0x06, 0x58, 0x32,
-	0x35, 0x35, 0x31, 0x39, 0x10, 0x1d, 0x12, 0x08, 0x0a, 0x04, 0x58, 0x34, 0x34, 0x38, 0x10, 0x1e,
-	0x12, 0x18, 0x0a, 0x14, 0x42, 0x52, 0x41, 0x49, 0x4e, 0x50, 0x4f, 0x4f, 0x4c, 0x50, 0x32, 0x35,
-	0x36, 0x52, 0x31, 0x54, 0x4c, 0x53, 0x31, 0x33, 0x10, 0x1f, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x52,
-	0x41, 0x49, 0x4e, 0x50, 0x4f, 0x4f, 0x4c, 0x50, 0x33, 0x38, 0x34, 0x52, 0x31, 0x54, 0x4c, 0x53,
-	0x31, 0x33, 0x10, 0x20, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x52, 0x41, 0x49, 0x4e, 0x50, 0x4f, 0x4f,
-	0x4c, 0x50, 0x35, 0x31, 0x32, 0x52, 0x31, 0x54, 0x4c, 0x53, 0x31, 0x33, 0x10, 0x21, 0x12, 0x0a,
-	0x0a, 0x06, 0x47, 0x43, 0x32, 0x35, 0x36, 0x41, 0x10, 0x22, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x43,
-	0x32, 0x35, 0x36, 0x42, 0x10, 0x23, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x43, 0x32, 0x35, 0x36, 0x43,
-	0x10, 0x24, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x43, 0x32, 0x35, 0x36, 0x44, 0x10, 0x25, 0x12, 0x0a,
-	0x0a, 0x06, 0x47, 0x43, 0x35, 0x31, 0x32, 0x41, 0x10, 0x26, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x43,
-	0x35, 0x31, 0x32, 0x42, 0x10, 0x27, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x43, 0x35, 0x31, 0x32, 0x43,
-	0x10, 0x28, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x55, 0x52, 0x56, 0x45, 0x53, 0x4d, 0x32, 0x10, 0x29,
-	0x12, 0x0e, 0x0a, 0x09, 0x46, 0x46, 0x44, 0x48, 0x45, 0x32, 0x30, 0x34, 0x38, 0x10, 0x80, 0x02,
-	0x12, 0x0e, 0x0a, 0x09, 0x46, 0x46, 0x44, 0x48, 0x45, 0x33, 0x30, 0x37, 0x32, 0x10, 0x81, 0x02,
-	0x12, 0x0e, 0x0a, 0x09, 0x46, 0x46, 0x44, 0x48, 0x45, 0x34, 0x30, 0x39, 0x36, 0x10, 0x82, 0x02,
-	0x12, 0x0e, 0x0a, 0x09, 0x46, 0x46, 0x44, 0x48, 0x45, 0x36, 0x31, 0x34, 0x34, 0x10, 0x83, 0x02,
-	0x12, 0x0e, 0x0a, 0x09, 0x46, 0x46, 0x44, 0x48, 0x45, 0x38, 0x31, 0x39, 0x32, 0x10, 0x84, 0x02,
-	0x12, 0x1b, 0x0a, 0x15, 0x58, 0x32, 0x35, 0x35, 0x31, 0x39, 0x4b, 0x59, 0x42, 0x45, 0x52, 0x35,
-	0x31, 0x32, 0x44, 0x52, 0x41, 0x46, 0x54, 0x30, 0x30, 0x10, 0xb0, 0xfc, 0x03, 0x12, 0x1b, 0x0a,
-	0x15, 0x58, 0x32, 0x35, 0x35, 0x31, 0x39, 0x4b, 0x59, 0x42, 0x45, 0x52, 0x37, 0x36, 0x38, 0x44,
-	0x52, 0x41, 0x46, 0x54, 0x30, 0x30, 0x10, 0x99, 0xc7, 0x01, 0x12, 0x1e, 0x0a, 0x18, 0x58, 0x32,
-	0x35, 0x35, 0x31, 0x39, 0x4b, 0x59, 0x42, 0x45, 0x52, 0x37, 0x36, 0x38, 0x44, 0x52, 0x41, 0x46,
-	0x54, 0x30, 0x30, 0x4f, 0x4c, 0x44, 0x10, 0xb1, 0xfc, 0x03, 0x12, 0x19, 0x0a, 0x13, 0x50, 0x32,
-	0x35, 0x36, 0x4b, 0x79, 0x62, 0x65, 0x72, 0x37, 0x36, 0x38, 0x44, 0x72, 0x61, 0x66, 0x74, 0x30,
-	0x30, 0x10, 0xb2, 0xfc, 0x03, 0x12, 0x25, 0x0a, 0x1f, 0x41, 0x52, 0x42, 0x49, 0x54, 0x52, 0x41,
-	0x52, 0x59, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x49, 0x43, 0x49, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x4d,
-	0x45, 0x5f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x53, 0x10, 0x81, 0xfe, 0x03, 0x12, 0x25, 0x0a, 0x1f,
-	0x41, 0x52, 0x42, 0x49, 0x54, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x49, 0x43,
-	0x49, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x32, 0x5f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x53, 0x10,
-	0x82, 0xfe, 0x03, 0x22, 0x6c, 0x0a, 0x18, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69, 0x63, 0x43,
-	0x75, 0x72, 0x76, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
-	0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x4e, 0x53, 0x49, 0x5f, 0x58, 0x39, 0x36, 0x32, 0x5f, 0x43,
-	0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x45, 0x10,
-	0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x4e, 0x53, 0x49, 0x5f, 0x58, 0x39, 0x36, 0x32, 0x5f, 0x43,
-	0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x45, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x32, 0x10,
-	0x02, 0x1a, 0xdc, 0x06, 0x0a, 0x0f, 0x48, 0x54, 0x54, 0x50, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x73, 0x65, 0x75, 0x64,
-	0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x11, 0x70, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77,
-	0x73, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
-	0x69, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x72, 0x61, 0x6d,
-	0x65, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46,
-	0x72, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x15, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x0e, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
-	0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x46, 0x69,
-	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x0e, 0x70, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x69, 0x0a, 0x13, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x32, 0x35, 0x36, 0x10, 0xae, 0x99, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45,
+	0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45,
+	0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
+	0x10, 0x81, 0xa0, 0x03, 0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48,
+	0x45, 0x5f, 0x50, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32,
+	0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x82, 0xa0,
+	0x03, 0x12, 0x2d, 0x0a, 0x27, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50,
+	0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f,
+	0x43, 0x43, 0x4d, 0x5f, 0x38, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x83, 0xa0, 0x03,
+	0x12, 0x2b, 0x0a, 0x25, 0x54, 0x4c, 0x53, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x45, 0x5f, 0x50, 0x53,
+	0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x43,
+	0x43, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x85, 0xa0, 0x03, 0x22, 0x93, 0x0b,
+	0x0a, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x53,
+	0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x4d, 0x41, 0x58, 0x5f, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x45, 0x4e,
+	0x47, 0x54, 0x48, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f,
+	0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x52, 0x4c, 0x10,
+	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x52, 0x55, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x43, 0x41, 0x5f,
+	0x4b, 0x45, 0x59, 0x53, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41,
+	0x54, 0x45, 0x44, 0x5f, 0x48, 0x4d, 0x41, 0x43, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x05, 0x12, 0x10,
+	0x0a, 0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x06,
+	0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5a,
+	0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54,
+	0x48, 0x5a, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x45, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44,
+	0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x53, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x43, 0x5f,
+	0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x53, 0x10, 0x0b, 0x12,
+	0x07, 0x0a, 0x03, 0x53, 0x52, 0x50, 0x10, 0x0c, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x49, 0x47, 0x4e,
+	0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x53,
+	0x10, 0x0d, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x52, 0x54, 0x50, 0x10, 0x0e,
+	0x12, 0x0d, 0x0a, 0x09, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x10, 0x0f, 0x12,
+	0x2a, 0x0a, 0x26, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c,
+	0x41, 0x59, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4e, 0x45,
+	0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x10, 0x12, 0x15, 0x0a, 0x11, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x56, 0x32,
+	0x10, 0x11, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x5f, 0x43, 0x45, 0x52,
+	0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41,
+	0x4d, 0x50, 0x10, 0x12, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10,
+	0x13, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x45, 0x52, 0x54,
+	0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x14, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x41, 0x44, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x15, 0x12, 0x14, 0x0a, 0x10, 0x45,
+	0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x5f, 0x54, 0x48, 0x45, 0x4e, 0x5f, 0x4d, 0x41, 0x43, 0x10,
+	0x16, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x4d, 0x41,
+	0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x17, 0x12, 0x11, 0x0a,
+	0x0d, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x18,
+	0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x43, 0x48, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10,
+	0x19, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x4c, 0x53, 0x5f, 0x4c, 0x54, 0x53, 0x10, 0x1a, 0x12, 0x18,
+	0x0a, 0x14, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x49,
+	0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x1b, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x43, 0x4f,
+	0x52, 0x44, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x1c, 0x12,
+	0x0f, 0x0a, 0x0b, 0x50, 0x57, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x10, 0x1d,
+	0x12, 0x0d, 0x0a, 0x09, 0x50, 0x57, 0x44, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x10, 0x1e, 0x12,
+	0x11, 0x0a, 0x0d, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x41, 0x4c, 0x54,
+	0x10, 0x1f, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x50, 0x49, 0x4e,
+	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x20, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4c, 0x53, 0x5f, 0x43, 0x45,
+	0x52, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x5f, 0x50,
+	0x53, 0x4b, 0x10, 0x21, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x45,
+	0x44, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x22, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54,
+	0x10, 0x23, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x4c, 0x4d, 0x53, 0x50, 0x10, 0x24, 0x12, 0x12, 0x0a,
+	0x0e, 0x54, 0x4c, 0x4d, 0x53, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x49, 0x4e, 0x47, 0x10,
+	0x25, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4c, 0x4d, 0x53, 0x50, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47,
+	0x41, 0x54, 0x45, 0x10, 0x26, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54,
+	0x45, 0x44, 0x5f, 0x45, 0x4b, 0x54, 0x5f, 0x43, 0x49, 0x50, 0x48, 0x45, 0x52, 0x53, 0x10, 0x27,
+	0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x4b,
+	0x45, 0x59, 0x10, 0x29, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x10, 0x2a, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45,
+	0x44, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x2b, 0x12, 0x0a, 0x0a, 0x06,
+	0x43, 0x4f, 0x4f, 0x4b, 0x49, 0x45, 0x10, 0x2c, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x53, 0x4b, 0x5f,
+	0x4b, 0x45, 0x59, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x53, 0x10, 0x2d, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43,
+	0x41, 0x54, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x54, 0x49, 0x45, 0x53, 0x10,
+	0x2f, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x49, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x53,
+	0x10, 0x30, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x53,
+	0x48, 0x41, 0x4b, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x31, 0x12, 0x1d, 0x0a, 0x19, 0x53,
+	0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54,
+	0x48, 0x4d, 0x53, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x10, 0x32, 0x12, 0x0d, 0x0a, 0x09, 0x4b, 0x45,
+	0x59, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x10, 0x33, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x50, 0x41, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x34,
+	0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49,
+	0x44, 0x5f, 0x44, 0x45, 0x50, 0x52, 0x45, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x35, 0x12, 0x11,
+	0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x44, 0x10,
+	0x36, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x49, 0x44,
+	0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x37, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x44, 0x10, 0x38,
+	0x12, 0x1d, 0x0a, 0x19, 0x51, 0x55, 0x49, 0x43, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x53, 0x10, 0x39, 0x12,
+	0x12, 0x0a, 0x0e, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x10, 0x3a, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x4e, 0x53, 0x53, 0x45, 0x43, 0x5f, 0x43, 0x48,
+	0x41, 0x49, 0x4e, 0x10, 0x3b, 0x12, 0x29, 0x0a, 0x25, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43,
+	0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x53, 0x10, 0x3c,
+	0x12, 0x25, 0x0a, 0x1f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x50,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49,
+	0x4e, 0x47, 0x53, 0x10, 0xe9, 0x88, 0x01, 0x12, 0x26, 0x0a, 0x20, 0x45, 0x58, 0x54, 0x45, 0x4e,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x45, 0x44, 0x5f, 0x43,
+	0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x45, 0x4c, 0x4c, 0x4f, 0x10, 0x8d, 0xfc, 0x03, 0x12,
+	0x22, 0x0a, 0x1c, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4e,
+	0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10,
+	0x81, 0xfe, 0x03, 0x22, 0x6f, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x15, 0x0a, 0x11, 0x52,
+	0x45, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x45, 0x56, 0x45, 0x52,
+	0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54,
+	0x45, 0x5f, 0x4f, 0x4e, 0x43, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54,
+	0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54,
+	0x45, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x4c, 0x59, 0x5f, 0x41, 0x53, 0x5f, 0x43, 0x4c, 0x49, 0x45,
+	0x4e, 0x54, 0x10, 0x02, 0x22, 0x98, 0x03, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x5f, 0x53,
+	0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x53,
+	0x41, 0x5f, 0x50, 0x4b, 0x43, 0x53, 0x31, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x81,
+	0x08, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x4b, 0x43, 0x53, 0x31, 0x5f, 0x53,
+	0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x81, 0x0a, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x53, 0x41, 0x5f,
+	0x50, 0x4b, 0x43, 0x53, 0x31, 0x5f, 0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10, 0x81, 0x0c, 0x12,
+	0x1b, 0x0a, 0x16, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x53, 0x45, 0x43, 0x50, 0x32, 0x35, 0x36,
+	0x52, 0x31, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x83, 0x08, 0x12, 0x1b, 0x0a, 0x16,
+	0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x53, 0x45, 0x43, 0x50, 0x33, 0x38, 0x34, 0x52, 0x31, 0x5f,
+	0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x83, 0x0a, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x43, 0x44,
+	0x53, 0x41, 0x5f, 0x53, 0x45, 0x43, 0x50, 0x35, 0x32, 0x31, 0x52, 0x31, 0x5f, 0x53, 0x48, 0x41,
+	0x35, 0x31, 0x32, 0x10, 0x83, 0x0c, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53,
+	0x53, 0x5f, 0x52, 0x53, 0x41, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x84, 0x10,
+	0x12, 0x18, 0x0a, 0x13, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x45,
+	0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x85, 0x10, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x53,
+	0x41, 0x5f, 0x50, 0x53, 0x53, 0x5f, 0x52, 0x53, 0x41, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x35, 0x31,
+	0x32, 0x10, 0x86, 0x10, 0x12, 0x0c, 0x0a, 0x07, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31, 0x39, 0x10,
+	0x87, 0x10, 0x12, 0x0a, 0x0a, 0x05, 0x45, 0x44, 0x34, 0x34, 0x38, 0x10, 0x88, 0x10, 0x12, 0x17,
+	0x0a, 0x12, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x53, 0x5f, 0x50, 0x53, 0x53, 0x5f, 0x53, 0x48,
+	0x41, 0x32, 0x35, 0x36, 0x10, 0x89, 0x10, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x53, 0x41, 0x5f, 0x50,
+	0x53, 0x53, 0x5f, 0x50, 0x53, 0x53, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x8a, 0x10,
+	0x12, 0x17, 0x0a, 0x12, 0x52, 0x53, 0x41, 0x5f, 0x50, 0x53, 0x53, 0x5f, 0x50, 0x53, 0x53, 0x5f,
+	0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10, 0x8b, 0x10, 0x12, 0x13, 0x0a, 0x0e, 0x52, 0x53, 0x41,
+	0x5f, 0x50, 0x4b, 0x43, 0x53, 0x31, 0x5f, 0x53, 0x48, 0x41, 0x31, 0x10, 0x81, 0x04, 0x12, 0x0f,
+	0x0a, 0x0a, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x53, 0x48, 0x41, 0x31, 0x10, 0x83, 0x04, 0x22,
+	0xac, 0x07, 0x0a, 0x0d, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69, 0x63, 0x43, 0x75, 0x72, 0x76,
+	0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x31, 0x36, 0x33, 0x4b, 0x31, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x31, 0x36, 0x33, 0x52, 0x31, 0x10, 0x02, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x45, 0x43, 0x54, 0x31, 0x36, 0x33, 0x52, 0x32, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09,
+	0x53, 0x45, 0x43, 0x54, 0x31, 0x39, 0x33, 0x52, 0x31, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x53,
+	0x45, 0x43, 0x54, 0x31, 0x39, 0x33, 0x52, 0x32, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45,
+	0x43, 0x54, 0x32, 0x33, 0x33, 0x4b, 0x31, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43,
+	0x54, 0x32, 0x33, 0x33, 0x52, 0x31, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54,
+	0x32, 0x33, 0x39, 0x4b, 0x31, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x32,
+	0x38, 0x33, 0x4b, 0x31, 0x10, 0x09, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x32, 0x38,
+	0x33, 0x52, 0x31, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x34, 0x30, 0x39,
+	0x4b, 0x31, 0x10, 0x0b, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x34, 0x30, 0x39, 0x52,
+	0x31, 0x10, 0x0c, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x35, 0x37, 0x31, 0x4b, 0x31,
+	0x10, 0x0d, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x54, 0x35, 0x37, 0x31, 0x52, 0x31, 0x10,
+	0x0e, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x31, 0x36, 0x30, 0x4b, 0x31, 0x10, 0x0f,
+	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x31, 0x36, 0x30, 0x52, 0x31, 0x10, 0x10, 0x12,
+	0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x31, 0x36, 0x30, 0x52, 0x32, 0x10, 0x11, 0x12, 0x0d,
+	0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x31, 0x39, 0x32, 0x4b, 0x31, 0x10, 0x12, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x45, 0x43, 0x50, 0x31, 0x39, 0x32, 0x52, 0x31, 0x10, 0x13, 0x12, 0x0d, 0x0a, 0x09,
+	0x53, 0x45, 0x43, 0x50, 0x32, 0x32, 0x34, 0x4b, 0x31, 0x10, 0x14, 0x12, 0x0d, 0x0a, 0x09, 0x53,
+	0x45, 0x43, 0x50, 0x32, 0x32, 0x34, 0x52, 0x31, 0x10, 0x15, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45,
+	0x43, 0x50, 0x32, 0x35, 0x36, 0x4b, 0x31, 0x10, 0x16, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43,
+	0x50, 0x32, 0x35, 0x36, 0x52, 0x31, 0x10, 0x17, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50,
+	0x33, 0x38, 0x34, 0x52, 0x31, 0x10, 0x18, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x35,
+	0x32, 0x31, 0x52, 0x31, 0x10, 0x19, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x52, 0x41, 0x49, 0x4e, 0x50,
+	0x4f, 0x4f, 0x4c, 0x50, 0x32, 0x35, 0x36, 0x52, 0x31, 0x10, 0x1a, 0x12, 0x13, 0x0a, 0x0f, 0x42,
+	0x52, 0x41, 0x49, 0x4e, 0x50, 0x4f, 0x4f, 0x4c, 0x50, 0x33, 0x38, 0x34, 0x52, 0x31, 0x10, 0x1b,
+	0x12, 0x13, 0x0a, 0x0f, 0x42, 0x52, 0x41, 0x49, 0x4e, 0x50, 0x4f, 0x4f, 0x4c, 0x50, 0x35, 0x31,
+	0x32, 0x52, 0x31, 0x10, 0x1c, 0x12, 0x0a, 0x0a, 0x06, 0x58, 0x32, 0x35, 0x35, 0x31, 0x39, 0x10,
+	0x1d, 0x12, 0x08, 0x0a, 0x04, 0x58, 0x34, 0x34, 0x38, 0x10, 0x1e, 0x12, 0x18, 0x0a, 0x14, 0x42,
+	0x52, 0x41, 0x49, 0x4e, 0x50, 0x4f, 0x4f, 0x4c, 0x50, 0x32, 0x35, 0x36, 0x52, 0x31, 0x54, 0x4c,
+	0x53, 0x31, 0x33, 0x10, 0x1f, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x52, 0x41, 0x49, 0x4e, 0x50, 0x4f,
+	0x4f, 0x4c, 0x50, 0x33, 0x38, 0x34, 0x52, 0x31, 0x54, 0x4c, 0x53, 0x31, 0x33, 0x10, 0x20, 0x12,
+	0x18, 0x0a, 0x14, 0x42, 0x52, 0x41, 0x49, 0x4e, 0x50, 0x4f, 0x4f, 0x4c, 0x50, 0x35, 0x31, 0x32,
+	0x52, 0x31, 0x54, 0x4c, 0x53, 0x31, 0x33, 0x10, 0x21, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x43, 0x32,
+	0x35, 0x36, 0x41, 0x10, 0x22, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x43, 0x32, 0x35, 0x36, 0x42, 0x10,
+	0x23, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x43, 0x32, 0x35, 0x36, 0x43, 0x10, 0x24, 0x12, 0x0a, 0x0a,
+	0x06, 0x47, 0x43, 0x32, 0x35, 0x36, 0x44, 0x10, 0x25, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x43, 0x35,
+	0x31, 0x32, 0x41, 0x10, 0x26, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x43, 0x35, 0x31, 0x32, 0x42, 0x10,
+	0x27, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x43, 0x35, 0x31, 0x32, 0x43, 0x10, 0x28, 0x12, 0x0c, 0x0a,
+	0x08, 0x43, 0x55, 0x52, 0x56, 0x45, 0x53, 0x4d, 0x32, 0x10, 0x29, 0x12, 0x0e, 0x0a, 0x09, 0x46,
+	0x46, 0x44, 0x48, 0x45, 0x32, 0x30, 0x34, 0x38, 0x10, 0x80, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x46,
+	0x46, 0x44, 0x48, 0x45, 0x33, 0x30, 0x37, 0x32, 0x10, 0x81, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x46,
+	0x46, 0x44, 0x48, 0x45, 0x34, 0x30, 0x39, 0x36, 0x10, 0x82, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x46,
+	0x46, 0x44, 0x48, 0x45, 0x36, 0x31, 0x34, 0x34, 0x10, 0x83, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x46,
+	0x46, 0x44, 0x48, 0x45, 0x38, 0x31, 0x39, 0x32, 0x10, 0x84, 0x02, 0x12, 0x1b, 0x0a, 0x15, 0x58,
+	0x32, 0x35, 0x35, 0x31, 0x39, 0x4b, 0x59, 0x42, 0x45, 0x52, 0x35, 0x31, 0x32, 0x44, 0x52, 0x41,
+	0x46, 0x54, 0x30, 0x30, 0x10, 0xb0, 0xfc, 0x03, 0x12, 0x1b, 0x0a, 0x15, 0x58, 0x32, 0x35, 0x35,
+	0x31, 0x39, 0x4b, 0x59, 0x42, 0x45, 0x52, 0x37, 0x36, 0x38, 0x44, 0x52, 0x41, 0x46, 0x54, 0x30,
+	0x30, 0x10, 0x99, 0xc7, 0x01, 0x12, 0x1e, 0x0a, 0x18, 0x58, 0x32, 0x35, 0x35, 0x31, 0x39, 0x4b,
+	0x59, 0x42, 0x45, 0x52, 0x37, 0x36, 0x38, 0x44, 0x52, 0x41, 0x46, 0x54, 0x30, 0x30, 0x4f, 0x4c,
+	0x44, 0x10, 0xb1, 0xfc, 0x03, 0x12, 0x19, 0x0a, 0x13, 0x50, 0x32, 0x35, 0x36, 0x4b, 0x79, 0x62,
+	0x65, 0x72, 0x37, 0x36, 0x38, 0x44, 0x72, 0x61, 0x66, 0x74, 0x30, 0x30, 0x10, 0xb2, 0xfc, 0x03,
+	0x12, 0x25, 0x0a, 0x1f, 0x41, 0x52, 0x42, 0x49, 0x54, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x45, 0x58,
+	0x50, 0x4c, 0x49, 0x43, 0x49, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x45, 0x5f, 0x43, 0x55, 0x52,
+	0x56, 0x45, 0x53, 0x10, 0x81, 0xfe, 0x03, 0x12, 0x25, 0x0a, 0x1f, 0x41, 0x52, 0x42, 0x49, 0x54,
+	0x52, 0x41, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x49, 0x43, 0x49, 0x54, 0x5f, 0x43, 0x48,
+	0x41, 0x52, 0x32, 0x5f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x53, 0x10, 0x82, 0xfe, 0x03, 0x22, 0x6c,
+	0x0a, 0x18, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x74, 0x69, 0x63, 0x43, 0x75, 0x72, 0x76, 0x65, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e,
+	0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a,
+	0x41, 0x4e, 0x53, 0x49, 0x5f, 0x58, 0x39, 0x36, 0x32, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45,
+	0x53, 0x53, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a,
+	0x41, 0x4e, 0x53, 0x49, 0x5f, 0x58, 0x39, 0x36, 0x32, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45,
+	0x53, 0x53, 0x45, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x32, 0x10, 0x02, 0x1a, 0xb4, 0x0c, 0x0a,
+	0x0f, 0x48, 0x54, 0x54, 0x50, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70,
+	0x73, 0x65, 0x75, 0x64, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x5d, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x48,
+	0x54, 0x54, 0x50, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x73,
+	0x52, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12,
+	0x34, 0x0a, 0x15, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f,
+	0x77, 0x73, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x69, 0x0a, 0x13, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69,
+	0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x46,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x13, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x43, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73,
+	0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x46, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x06,
+	0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
 	0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e,
 	0x48, 0x54, 0x54, 0x50, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e,
-	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74,
-	0x73, 0x52, 0x13, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x50, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x83, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x44, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x44, 0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73,
-	0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x63, 0x6c, 0x75,
-	0x73, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x91, 0x02, 0x0a,
-	0x11, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x4f, 0x70,
-	0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x75, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x14,
-	0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x43,
-	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
-	0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22,
-	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d,
-	0x61, 0x78, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65,
-	0x1a, 0xc4, 0x01, 0x0a, 0x0c, 0x47, 0x4c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x08, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
-	0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52,
-	0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65,
-	0x6e, 0x75, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
-	0x6e, 0x75, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0xab, 0x03, 0x0a, 0x11, 0x42, 0x72, 0x6f, 0x77,
-	0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x4e, 0x0a,
-	0x07, 0x6d, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34,
-	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72,
-	0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x63, 0x0a,
-	0x0e, 0x67, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x1a, 0x83, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x91, 0x02, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x28, 0x0a,
+	0x0f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x75, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x43, 0x6f,
+	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a,
+	0x11, 0x6d, 0x61, 0x78, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x1a, 0x2f, 0x0a, 0x07, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xf6, 0x02, 0x0a,
+	0x05, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74,
+	0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50,
+	0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x49, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x48,
+	0x54, 0x54, 0x50, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x53,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
+	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x46, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x9b, 0x01, 0x0a, 0x09, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x41, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52,
+	0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x53, 0x54, 0x5f,
+	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x45, 0x54, 0x54,
+	0x49, 0x4e, 0x47, 0x53, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x50,
+	0x52, 0x4f, 0x4d, 0x49, 0x53, 0x45, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x49, 0x4e, 0x47,
+	0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x4f, 0x41, 0x57, 0x41, 0x59, 0x10, 0x07, 0x12, 0x11,
+	0x0a, 0x0d, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10,
+	0x08, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x09, 0x1a, 0xb8, 0x02, 0x0a, 0x0c, 0x47, 0x4c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x6e, 0x75, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x6e, 0x75, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x73, 0x68,
+	0x61, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
+	0x2e, 0x47, 0x4c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x53, 0x68,
+	0x61, 0x70, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x22, 0x32, 0x0a, 0x05, 0x53, 0x68,
+	0x61, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x52, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a,
+	0x0b, 0x54, 0x59, 0x50, 0x45, 0x44, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x02, 0x1a, 0xc3,
+	0x04, 0x0a, 0x11, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x4e, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
 	0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f,
-	0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47,
-	0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0e, 0x67, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d,
+	0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x61, 0x70,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x63, 0x0a, 0x0e, 0x67, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77,
+	0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x67, 0x6c, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0a, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f,
+	0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
 	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x65,
@@ -7433,353 +8921,489 @@ var file_proto_device_utils_device_utils_proto_rawDesc = []byte{
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
 	0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x4c,
 	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xa5, 0x03, 0x0a, 0x0d, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65,
-	0x72, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x76, 0x61,
-	0x69, 0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
-	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
-	0x44, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
-	0x6f, 0x72, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x69, 0x78, 0x65, 0x6c,
-	0x44, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x69, 0x78,
-	0x65, 0x6c, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x4c, 0x65, 0x66, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x54, 0x6f,
-	0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x54, 0x6f,
-	0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
-	0x64, 0x12, 0x51, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72,
-	0x6f, 0x77, 0x73, 0x65, 0x72, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x2e, 0x4f, 0x72, 0x69, 0x65,
-	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x37, 0x0a, 0x0b, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x8c, 0x03,
-	0x0a, 0x06, 0x57, 0x65, 0x62, 0x47, 0x50, 0x55, 0x12, 0x41, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0xa5, 0x03, 0x0a, 0x0d, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x57,
+	0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x69, 0x78, 0x65,
+	0x6c, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x4c,
+	0x65, 0x66, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x4c, 0x65, 0x66, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x54, 0x6f, 0x70,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x54, 0x6f, 0x70,
+	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x12, 0x51, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
+	0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x6f,
+	0x77, 0x73, 0x65, 0x72, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x2e, 0x4f, 0x72, 0x69, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x37, 0x0a, 0x0b, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x8c, 0x03, 0x0a,
+	0x06, 0x57, 0x65, 0x62, 0x47, 0x50, 0x55, 0x12, 0x41, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
+	0x2e, 0x57, 0x65, 0x62, 0x47, 0x50, 0x55, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x65, 0x76,
 	0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65,
-	0x72, 0x2e, 0x57, 0x65, 0x62, 0x47, 0x50, 0x55, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x73, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73,
-	0x65, 0x72, 0x2e, 0x57, 0x65, 0x62, 0x47, 0x50, 0x55, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a,
-	0x11, 0x69, 0x73, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x64, 0x61, 0x70, 0x74,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x73, 0x46, 0x61, 0x6c, 0x6c,
-	0x62, 0x61, 0x63, 0x6b, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76,
-	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e,
-	0x64, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x1a, 0x1e, 0x0a, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x93, 0x01, 0x0a,
-	0x0f, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x55, 0x52, 0x49, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x55, 0x52, 0x49, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6c, 0x61, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x1a, 0x8c, 0x03, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x64, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x49, 0x4d, 0x45,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73,
-	0x65, 0x72, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x64, 0x4d, 0x49, 0x4d, 0x45, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x49, 0x4d, 0x45,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x5c, 0x0a, 0x08, 0x4d, 0x49, 0x4d, 0x45, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x65,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x1a, 0x6c, 0x0a, 0x17, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
-	0x4d, 0x49, 0x4d, 0x45, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
-	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x4d, 0x49,
-	0x4d, 0x45, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0xc4, 0x03, 0x0a, 0x11, 0x48, 0x69, 0x67, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x6f, 0x70,
-	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
-	0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62,
-	0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x69,
-	0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12,
-	0x28, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73, 0x46,
-	0x75, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x75, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x45, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
-	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x6f,
-	0x70, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x06,
-	0x62, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x57, 0x0a, 0x0f, 0x66, 0x75, 0x6c, 0x6c, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
-	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x6f,
-	0x70, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x0f,
-	0x66, 0x75, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x1a,
-	0x37, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xff, 0x03, 0x0a, 0x06, 0x57, 0x65, 0x62,
-	0x52, 0x54, 0x43, 0x12, 0x43, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
-	0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x62, 0x52, 0x54, 0x43,
-	0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x43, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69,
-	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x57,
-	0x65, 0x62, 0x52, 0x54, 0x43, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x1a, 0x7d, 0x0a,
-	0x05, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x73, 0x64, 0x70, 0x46, 0x6d, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x64, 0x70, 0x46, 0x6d, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x1a, 0x41, 0x0a, 0x0f,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x1a,
-	0xa8, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74,
-	0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x62, 0x52,
-	0x54, 0x43, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x52, 0x06, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x73,
-	0x12, 0x58, 0x0a, 0x10, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x65, 0x76,
+	0x72, 0x2e, 0x57, 0x65, 0x62, 0x47, 0x50, 0x55, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11,
+	0x69, 0x73, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x73, 0x46, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74,
+	0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x1e, 0x0a, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x93, 0x01, 0x0a, 0x0f,
+	0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x55, 0x52, 0x49, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x55, 0x52, 0x49, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c,
+	0x61, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x1a, 0xd1, 0x03, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x64,
+	0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x49, 0x4d, 0x45, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x64, 0x65, 0x76,
 	0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65,
-	0x72, 0x2e, 0x57, 0x65, 0x62, 0x52, 0x54, 0x43, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x0b, 0x47,
-	0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f,
-	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c,
-	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
-	0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
-	0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
-	0x12, 0x46, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
-	0x73, 0x2e, 0x47, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x15,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x50, 0x53, 0x10, 0x01,
-	0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x02, 0x12, 0x0b, 0x0a,
-	0x07, 0x50, 0x41, 0x53, 0x53, 0x49, 0x56, 0x45, 0x10, 0x03, 0x22, 0x44, 0x0a, 0x06, 0x4c, 0x6f,
-	0x63, 0x61, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x53, 0x4f, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x53, 0x4f,
-	0x22, 0xa1, 0x03, 0x0a, 0x07, 0x53, 0x49, 0x4d, 0x43, 0x61, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10,
-	0x0a, 0x03, 0x4d, 0x43, 0x43, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x43, 0x43,
-	0x12, 0x10, 0x0a, 0x03, 0x4d, 0x4e, 0x43, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d,
-	0x4e, 0x43, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x53, 0x4f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x53, 0x4f, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e,
-	0x0a, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x53, 0x49, 0x4d, 0x43,
-	0x61, 0x72, 0x64, 0x2e, 0x49, 0x4d, 0x45, 0x49, 0x52, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x12, 0x2e,
-	0x0a, 0x04, 0x6d, 0x65, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x53, 0x49, 0x4d, 0x43,
-	0x61, 0x72, 0x64, 0x2e, 0x4d, 0x45, 0x49, 0x44, 0x52, 0x04, 0x6d, 0x65, 0x69, 0x64, 0x1a, 0x2c,
-	0x0a, 0x04, 0x49, 0x4d, 0x45, 0x49, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x41, 0x43, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x54, 0x41, 0x43, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x65, 0x69,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x1a, 0x66, 0x0a, 0x04,
-	0x4d, 0x45, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6d, 0x65, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x03, 0x4d, 0x41, 0x43, 0x12, 0x10, 0x0a, 0x03, 0x4f,
-	0x55, 0x49, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4f, 0x55, 0x49, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1e, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x7a,
-	0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x65,
-	0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6e, 0x73, 0x69, 0x74,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79,
-	0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f,
-	0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14,
-	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f,
-	0x6e, 0x74, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x74,
-	0x69, 0x63, 0x61, 0x6c, 0x22, 0xa6, 0x02, 0x0a, 0x07, 0x43, 0x50, 0x55, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x36, 0x0a, 0x04, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
-	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x43, 0x50,
-	0x55, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72,
-	0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x69, 0x4c, 0x69, 0x73,
-	0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x62, 0x69, 0x4c, 0x69, 0x73, 0x74,
-	0x22, 0x6b, 0x0a, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a,
-	0x03, 0x41, 0x52, 0x4d, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x52, 0x4d, 0x36, 0x34, 0x10,
-	0x02, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x49, 0x50, 0x53, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4d,
-	0x49, 0x50, 0x53, 0x45, 0x4c, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x50, 0x43, 0x10, 0x05,
-	0x12, 0x09, 0x0a, 0x05, 0x50, 0x50, 0x43, 0x36, 0x34, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x58,
-	0x33, 0x32, 0x10, 0x07, 0x12, 0x07, 0x0a, 0x03, 0x58, 0x36, 0x34, 0x10, 0x08, 0x22, 0xd7, 0x0e,
-	0x0a, 0x0d, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x2e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x64, 0x72, 0x6f,
-	0x69, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x3d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x23, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
-	0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b,
-	0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x64,
-	0x72, 0x6f, 0x69, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x46, 0x0a, 0x08, 0x73,
-	0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x64,
-	0x72, 0x6f, 0x69, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x52, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x77,
-	0x61, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69,
-	0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x73,
-	0x63, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
-	0x73, 0x2e, 0x43, 0x50, 0x55, 0x44, 0x61, 0x74, 0x61, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x2c,
-	0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x4c, 0x6f,
-	0x63, 0x61, 0x6c, 0x65, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x47, 0x50,
-	0x53, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
-	0x74, 0x69, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x52, 0x08, 0x74,
-	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x6d, 0x61, 0x63, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x4d, 0x41, 0x43, 0x52, 0x0a,
-	0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x69,
-	0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x53, 0x49, 0x4d, 0x43,
-	0x61, 0x72, 0x64, 0x52, 0x08, 0x73, 0x69, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x1a, 0x14, 0x0a,
-	0x02, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x1a, 0xf3, 0x03, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x74, 0x6c,
-	0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6f, 0x6f,
-	0x74, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12,
-	0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a,
-	0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x64, 0x6d, 0x53, 0x6b,
-	0x75, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x64, 0x6d, 0x53, 0x6b, 0x75, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x28, 0x0a, 0x0f, 0x73,
-	0x6f, 0x63, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x63, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x63, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x63, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x6e, 0x63,
-	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x61, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xe2, 0x02, 0x0a, 0x0e, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x12, 0x6c, 0x0a, 0x10,
-	0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61,
-	0x72, 0x65, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61,
-	0x72, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x5d, 0x0a, 0x0b, 0x61, 0x70,
-	0x70, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3b, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x41,
-	0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x4d,
-	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x70,
-	0x70, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x43, 0x0a, 0x15, 0x53, 0x6f, 0x66,
-	0x74, 0x77, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x4d, 0x49, 0x4d, 0x45, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x49, 0x4d, 0x45, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x4d, 0x49, 0x4d, 0x45, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x5c, 0x0a, 0x08, 0x4d, 0x49, 0x4d,
+	0x45, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x66,
+	0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x66,
+	0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x6c, 0x0a, 0x17, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x4d, 0x49, 0x4d, 0x45, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74,
 	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e,
-	0x0a, 0x10, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x86,
-	0x03, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x6e,
-	0x64, 0x72, 0x6f, 0x69, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x4e, 0x4f, 0x4e,
-	0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x31, 0x5f, 0x30, 0x10, 0x01, 0x12, 0x08, 0x0a,
-	0x04, 0x56, 0x31, 0x5f, 0x31, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x31, 0x5f, 0x35, 0x10,
-	0x03, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x31, 0x5f, 0x36, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x56,
-	0x32, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x32, 0x5f, 0x30, 0x5f, 0x31, 0x10, 0x06, 0x12,
-	0x08, 0x0a, 0x04, 0x56, 0x32, 0x5f, 0x31, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x32, 0x5f,
-	0x32, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x32, 0x5f, 0x33, 0x5f, 0x32, 0x10, 0x09, 0x12,
-	0x0a, 0x0a, 0x06, 0x56, 0x32, 0x5f, 0x33, 0x5f, 0x37, 0x10, 0x0a, 0x12, 0x08, 0x0a, 0x04, 0x56,
-	0x33, 0x5f, 0x30, 0x10, 0x0b, 0x12, 0x08, 0x0a, 0x04, 0x76, 0x33, 0x5f, 0x31, 0x10, 0x0c, 0x12,
-	0x08, 0x0a, 0x04, 0x56, 0x33, 0x5f, 0x32, 0x10, 0x0d, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x34, 0x5f,
-	0x30, 0x5f, 0x32, 0x10, 0x0e, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x34, 0x5f, 0x30, 0x5f, 0x34, 0x10,
-	0x0f, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x34, 0x5f, 0x31, 0x10, 0x10, 0x12, 0x08, 0x0a, 0x04, 0x56,
-	0x34, 0x5f, 0x32, 0x10, 0x11, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x34, 0x5f, 0x33, 0x10, 0x12, 0x12,
-	0x08, 0x0a, 0x04, 0x56, 0x34, 0x5f, 0x34, 0x10, 0x13, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x34, 0x5f,
-	0x34, 0x57, 0x10, 0x14, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x35, 0x5f, 0x30, 0x10, 0x15, 0x12, 0x08,
-	0x0a, 0x04, 0x56, 0x35, 0x5f, 0x31, 0x10, 0x16, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x36, 0x5f, 0x30,
-	0x10, 0x17, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x37, 0x5f, 0x30, 0x10, 0x18, 0x12, 0x08, 0x0a, 0x04,
-	0x56, 0x37, 0x5f, 0x31, 0x10, 0x19, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x38, 0x5f, 0x30, 0x10, 0x1a,
-	0x12, 0x08, 0x0a, 0x04, 0x56, 0x38, 0x5f, 0x31, 0x10, 0x1b, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x39,
-	0x5f, 0x30, 0x10, 0x1c, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x31, 0x30, 0x5f, 0x30, 0x10, 0x1d, 0x12,
-	0x09, 0x0a, 0x05, 0x56, 0x31, 0x31, 0x5f, 0x30, 0x10, 0x1e, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x31,
-	0x32, 0x5f, 0x30, 0x10, 0x1f, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x31, 0x32, 0x5f, 0x30, 0x4c, 0x10,
-	0x20, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x31, 0x33, 0x5f, 0x30, 0x10, 0x21, 0x12, 0x09, 0x0a, 0x05,
-	0x56, 0x31, 0x34, 0x5f, 0x30, 0x10, 0x22, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x52, 0x55, 0x48, 0x49, 0x74, 0x73, 0x41, 0x42, 0x75,
-	0x6e, 0x6e, 0x79, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x75, 0x74, 0x69, 0x6c, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69,
+	0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x4d, 0x49, 0x4d, 0x45, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x42, 0x65,
+	0x65, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x68, 0x61, 0x73, 0x42, 0x65, 0x65, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x1a, 0xdd, 0x02, 0x0a, 0x09, 0x43, 0x68,
+	0x72, 0x6f, 0x6d, 0x65, 0x41, 0x70, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x55, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42,
+	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x41, 0x70, 0x70,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x55, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x68,
+	0x72, 0x6f, 0x6d, 0x65, 0x41, 0x70, 0x70, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x3f, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x52, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x0b, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x1a, 0xa6, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x1a, 0xd2, 0x02, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73,
+	0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x4f, 0x66, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x34, 0x0a, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x72, 0x65, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x15, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xbd, 0x03, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x61,
+	0x73, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77,
+	0x73, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
+	0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x5d, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x50,
+	0x72, 0x6f, 0x62, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69,
+	0x6d, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x69,
+	0x6e, 0x67, 0x1a, 0xc4, 0x03, 0x0a, 0x11, 0x48, 0x69, 0x67, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x6f,
+	0x70, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
+	0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x12, 0x28, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73,
+	0x46, 0x75, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x75, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x45, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
+	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x45, 0x6e, 0x74, 0x72,
+	0x6f, 0x70, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x57, 0x0a, 0x0f, 0x66, 0x75, 0x6c, 0x6c, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
+	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x45, 0x6e, 0x74, 0x72,
+	0x6f, 0x70, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52,
+	0x0f, 0x66, 0x75, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x1a, 0x37, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xff, 0x03, 0x0a, 0x06, 0x57, 0x65,
+	0x62, 0x52, 0x54, 0x43, 0x12, 0x43, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69,
+	0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x62, 0x52, 0x54,
+	0x43, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x43, 0x0a, 0x05, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e,
+	0x57, 0x65, 0x62, 0x52, 0x54, 0x43, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x1a, 0x7d,
+	0x0a, 0x05, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x64, 0x70, 0x46, 0x6d, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x64, 0x70, 0x46, 0x6d, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x1a, 0x41, 0x0a,
+	0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x1a, 0xa8, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
+	0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x62,
+	0x52, 0x54, 0x43, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x52, 0x06, 0x63, 0x6f, 0x64, 0x65, 0x63,
+	0x73, 0x12, 0x58, 0x0a, 0x10, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73,
+	0x65, 0x72, 0x2e, 0x57, 0x65, 0x62, 0x52, 0x54, 0x43, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe6, 0x03, 0x0a, 0x0b,
+	0x47, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x46, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69,
+	0x6c, 0x73, 0x2e, 0x47, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61,
+	0x6c, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x10, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x41, 0x63, 0x63,
+	0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x62, 0x65,
+	0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x32, 0x0a, 0x14, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x52, 0x65,
+	0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x14, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69,
+	0x6d, 0x65, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x22, 0x5b, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x15, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x50, 0x53, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x41, 0x53, 0x53, 0x49, 0x56, 0x45, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x55, 0x53,
+	0x45, 0x44, 0x10, 0x04, 0x22, 0xfa, 0x01, 0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x53, 0x4f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x53, 0x4f, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xa1, 0x03, 0x0a, 0x07, 0x53, 0x49, 0x4d, 0x43, 0x61, 0x72, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x4d, 0x43, 0x43, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x43,
+	0x43, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x4e, 0x43, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x4d, 0x4e, 0x43, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x53, 0x4f, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x53, 0x4f, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x2e, 0x0a, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x53, 0x49, 0x4d,
+	0x43, 0x61, 0x72, 0x64, 0x2e, 0x49, 0x4d, 0x45, 0x49, 0x52, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x12,
+	0x2e, 0x0a, 0x04, 0x6d, 0x65, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x53, 0x49, 0x4d,
+	0x43, 0x61, 0x72, 0x64, 0x2e, 0x4d, 0x45, 0x49, 0x44, 0x52, 0x04, 0x6d, 0x65, 0x69, 0x64, 0x1a,
+	0x2c, 0x0a, 0x04, 0x49, 0x4d, 0x45, 0x49, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x41, 0x43, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x54, 0x41, 0x43, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x65,
+	0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x1a, 0x66, 0x0a,
+	0x04, 0x4d, 0x45, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x65, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x03, 0x4d, 0x41, 0x43, 0x12, 0x10, 0x0a, 0x03,
+	0x4f, 0x55, 0x49, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4f, 0x55, 0x49, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1e, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0a, 0x53, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x79, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x14, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x72, 0x69, 0x7a,
+	0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x22, 0xa6, 0x02, 0x0a, 0x07, 0x43, 0x50, 0x55, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x36, 0x0a, 0x04, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x22, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x43,
+	0x50, 0x55, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63,
+	0x68, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x63,
+	0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x72, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x69, 0x4c, 0x69,
+	0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x62, 0x69, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x6b, 0x0a, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x41, 0x52, 0x4d, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x52, 0x4d, 0x36, 0x34,
+	0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x49, 0x50, 0x53, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
+	0x4d, 0x49, 0x50, 0x53, 0x45, 0x4c, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x50, 0x43, 0x10,
+	0x05, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x50, 0x43, 0x36, 0x34, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03,
+	0x58, 0x33, 0x32, 0x10, 0x07, 0x12, 0x07, 0x0a, 0x03, 0x58, 0x36, 0x34, 0x10, 0x08, 0x22, 0xd7,
+	0x0e, 0x0a, 0x0d, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x2e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x64, 0x72,
+	0x6f, 0x69, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x3d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73,
+	0x2e, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x3b, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x41, 0x6e,
+	0x64, 0x72, 0x6f, 0x69, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x46, 0x0a, 0x08,
+	0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x41, 0x6e,
+	0x64, 0x72, 0x6f, 0x69, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x52, 0x08, 0x73, 0x6f, 0x66, 0x74,
+	0x77, 0x61, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74,
+	0x69, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06,
+	0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69,
+	0x6c, 0x73, 0x2e, 0x43, 0x50, 0x55, 0x44, 0x61, 0x74, 0x61, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12,
+	0x2c, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x47,
+	0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x6d, 0x61, 0x63, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x4d, 0x41, 0x43, 0x52,
+	0x0a, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x73,
+	0x69, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x53, 0x49, 0x4d,
+	0x43, 0x61, 0x72, 0x64, 0x52, 0x08, 0x73, 0x69, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x1a, 0x14,
+	0x0a, 0x02, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x1a, 0xf3, 0x03, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x74,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6f,
+	0x6f, 0x74, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x64, 0x6d, 0x53,
+	0x6b, 0x75, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x64, 0x6d, 0x53, 0x6b, 0x75,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b,
+	0x75, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x28, 0x0a, 0x0f,
+	0x73, 0x6f, 0x63, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x63, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x63, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x63, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x6e,
+	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xe2, 0x02, 0x0a, 0x0e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x12, 0x6c, 0x0a,
+	0x10, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x77,
+	0x61, 0x72, 0x65, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x73, 0x6f, 0x66, 0x74, 0x77,
+	0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x5d, 0x0a, 0x0b, 0x61,
+	0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3b, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
+	0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70,
+	0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61,
+	0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x43, 0x0a, 0x15, 0x53, 0x6f,
+	0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x3e, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x86, 0x03, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x41,
+	0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x31, 0x5f, 0x30, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x56, 0x31, 0x5f, 0x31, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x31, 0x5f, 0x35,
+	0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x31, 0x5f, 0x36, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02,
+	0x56, 0x32, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x32, 0x5f, 0x30, 0x5f, 0x31, 0x10, 0x06,
+	0x12, 0x08, 0x0a, 0x04, 0x56, 0x32, 0x5f, 0x31, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x32,
+	0x5f, 0x32, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x32, 0x5f, 0x33, 0x5f, 0x32, 0x10, 0x09,
+	0x12, 0x0a, 0x0a, 0x06, 0x56, 0x32, 0x5f, 0x33, 0x5f, 0x37, 0x10, 0x0a, 0x12, 0x08, 0x0a, 0x04,
+	0x56, 0x33, 0x5f, 0x30, 0x10, 0x0b, 0x12, 0x08, 0x0a, 0x04, 0x76, 0x33, 0x5f, 0x31, 0x10, 0x0c,
+	0x12, 0x08, 0x0a, 0x04, 0x56, 0x33, 0x5f, 0x32, 0x10, 0x0d, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x34,
+	0x5f, 0x30, 0x5f, 0x32, 0x10, 0x0e, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x34, 0x5f, 0x30, 0x5f, 0x34,
+	0x10, 0x0f, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x34, 0x5f, 0x31, 0x10, 0x10, 0x12, 0x08, 0x0a, 0x04,
+	0x56, 0x34, 0x5f, 0x32, 0x10, 0x11, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x34, 0x5f, 0x33, 0x10, 0x12,
+	0x12, 0x08, 0x0a, 0x04, 0x56, 0x34, 0x5f, 0x34, 0x10, 0x13, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x34,
+	0x5f, 0x34, 0x57, 0x10, 0x14, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x35, 0x5f, 0x30, 0x10, 0x15, 0x12,
+	0x08, 0x0a, 0x04, 0x56, 0x35, 0x5f, 0x31, 0x10, 0x16, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x36, 0x5f,
+	0x30, 0x10, 0x17, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x37, 0x5f, 0x30, 0x10, 0x18, 0x12, 0x08, 0x0a,
+	0x04, 0x56, 0x37, 0x5f, 0x31, 0x10, 0x19, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x38, 0x5f, 0x30, 0x10,
+	0x1a, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x38, 0x5f, 0x31, 0x10, 0x1b, 0x12, 0x08, 0x0a, 0x04, 0x56,
+	0x39, 0x5f, 0x30, 0x10, 0x1c, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x31, 0x30, 0x5f, 0x30, 0x10, 0x1d,
+	0x12, 0x09, 0x0a, 0x05, 0x56, 0x31, 0x31, 0x5f, 0x30, 0x10, 0x1e, 0x12, 0x09, 0x0a, 0x05, 0x56,
+	0x31, 0x32, 0x5f, 0x30, 0x10, 0x1f, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x31, 0x32, 0x5f, 0x30, 0x4c,
+	0x10, 0x20, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x31, 0x33, 0x5f, 0x30, 0x10, 0x21, 0x12, 0x09, 0x0a,
+	0x05, 0x56, 0x31, 0x34, 0x5f, 0x30, 0x10, 0x22, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x52, 0x55, 0x48, 0x49, 0x74, 0x73, 0x41, 0x42,
+	0x75, 0x6e, 0x6e, 0x79, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x75, 0x74, 0x69, 0x6c,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7794,8 +9418,8 @@ func file_proto_device_utils_device_utils_proto_rawDescGZIP() []byte {
 	return file_proto_device_utils_device_utils_proto_rawDescData
 }
 
-var file_proto_device_utils_device_utils_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
-var file_proto_device_utils_device_utils_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_proto_device_utils_device_utils_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_proto_device_utils_device_utils_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
 var file_proto_device_utils_device_utils_proto_goTypes = []interface{}{
 	(Browser_TLSFingerprint_ProtocolVersion)(0),                                          // 0: device_utils.Browser.TLSFingerprint.ProtocolVersion
 	(Browser_TLSFingerprint_CipherSuite)(0),                                              // 1: device_utils.Browser.TLSFingerprint.CipherSuite
@@ -7808,148 +9432,197 @@ var file_proto_device_utils_device_utils_proto_goTypes = []interface{}{
 	(Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode)(0),                   // 8: device_utils.Browser.TLSFingerprint.ExtensionData.PSKKeyExchangeModes.Mode
 	(Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF)(0),         // 9: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HKDF
 	(Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD)(0),     // 10: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HPKEAEAD
-	(GPSLocation_LocationProvider)(0),                                                    // 11: device_utils.GPSLocation.LocationProvider
-	(CPUData_Architecture)(0),                                                            // 12: device_utils.CPUData.Architecture
-	(AndroidDevice_Version)(0),                                                           // 13: device_utils.AndroidDevice.Version
-	(*Browser)(nil),                                                                      // 14: device_utils.Browser
-	(*GPSLocation)(nil),                                                                  // 15: device_utils.GPSLocation
-	(*Locale)(nil),                                                                       // 16: device_utils.Locale
-	(*SIMCard)(nil),                                                                      // 17: device_utils.SIMCard
-	(*MAC)(nil),                                                                          // 18: device_utils.MAC
-	(*Timezone)(nil),                                                                     // 19: device_utils.Timezone
-	(*ScreenData)(nil),                                                                   // 20: device_utils.ScreenData
-	(*CPUData)(nil),                                                                      // 21: device_utils.CPUData
-	(*AndroidDevice)(nil),                                                                // 22: device_utils.AndroidDevice
-	(*Browser_TLSFingerprint)(nil),                                                       // 23: device_utils.Browser.TLSFingerprint
-	(*Browser_HTTPFingerprint)(nil),                                                      // 24: device_utils.Browser.HTTPFingerprint
-	(*Browser_GLCapability)(nil),                                                         // 25: device_utils.Browser.GLCapability
-	(*Browser_BrowserCollection)(nil),                                                    // 26: device_utils.Browser.BrowserCollection
-	(*Browser_BrowserScreen)(nil),                                                        // 27: device_utils.Browser.BrowserScreen
-	(*Browser_WebGPU)(nil),                                                               // 28: device_utils.Browser.WebGPU
-	(*Browser_SpeechSynthesis)(nil),                                                      // 29: device_utils.Browser.SpeechSynthesis
-	(*Browser_Plugin)(nil),                                                               // 30: device_utils.Browser.Plugin
-	(*Browser_HighEntropyValues)(nil),                                                    // 31: device_utils.Browser.HighEntropyValues
-	(*Browser_WebRTC)(nil),                                                               // 32: device_utils.Browser.WebRTC
-	(*Browser_TLSFingerprint_ExtensionData)(nil),                                         // 33: device_utils.Browser.TLSFingerprint.ExtensionData
-	(*Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms)(nil),                     // 34: device_utils.Browser.TLSFingerprint.ExtensionData.SignatureAlgorithms
-	(*Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation)(nil),                    // 35: device_utils.Browser.TLSFingerprint.ExtensionData.ApplicationLayerProtocolNegotiation
-	(*Browser_TLSFingerprint_ExtensionData_RecordSizeLimit)(nil),                                        // 36: device_utils.Browser.TLSFingerprint.ExtensionData.RecordSizeLimit
-	(*Browser_TLSFingerprint_ExtensionData_CompressCertificate)(nil),                                    // 37: device_utils.Browser.TLSFingerprint.ExtensionData.CompressCertificate
-	(*Browser_TLSFingerprint_ExtensionData_SupportedVersions)(nil),                                      // 38: device_utils.Browser.TLSFingerprint.ExtensionData.SupportedVersions
-	(*Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes)(nil),                                    // 39: device_utils.Browser.TLSFingerprint.ExtensionData.PSKKeyExchangeModes
-	(*Browser_TLSFingerprint_ExtensionData_KeyShareExtension)(nil),                                      // 40: device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension
-	(*Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings)(nil),                          // 41: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionApplicationsSettings
-	(*Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo)(nil),                             // 42: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionRenegotiationInfo
-	(*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello)(nil),                          // 43: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello
-	(*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare)(nil),                             // 44: device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension.KeyShare
-	(*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite)(nil), // 45: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HPKESymmetricCipherSuite
-	(*Browser_HTTPFingerprint_PriorityFrameOpts)(nil),                                                   // 46: device_utils.Browser.HTTPFingerprint.PriorityFrameOpts
-	(*Browser_HTTPFingerprint_SettingsFrameOpts)(nil),                                                   // 47: device_utils.Browser.HTTPFingerprint.SettingsFrameOpts
-	nil, // 48: device_utils.Browser.BrowserCollection.MapDataEntry
-	nil, // 49: device_utils.Browser.BrowserCollection.GlCapabilitiesEntry
-	(*Browser_BrowserScreen_Orientation)(nil), // 50: device_utils.Browser.BrowserScreen.Orientation
-	(*Browser_WebGPU_Features)(nil),           // 51: device_utils.Browser.WebGPU.Features
-	nil,                                       // 52: device_utils.Browser.WebGPU.LimitsEntry
-	(*Browser_Plugin_MIMEType)(nil),           // 53: device_utils.Browser.Plugin.MIMEType
-	nil,                                       // 54: device_utils.Browser.Plugin.SupportedMIMETypesEntry
-	(*Browser_HighEntropyValues_Brand)(nil),   // 55: device_utils.Browser.HighEntropyValues.Brand
-	(*Browser_WebRTC_Codec)(nil),              // 56: device_utils.Browser.WebRTC.Codec
-	(*Browser_WebRTC_HeaderExtension)(nil),    // 57: device_utils.Browser.WebRTC.HeaderExtension
-	(*Browser_WebRTC_CodecInformation)(nil),   // 58: device_utils.Browser.WebRTC.CodecInformation
-	(*SIMCard_IMEI)(nil),                      // 59: device_utils.SIMCard.IMEI
-	(*SIMCard_MEID)(nil),                      // 60: device_utils.SIMCard.MEID
-	(*AndroidDevice_ID)(nil),                  // 61: device_utils.AndroidDevice.ID
-	(*AndroidDevice_BuildData)(nil),           // 62: device_utils.AndroidDevice.BuildData
-	(*AndroidDevice_DeviceSoftware)(nil),      // 63: device_utils.AndroidDevice.DeviceSoftware
-	nil,                                       // 64: device_utils.AndroidDevice.DeviceSoftware.SoftwareMetaDataEntry
-	nil,                                       // 65: device_utils.AndroidDevice.DeviceSoftware.AppMetaDataEntry
+	(Browser_HTTPFingerprint_FrameType)(0),                                               // 11: device_utils.Browser.HTTPFingerprint.FrameType
+	(Browser_GLCapability_Shape)(0),                                                      // 12: device_utils.Browser.GLCapability.Shape
+	(GPSLocation_LocationProvider)(0),                                                    // 13: device_utils.GPSLocation.LocationProvider
+	(CPUData_Architecture)(0),                                                            // 14: device_utils.CPUData.Architecture
+	(AndroidDevice_Version)(0),                                                           // 15: device_utils.AndroidDevice.Version
+	(*Browser)(nil),                                                                      // 16: device_utils.Browser
+	(*GPSLocation)(nil),                                                                  // 17: device_utils.GPSLocation
+	(*Locale)(nil),                                                                       // 18: device_utils.Locale
+	(*SIMCard)(nil),                                                                      // 19: device_utils.SIMCard
+	(*MAC)(nil),                                                                          // 20: device_utils.MAC
+	(*Timezone)(nil),                                                                     // 21: device_utils.Timezone
+	(*ScreenData)(nil),                                                                   // 22: device_utils.ScreenData
+	(*CPUData)(nil),                                                                      // 23: device_utils.CPUData
+	(*AndroidDevice)(nil),                                                                // 24: device_utils.AndroidDevice
+	(*Browser_TLSFingerprint)(nil),                                                       // 25: device_utils.Browser.TLSFingerprint
+	(*Browser_HTTPFingerprint)(nil),                                                      // 26: device_utils.Browser.HTTPFingerprint
+	(*Browser_GLCapability)(nil),                                                         // 27: device_utils.Browser.GLCapability
+	(*Browser_BrowserCollection)(nil),                                                    // 28: device_utils.Browser.BrowserCollection
+	(*Browser_BrowserScreen)(nil),                                                        // 29: device_utils.Browser.BrowserScreen
+	(*Browser_WebGPU)(nil),                                                               // 30: device_utils.Browser.WebGPU
+	(*Browser_SpeechSynthesis)(nil),                                                      // 31: device_utils.Browser.SpeechSynthesis
+	(*Browser_Plugin)(nil),                                                               // 32: device_utils.Browser.Plugin
+	(*Browser_UserActivation)(nil),                                                       // 33: device_utils.Browser.UserActivation
+	(*Browser_ChromeApp)(nil),                                                            // 34: device_utils.Browser.ChromeApp
+	(*Browser_MediaDevice)(nil),                                                          // 35: device_utils.Browser.MediaDevice
+	(*Browser_AudioParam)(nil),                                                           // 36: device_utils.Browser.AudioParam
+	(*Browser_AudioDestination)(nil),                                                     // 37: device_utils.Browser.AudioDestination
+	(*Browser_AudioContext)(nil),                                                         // 38: device_utils.Browser.AudioContext
+	(*Browser_StackProbe)(nil),                                                           // 39: device_utils.Browser.StackProbe
+	(*Browser_HighEntropyValues)(nil),                                                    // 40: device_utils.Browser.HighEntropyValues
+	(*Browser_WebRTC)(nil),                                                               // 41: device_utils.Browser.WebRTC
+	nil,                                                                                  // 42: device_utils.Browser.PermissionsEntry
+	(*Browser_TLSFingerprint_ExtensionData)(nil),                                         // 43: device_utils.Browser.TLSFingerprint.ExtensionData
+	(*Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms)(nil),                     // 44: device_utils.Browser.TLSFingerprint.ExtensionData.SignatureAlgorithms
+	(*Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation)(nil),                    // 45: device_utils.Browser.TLSFingerprint.ExtensionData.ApplicationLayerProtocolNegotiation
+	(*Browser_TLSFingerprint_ExtensionData_RecordSizeLimit)(nil),                                        // 46: device_utils.Browser.TLSFingerprint.ExtensionData.RecordSizeLimit
+	(*Browser_TLSFingerprint_ExtensionData_CompressCertificate)(nil),                                    // 47: device_utils.Browser.TLSFingerprint.ExtensionData.CompressCertificate
+	(*Browser_TLSFingerprint_ExtensionData_SupportedVersions)(nil),                                      // 48: device_utils.Browser.TLSFingerprint.ExtensionData.SupportedVersions
+	(*Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes)(nil),                                    // 49: device_utils.Browser.TLSFingerprint.ExtensionData.PSKKeyExchangeModes
+	(*Browser_TLSFingerprint_ExtensionData_KeyShareExtension)(nil),                                      // 50: device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension
+	(*Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings)(nil),                          // 51: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionApplicationsSettings
+	(*Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo)(nil),                             // 52: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionRenegotiationInfo
+	(*Browser_TLSFingerprint_ExtensionData_StatusRequest)(nil),                                          // 53: device_utils.Browser.TLSFingerprint.ExtensionData.StatusRequest
+	(*Browser_TLSFingerprint_ExtensionData_SupportedGroups)(nil),                                        // 54: device_utils.Browser.TLSFingerprint.ExtensionData.SupportedGroups
+	(*Browser_TLSFingerprint_ExtensionData_Padding)(nil),                                                // 55: device_utils.Browser.TLSFingerprint.ExtensionData.Padding
+	(*Browser_TLSFingerprint_ExtensionData_DelegatedCredentials)(nil),                                   // 56: device_utils.Browser.TLSFingerprint.ExtensionData.DelegatedCredentials
+	(*Browser_TLSFingerprint_ExtensionData_SessionTicket)(nil),                                          // 57: device_utils.Browser.TLSFingerprint.ExtensionData.SessionTicket
+	(*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello)(nil),                          // 58: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello
+	(*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare)(nil),                             // 59: device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension.KeyShare
+	(*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite)(nil), // 60: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HPKESymmetricCipherSuite
+	(*Browser_HTTPFingerprint_PriorityFrameOpts)(nil),                                                   // 61: device_utils.Browser.HTTPFingerprint.PriorityFrameOpts
+	(*Browser_HTTPFingerprint_SettingsFrameOpts)(nil),                                                   // 62: device_utils.Browser.HTTPFingerprint.SettingsFrameOpts
+	(*Browser_HTTPFingerprint_Setting)(nil),                                                             // 63: device_utils.Browser.HTTPFingerprint.Setting
+	(*Browser_HTTPFingerprint_Frame)(nil),                                                               // 64: device_utils.Browser.HTTPFingerprint.Frame
+	nil,                                                                                                 // 65: device_utils.Browser.BrowserCollection.MapDataEntry
+	nil,                                                                                                 // 66: device_utils.Browser.BrowserCollection.GlCapabilitiesEntry
+	nil,                                                                                                 // 67: device_utils.Browser.BrowserCollection.NumberDataEntry
+	(*Browser_BrowserScreen_Orientation)(nil),                                                           // 68: device_utils.Browser.BrowserScreen.Orientation
+	(*Browser_WebGPU_Features)(nil),                                                                     // 69: device_utils.Browser.WebGPU.Features
+	nil,                                                                                                 // 70: device_utils.Browser.WebGPU.LimitsEntry
+	(*Browser_Plugin_MIMEType)(nil),                                                                     // 71: device_utils.Browser.Plugin.MIMEType
+	nil,                                                                                                 // 72: device_utils.Browser.Plugin.SupportedMIMETypesEntry
+	nil,                                                                                                 // 73: device_utils.Browser.ChromeApp.InstallStateEntry
+	nil,                                                                                                 // 74: device_utils.Browser.ChromeApp.RunningStateEntry
+	nil,                                                                                                 // 75: device_utils.Browser.AudioContext.ListenerEntry
+	(*Browser_HighEntropyValues_Brand)(nil),                                                             // 76: device_utils.Browser.HighEntropyValues.Brand
+	(*Browser_WebRTC_Codec)(nil),                                                                        // 77: device_utils.Browser.WebRTC.Codec
+	(*Browser_WebRTC_HeaderExtension)(nil),                                                              // 78: device_utils.Browser.WebRTC.HeaderExtension
+	(*Browser_WebRTC_CodecInformation)(nil),                                                             // 79: device_utils.Browser.WebRTC.CodecInformation
+	(*SIMCard_IMEI)(nil),                                                                                // 80: device_utils.SIMCard.IMEI
+	(*SIMCard_MEID)(nil),                                                                                // 81: device_utils.SIMCard.MEID
+	(*AndroidDevice_ID)(nil),                                                                            // 82: device_utils.AndroidDevice.ID
+	(*AndroidDevice_BuildData)(nil),                                                                     // 83: device_utils.AndroidDevice.BuildData
+	(*AndroidDevice_DeviceSoftware)(nil),                                                                // 84: device_utils.AndroidDevice.DeviceSoftware
+	nil,                                                                                                 // 85: device_utils.AndroidDevice.DeviceSoftware.SoftwareMetaDataEntry
+	nil,                                                                                                 // 86: device_utils.AndroidDevice.DeviceSoftware.AppMetaDataEntry
 }
 var file_proto_device_utils_device_utils_proto_depIdxs = []int32{
-	23, // 0: device_utils.Browser.tlsFingerprint:type_name -> device_utils.Browser.TLSFingerprint
-	24, // 1: device_utils.Browser.httpFingerprint:type_name -> device_utils.Browser.HTTPFingerprint
-	27, // 2: device_utils.Browser.screen:type_name -> device_utils.Browser.BrowserScreen
-	28, // 3: device_utils.Browser.webGPU:type_name -> device_utils.Browser.WebGPU
-	29, // 4: device_utils.Browser.speechSynthesis:type_name -> device_utils.Browser.SpeechSynthesis
-	30, // 5: device_utils.Browser.plugins:type_name -> device_utils.Browser.Plugin
-	31, // 6: device_utils.Browser.highEntropyValues:type_name -> device_utils.Browser.HighEntropyValues
-	32, // 7: device_utils.Browser.webRTC:type_name -> device_utils.Browser.WebRTC
-	26, // 8: device_utils.Browser.availableFonts:type_name -> device_utils.Browser.BrowserCollection
-	26, // 9: device_utils.Browser.navigator:type_name -> device_utils.Browser.BrowserCollection
-	26, // 10: device_utils.Browser.window:type_name -> device_utils.Browser.BrowserCollection
-	26, // 11: device_utils.Browser.document:type_name -> device_utils.Browser.BrowserCollection
-	26, // 12: device_utils.Browser.documentElement:type_name -> device_utils.Browser.BrowserCollection
-	26, // 13: device_utils.Browser.audioTypes:type_name -> device_utils.Browser.BrowserCollection
-	26, // 14: device_utils.Browser.videoTypes:type_name -> device_utils.Browser.BrowserCollection
-	26, // 15: device_utils.Browser.css:type_name -> device_utils.Browser.BrowserCollection
-	26, // 16: device_utils.Browser.gl:type_name -> device_utils.Browser.BrowserCollection
-	26, // 17: device_utils.Browser.gl2:type_name -> device_utils.Browser.BrowserCollection
-	26, // 18: device_utils.Browser.glExperimental:type_name -> device_utils.Browser.BrowserCollection
-	11, // 19: device_utils.GPSLocation.provider:type_name -> device_utils.GPSLocation.LocationProvider
-	59, // 20: device_utils.SIMCard.imei:type_name -> device_utils.SIMCard.IMEI
-	60, // 21: device_utils.SIMCard.meid:type_name -> device_utils.SIMCard.MEID
-	12, // 22: device_utils.CPUData.arch:type_name -> device_utils.CPUData.Architecture
-	61, // 23: device_utils.AndroidDevice.id:type_name -> device_utils.AndroidDevice.ID
-	13, // 24: device_utils.AndroidDevice.version:type_name -> device_utils.AndroidDevice.Version
-	62, // 25: device_utils.AndroidDevice.build:type_name -> device_utils.AndroidDevice.BuildData
-	63, // 26: device_utils.AndroidDevice.software:type_name -> device_utils.AndroidDevice.DeviceSoftware
-	20, // 27: device_utils.AndroidDevice.screen:type_name -> device_utils.ScreenData
-	21, // 28: device_utils.AndroidDevice.cpu:type_name -> device_utils.CPUData
-	16, // 29: device_utils.AndroidDevice.locale:type_name -> device_utils.Locale
-	15, // 30: device_utils.AndroidDevice.location:type_name -> device_utils.GPSLocation
-	19, // 31: device_utils.AndroidDevice.timezone:type_name -> device_utils.Timezone
-	18, // 32: device_utils.AndroidDevice.macAddress:type_name -> device_utils.MAC
-	17, // 33: device_utils.AndroidDevice.simSlots:type_name -> device_utils.SIMCard
-	0,  // 34: device_utils.Browser.TLSFingerprint.version:type_name -> device_utils.Browser.TLSFingerprint.ProtocolVersion
-	1,  // 35: device_utils.Browser.TLSFingerprint.cipherSuites:type_name -> device_utils.Browser.TLSFingerprint.CipherSuite
-	2,  // 36: device_utils.Browser.TLSFingerprint.extensions:type_name -> device_utils.Browser.TLSFingerprint.Extension
-	5,  // 37: device_utils.Browser.TLSFingerprint.ellipticCurves:type_name -> device_utils.Browser.TLSFingerprint.EllipticCurve
-	6,  // 38: device_utils.Browser.TLSFingerprint.ellipticCurvePointFormats:type_name -> device_utils.Browser.TLSFingerprint.EllipticCurvePointFormat
-	33, // 39: device_utils.Browser.TLSFingerprint.extensionData:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData
-	47, // 40: device_utils.Browser.HTTPFingerprint.settingsFrame:type_name -> device_utils.Browser.HTTPFingerprint.SettingsFrameOpts
-	46, // 41: device_utils.Browser.HTTPFingerprint.priorityFrames:type_name -> device_utils.Browser.HTTPFingerprint.PriorityFrameOpts
-	46, // 42: device_utils.Browser.HTTPFingerprint.headerFramePriority:type_name -> device_utils.Browser.HTTPFingerprint.PriorityFrameOpts
-	48, // 43: device_utils.Browser.BrowserCollection.mapData:type_name -> device_utils.Browser.BrowserCollection.MapDataEntry
-	49, // 44: device_utils.Browser.BrowserCollection.glCapabilities:type_name -> device_utils.Browser.BrowserCollection.GlCapabilitiesEntry
-	50, // 45: device_utils.Browser.BrowserScreen.orientation:type_name -> device_utils.Browser.BrowserScreen.Orientation
-	51, // 46: device_utils.Browser.WebGPU.features:type_name -> device_utils.Browser.WebGPU.Features
-	52, // 47: device_utils.Browser.WebGPU.limits:type_name -> device_utils.Browser.WebGPU.LimitsEntry
-	54, // 48: device_utils.Browser.Plugin.supportedMIMETypes:type_name -> device_utils.Browser.Plugin.SupportedMIMETypesEntry
-	55, // 49: device_utils.Browser.HighEntropyValues.brands:type_name -> device_utils.Browser.HighEntropyValues.Brand
-	55, // 50: device_utils.Browser.HighEntropyValues.fullVersionList:type_name -> device_utils.Browser.HighEntropyValues.Brand
-	58, // 51: device_utils.Browser.WebRTC.video:type_name -> device_utils.Browser.WebRTC.CodecInformation
-	58, // 52: device_utils.Browser.WebRTC.audio:type_name -> device_utils.Browser.WebRTC.CodecInformation
-	2,  // 53: device_utils.Browser.TLSFingerprint.ExtensionData.extensionId:type_name -> device_utils.Browser.TLSFingerprint.Extension
-	34, // 54: device_utils.Browser.TLSFingerprint.ExtensionData.signatureAlgorithms:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.SignatureAlgorithms
-	35, // 55: device_utils.Browser.TLSFingerprint.ExtensionData.applicationLayerProtocolNegotiation:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ApplicationLayerProtocolNegotiation
-	37, // 56: device_utils.Browser.TLSFingerprint.ExtensionData.compressCertificate:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.CompressCertificate
-	36, // 57: device_utils.Browser.TLSFingerprint.ExtensionData.recordSizeLimit:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.RecordSizeLimit
-	38, // 58: device_utils.Browser.TLSFingerprint.ExtensionData.supportedVersions:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.SupportedVersions
-	39, // 59: device_utils.Browser.TLSFingerprint.ExtensionData.pskKeyExchangeModes:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.PSKKeyExchangeModes
-	40, // 60: device_utils.Browser.TLSFingerprint.ExtensionData.keyShareExtension:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension
-	41, // 61: device_utils.Browser.TLSFingerprint.ExtensionData.extensionApplicationsSettings:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionApplicationsSettings
-	43, // 62: device_utils.Browser.TLSFingerprint.ExtensionData.extensionEncryptedClientHello:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello
-	42, // 63: device_utils.Browser.TLSFingerprint.ExtensionData.extensionRenegotiationInfo:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionRenegotiationInfo
-	4,  // 64: device_utils.Browser.TLSFingerprint.ExtensionData.SignatureAlgorithms.supportedSignatureAlgorithms:type_name -> device_utils.Browser.TLSFingerprint.SignatureScheme
-	7,  // 65: device_utils.Browser.TLSFingerprint.ExtensionData.CompressCertificate.algorithms:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.CompressCertificate.CertificateCompression
-	0,  // 66: device_utils.Browser.TLSFingerprint.ExtensionData.SupportedVersions.versions:type_name -> device_utils.Browser.TLSFingerprint.ProtocolVersion
-	8,  // 67: device_utils.Browser.TLSFingerprint.ExtensionData.PSKKeyExchangeModes.modes:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.PSKKeyExchangeModes.Mode
-	44, // 68: device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension.keyShares:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension.KeyShare
-	3,  // 69: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionRenegotiationInfo.renegotiationSupport:type_name -> device_utils.Browser.TLSFingerprint.RenegotiationSupport
-	45, // 70: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.candidateCipherSuites:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HPKESymmetricCipherSuite
-	5,  // 71: device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension.KeyShare.group:type_name -> device_utils.Browser.TLSFingerprint.EllipticCurve
-	9,  // 72: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HPKESymmetricCipherSuite.kdfId:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HKDF
-	10, // 73: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HPKESymmetricCipherSuite.aeadId:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HPKEAEAD
-	25, // 74: device_utils.Browser.BrowserCollection.GlCapabilitiesEntry.value:type_name -> device_utils.Browser.GLCapability
-	53, // 75: device_utils.Browser.Plugin.SupportedMIMETypesEntry.value:type_name -> device_utils.Browser.Plugin.MIMEType
-	56, // 76: device_utils.Browser.WebRTC.CodecInformation.codecs:type_name -> device_utils.Browser.WebRTC.Codec
-	57, // 77: device_utils.Browser.WebRTC.CodecInformation.headerExtensions:type_name -> device_utils.Browser.WebRTC.HeaderExtension
-	64, // 78: device_utils.AndroidDevice.DeviceSoftware.softwareMetaData:type_name -> device_utils.AndroidDevice.DeviceSoftware.SoftwareMetaDataEntry
-	65, // 79: device_utils.AndroidDevice.DeviceSoftware.appMetaData:type_name -> device_utils.AndroidDevice.DeviceSoftware.AppMetaDataEntry
-	80, // [80:80] is the sub-list for method output_type
-	80, // [80:80] is the sub-list for method input_type
-	80, // [80:80] is the sub-list for extension type_name
-	80, // [80:80] is the sub-list for extension extendee
-	0,  // [0:80] is the sub-list for field type_name
+	25,  // 0: device_utils.Browser.tlsFingerprint:type_name -> device_utils.Browser.TLSFingerprint
+	26,  // 1: device_utils.Browser.httpFingerprint:type_name -> device_utils.Browser.HTTPFingerprint
+	29,  // 2: device_utils.Browser.screen:type_name -> device_utils.Browser.BrowserScreen
+	30,  // 3: device_utils.Browser.webGPU:type_name -> device_utils.Browser.WebGPU
+	31,  // 4: device_utils.Browser.speechSynthesis:type_name -> device_utils.Browser.SpeechSynthesis
+	32,  // 5: device_utils.Browser.plugins:type_name -> device_utils.Browser.Plugin
+	40,  // 6: device_utils.Browser.highEntropyValues:type_name -> device_utils.Browser.HighEntropyValues
+	41,  // 7: device_utils.Browser.webRTC:type_name -> device_utils.Browser.WebRTC
+	33,  // 8: device_utils.Browser.userActivation:type_name -> device_utils.Browser.UserActivation
+	34,  // 9: device_utils.Browser.chromeApp:type_name -> device_utils.Browser.ChromeApp
+	42,  // 10: device_utils.Browser.permissions:type_name -> device_utils.Browser.PermissionsEntry
+	38,  // 11: device_utils.Browser.audioContext:type_name -> device_utils.Browser.AudioContext
+	35,  // 12: device_utils.Browser.mediaDevices:type_name -> device_utils.Browser.MediaDevice
+	39,  // 13: device_utils.Browser.stackNative:type_name -> device_utils.Browser.StackProbe
+	39,  // 14: device_utils.Browser.stackWorker:type_name -> device_utils.Browser.StackProbe
+	28,  // 15: device_utils.Browser.availableFonts:type_name -> device_utils.Browser.BrowserCollection
+	28,  // 16: device_utils.Browser.navigator:type_name -> device_utils.Browser.BrowserCollection
+	28,  // 17: device_utils.Browser.window:type_name -> device_utils.Browser.BrowserCollection
+	28,  // 18: device_utils.Browser.document:type_name -> device_utils.Browser.BrowserCollection
+	28,  // 19: device_utils.Browser.documentElement:type_name -> device_utils.Browser.BrowserCollection
+	28,  // 20: device_utils.Browser.audioTypes:type_name -> device_utils.Browser.BrowserCollection
+	28,  // 21: device_utils.Browser.videoTypes:type_name -> device_utils.Browser.BrowserCollection
+	28,  // 22: device_utils.Browser.css:type_name -> device_utils.Browser.BrowserCollection
+	28,  // 23: device_utils.Browser.gl:type_name -> device_utils.Browser.BrowserCollection
+	28,  // 24: device_utils.Browser.gl2:type_name -> device_utils.Browser.BrowserCollection
+	28,  // 25: device_utils.Browser.glExperimental:type_name -> device_utils.Browser.BrowserCollection
+	13,  // 26: device_utils.GPSLocation.provider:type_name -> device_utils.GPSLocation.LocationProvider
+	80,  // 27: device_utils.SIMCard.imei:type_name -> device_utils.SIMCard.IMEI
+	81,  // 28: device_utils.SIMCard.meid:type_name -> device_utils.SIMCard.MEID
+	14,  // 29: device_utils.CPUData.arch:type_name -> device_utils.CPUData.Architecture
+	82,  // 30: device_utils.AndroidDevice.id:type_name -> device_utils.AndroidDevice.ID
+	15,  // 31: device_utils.AndroidDevice.version:type_name -> device_utils.AndroidDevice.Version
+	83,  // 32: device_utils.AndroidDevice.build:type_name -> device_utils.AndroidDevice.BuildData
+	84,  // 33: device_utils.AndroidDevice.software:type_name -> device_utils.AndroidDevice.DeviceSoftware
+	22,  // 34: device_utils.AndroidDevice.screen:type_name -> device_utils.ScreenData
+	23,  // 35: device_utils.AndroidDevice.cpu:type_name -> device_utils.CPUData
+	18,  // 36: device_utils.AndroidDevice.locale:type_name -> device_utils.Locale
+	17,  // 37: device_utils.AndroidDevice.location:type_name -> device_utils.GPSLocation
+	21,  // 38: device_utils.AndroidDevice.timezone:type_name -> device_utils.Timezone
+	20,  // 39: device_utils.AndroidDevice.macAddress:type_name -> device_utils.MAC
+	19,  // 40: device_utils.AndroidDevice.simSlots:type_name -> device_utils.SIMCard
+	0,   // 41: device_utils.Browser.TLSFingerprint.version:type_name -> device_utils.Browser.TLSFingerprint.ProtocolVersion
+	1,   // 42: device_utils.Browser.TLSFingerprint.cipherSuites:type_name -> device_utils.Browser.TLSFingerprint.CipherSuite
+	2,   // 43: device_utils.Browser.TLSFingerprint.extensions:type_name -> device_utils.Browser.TLSFingerprint.Extension
+	5,   // 44: device_utils.Browser.TLSFingerprint.ellipticCurves:type_name -> device_utils.Browser.TLSFingerprint.EllipticCurve
+	6,   // 45: device_utils.Browser.TLSFingerprint.ellipticCurvePointFormats:type_name -> device_utils.Browser.TLSFingerprint.EllipticCurvePointFormat
+	43,  // 46: device_utils.Browser.TLSFingerprint.extensionData:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData
+	62,  // 47: device_utils.Browser.HTTPFingerprint.settingsFrame:type_name -> device_utils.Browser.HTTPFingerprint.SettingsFrameOpts
+	61,  // 48: device_utils.Browser.HTTPFingerprint.priorityFrames:type_name -> device_utils.Browser.HTTPFingerprint.PriorityFrameOpts
+	61,  // 49: device_utils.Browser.HTTPFingerprint.headerFramePriority:type_name -> device_utils.Browser.HTTPFingerprint.PriorityFrameOpts
+	64,  // 50: device_utils.Browser.HTTPFingerprint.frames:type_name -> device_utils.Browser.HTTPFingerprint.Frame
+	63,  // 51: device_utils.Browser.HTTPFingerprint.settings:type_name -> device_utils.Browser.HTTPFingerprint.Setting
+	12,  // 52: device_utils.Browser.GLCapability.shape:type_name -> device_utils.Browser.GLCapability.Shape
+	65,  // 53: device_utils.Browser.BrowserCollection.mapData:type_name -> device_utils.Browser.BrowserCollection.MapDataEntry
+	66,  // 54: device_utils.Browser.BrowserCollection.glCapabilities:type_name -> device_utils.Browser.BrowserCollection.GlCapabilitiesEntry
+	67,  // 55: device_utils.Browser.BrowserCollection.numberData:type_name -> device_utils.Browser.BrowserCollection.NumberDataEntry
+	68,  // 56: device_utils.Browser.BrowserScreen.orientation:type_name -> device_utils.Browser.BrowserScreen.Orientation
+	69,  // 57: device_utils.Browser.WebGPU.features:type_name -> device_utils.Browser.WebGPU.Features
+	70,  // 58: device_utils.Browser.WebGPU.limits:type_name -> device_utils.Browser.WebGPU.LimitsEntry
+	72,  // 59: device_utils.Browser.Plugin.supportedMIMETypes:type_name -> device_utils.Browser.Plugin.SupportedMIMETypesEntry
+	71,  // 60: device_utils.Browser.Plugin.mimeTypes:type_name -> device_utils.Browser.Plugin.MIMEType
+	73,  // 61: device_utils.Browser.ChromeApp.installState:type_name -> device_utils.Browser.ChromeApp.InstallStateEntry
+	74,  // 62: device_utils.Browser.ChromeApp.runningState:type_name -> device_utils.Browser.ChromeApp.RunningStateEntry
+	38,  // 63: device_utils.Browser.AudioDestination.context:type_name -> device_utils.Browser.AudioContext
+	37,  // 64: device_utils.Browser.AudioContext.destination:type_name -> device_utils.Browser.AudioDestination
+	75,  // 65: device_utils.Browser.AudioContext.listener:type_name -> device_utils.Browser.AudioContext.ListenerEntry
+	76,  // 66: device_utils.Browser.HighEntropyValues.brands:type_name -> device_utils.Browser.HighEntropyValues.Brand
+	76,  // 67: device_utils.Browser.HighEntropyValues.fullVersionList:type_name -> device_utils.Browser.HighEntropyValues.Brand
+	79,  // 68: device_utils.Browser.WebRTC.video:type_name -> device_utils.Browser.WebRTC.CodecInformation
+	79,  // 69: device_utils.Browser.WebRTC.audio:type_name -> device_utils.Browser.WebRTC.CodecInformation
+	2,   // 70: device_utils.Browser.TLSFingerprint.ExtensionData.extensionId:type_name -> device_utils.Browser.TLSFingerprint.Extension
+	53,  // 71: device_utils.Browser.TLSFingerprint.ExtensionData.statusRequest:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.StatusRequest
+	54,  // 72: device_utils.Browser.TLSFingerprint.ExtensionData.supportedGroups:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.SupportedGroups
+	44,  // 73: device_utils.Browser.TLSFingerprint.ExtensionData.signatureAlgorithms:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.SignatureAlgorithms
+	45,  // 74: device_utils.Browser.TLSFingerprint.ExtensionData.applicationLayerProtocolNegotiation:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ApplicationLayerProtocolNegotiation
+	55,  // 75: device_utils.Browser.TLSFingerprint.ExtensionData.padding:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.Padding
+	47,  // 76: device_utils.Browser.TLSFingerprint.ExtensionData.compressCertificate:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.CompressCertificate
+	46,  // 77: device_utils.Browser.TLSFingerprint.ExtensionData.recordSizeLimit:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.RecordSizeLimit
+	56,  // 78: device_utils.Browser.TLSFingerprint.ExtensionData.delegatedCredentials:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.DelegatedCredentials
+	57,  // 79: device_utils.Browser.TLSFingerprint.ExtensionData.sessionTicket:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.SessionTicket
+	48,  // 80: device_utils.Browser.TLSFingerprint.ExtensionData.supportedVersions:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.SupportedVersions
+	49,  // 81: device_utils.Browser.TLSFingerprint.ExtensionData.pskKeyExchangeModes:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.PSKKeyExchangeModes
+	50,  // 82: device_utils.Browser.TLSFingerprint.ExtensionData.keyShareExtension:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension
+	51,  // 83: device_utils.Browser.TLSFingerprint.ExtensionData.extensionApplicationsSettings:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionApplicationsSettings
+	58,  // 84: device_utils.Browser.TLSFingerprint.ExtensionData.extensionEncryptedClientHello:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello
+	52,  // 85: device_utils.Browser.TLSFingerprint.ExtensionData.extensionRenegotiationInfo:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionRenegotiationInfo
+	4,   // 86: device_utils.Browser.TLSFingerprint.ExtensionData.SignatureAlgorithms.supportedSignatureAlgorithms:type_name -> device_utils.Browser.TLSFingerprint.SignatureScheme
+	7,   // 87: device_utils.Browser.TLSFingerprint.ExtensionData.CompressCertificate.algorithms:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.CompressCertificate.CertificateCompression
+	0,   // 88: device_utils.Browser.TLSFingerprint.ExtensionData.SupportedVersions.versions:type_name -> device_utils.Browser.TLSFingerprint.ProtocolVersion
+	8,   // 89: device_utils.Browser.TLSFingerprint.ExtensionData.PSKKeyExchangeModes.modes:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.PSKKeyExchangeModes.Mode
+	59,  // 90: device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension.keyShares:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension.KeyShare
+	3,   // 91: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionRenegotiationInfo.renegotiationSupport:type_name -> device_utils.Browser.TLSFingerprint.RenegotiationSupport
+	5,   // 92: device_utils.Browser.TLSFingerprint.ExtensionData.SupportedGroups.groups:type_name -> device_utils.Browser.TLSFingerprint.EllipticCurve
+	4,   // 93: device_utils.Browser.TLSFingerprint.ExtensionData.DelegatedCredentials.supportedSignatureAlgorithms:type_name -> device_utils.Browser.TLSFingerprint.SignatureScheme
+	60,  // 94: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.candidateCipherSuites:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HPKESymmetricCipherSuite
+	5,   // 95: device_utils.Browser.TLSFingerprint.ExtensionData.KeyShareExtension.KeyShare.group:type_name -> device_utils.Browser.TLSFingerprint.EllipticCurve
+	9,   // 96: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HPKESymmetricCipherSuite.kdfId:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HKDF
+	10,  // 97: device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HPKESymmetricCipherSuite.aeadId:type_name -> device_utils.Browser.TLSFingerprint.ExtensionData.ExtensionEncryptedClientHello.HPKEAEAD
+	11,  // 98: device_utils.Browser.HTTPFingerprint.Frame.type:type_name -> device_utils.Browser.HTTPFingerprint.FrameType
+	63,  // 99: device_utils.Browser.HTTPFingerprint.Frame.settings:type_name -> device_utils.Browser.HTTPFingerprint.Setting
+	61,  // 100: device_utils.Browser.HTTPFingerprint.Frame.priority:type_name -> device_utils.Browser.HTTPFingerprint.PriorityFrameOpts
+	27,  // 101: device_utils.Browser.BrowserCollection.GlCapabilitiesEntry.value:type_name -> device_utils.Browser.GLCapability
+	71,  // 102: device_utils.Browser.Plugin.SupportedMIMETypesEntry.value:type_name -> device_utils.Browser.Plugin.MIMEType
+	36,  // 103: device_utils.Browser.AudioContext.ListenerEntry.value:type_name -> device_utils.Browser.AudioParam
+	77,  // 104: device_utils.Browser.WebRTC.CodecInformation.codecs:type_name -> device_utils.Browser.WebRTC.Codec
+	78,  // 105: device_utils.Browser.WebRTC.CodecInformation.headerExtensions:type_name -> device_utils.Browser.WebRTC.HeaderExtension
+	85,  // 106: device_utils.AndroidDevice.DeviceSoftware.softwareMetaData:type_name -> device_utils.AndroidDevice.DeviceSoftware.SoftwareMetaDataEntry
+	86,  // 107: device_utils.AndroidDevice.DeviceSoftware.appMetaData:type_name -> device_utils.AndroidDevice.DeviceSoftware.AppMetaDataEntry
+	108, // [108:108] is the sub-list for method output_type
+	108, // [108:108] is the sub-list for method input_type
+	108, // [108:108] is the sub-list for extension type_name
+	108, // [108:108] is the sub-list for extension extendee
+	0,   // [0:108] is the sub-list for field type_name
 }
 
 func init() { file_proto_device_utils_device_utils_proto_init() }
@@ -8163,7 +9836,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 			}
 		}
 		file_proto_device_utils_device_utils_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Browser_HighEntropyValues); i {
+			switch v := v.(*Browser_UserActivation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8175,7 +9848,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 			}
 		}
 		file_proto_device_utils_device_utils_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Browser_WebRTC); i {
+			switch v := v.(*Browser_ChromeApp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8187,7 +9860,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 			}
 		}
 		file_proto_device_utils_device_utils_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Browser_TLSFingerprint_ExtensionData); i {
+			switch v := v.(*Browser_MediaDevice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8199,7 +9872,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 			}
 		}
 		file_proto_device_utils_device_utils_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms); i {
+			switch v := v.(*Browser_AudioParam); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8211,7 +9884,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 			}
 		}
 		file_proto_device_utils_device_utils_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation); i {
+			switch v := v.(*Browser_AudioDestination); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8223,7 +9896,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 			}
 		}
 		file_proto_device_utils_device_utils_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Browser_TLSFingerprint_ExtensionData_RecordSizeLimit); i {
+			switch v := v.(*Browser_AudioContext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8235,7 +9908,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 			}
 		}
 		file_proto_device_utils_device_utils_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Browser_TLSFingerprint_ExtensionData_CompressCertificate); i {
+			switch v := v.(*Browser_StackProbe); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8247,7 +9920,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 			}
 		}
 		file_proto_device_utils_device_utils_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Browser_TLSFingerprint_ExtensionData_SupportedVersions); i {
+			switch v := v.(*Browser_HighEntropyValues); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8259,6 +9932,90 @@ func file_proto_device_utils_device_utils_proto_init() {
 			}
 		}
 		file_proto_device_utils_device_utils_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_WebRTC); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_TLSFingerprint_ExtensionData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_TLSFingerprint_ExtensionData_RecordSizeLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_TLSFingerprint_ExtensionData_CompressCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_TLSFingerprint_ExtensionData_SupportedVersions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes); i {
 			case 0:
 				return &v.state
@@ -8270,7 +10027,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_TLSFingerprint_ExtensionData_KeyShareExtension); i {
 			case 0:
 				return &v.state
@@ -8282,7 +10039,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings); i {
 			case 0:
 				return &v.state
@@ -8294,7 +10051,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo); i {
 			case 0:
 				return &v.state
@@ -8306,7 +10063,67 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_TLSFingerprint_ExtensionData_StatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_TLSFingerprint_ExtensionData_SupportedGroups); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_TLSFingerprint_ExtensionData_Padding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_TLSFingerprint_ExtensionData_DelegatedCredentials); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_TLSFingerprint_ExtensionData_SessionTicket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello); i {
 			case 0:
 				return &v.state
@@ -8318,7 +10135,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare); i {
 			case 0:
 				return &v.state
@@ -8330,7 +10147,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite); i {
 			case 0:
 				return &v.state
@@ -8342,7 +10159,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_HTTPFingerprint_PriorityFrameOpts); i {
 			case 0:
 				return &v.state
@@ -8354,7 +10171,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_HTTPFingerprint_SettingsFrameOpts); i {
 			case 0:
 				return &v.state
@@ -8366,7 +10183,31 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_HTTPFingerprint_Setting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Browser_HTTPFingerprint_Frame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_device_utils_device_utils_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_BrowserScreen_Orientation); i {
 			case 0:
 				return &v.state
@@ -8378,7 +10219,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_WebGPU_Features); i {
 			case 0:
 				return &v.state
@@ -8390,7 +10231,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_Plugin_MIMEType); i {
 			case 0:
 				return &v.state
@@ -8402,7 +10243,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_HighEntropyValues_Brand); i {
 			case 0:
 				return &v.state
@@ -8414,7 +10255,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_WebRTC_Codec); i {
 			case 0:
 				return &v.state
@@ -8426,7 +10267,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_WebRTC_HeaderExtension); i {
 			case 0:
 				return &v.state
@@ -8438,7 +10279,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Browser_WebRTC_CodecInformation); i {
 			case 0:
 				return &v.state
@@ -8450,7 +10291,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SIMCard_IMEI); i {
 			case 0:
 				return &v.state
@@ -8462,7 +10303,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SIMCard_MEID); i {
 			case 0:
 				return &v.state
@@ -8474,7 +10315,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AndroidDevice_ID); i {
 			case 0:
 				return &v.state
@@ -8486,7 +10327,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AndroidDevice_BuildData); i {
 			case 0:
 				return &v.state
@@ -8498,7 +10339,7 @@ func file_proto_device_utils_device_utils_proto_init() {
 				return nil
 			}
 		}
-		file_proto_device_utils_device_utils_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_device_utils_device_utils_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AndroidDevice_DeviceSoftware); i {
 			case 0:
 				return &v.state
@@ -8516,8 +10357,8 @@ func file_proto_device_utils_device_utils_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_device_utils_device_utils_proto_rawDesc,
-			NumEnums:      14,
-			NumMessages:   52,
+			NumEnums:      16,
+			NumMessages:   71,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
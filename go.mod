@@ -1,8 +1,18 @@
 module github.com/BRUHItsABunny/go-device-utils
 
-go 1.18
+go 1.24
 
 require (
 	github.com/davecgh/go-spew v1.1.1
+	github.com/refraction-networking/utls v1.8.2
+	golang.org/x/net v0.38.0
+	golang.org/x/text v0.23.0
 	google.golang.org/protobuf v1.32.0
 )
+
+require (
+	github.com/andybalholm/brotli v1.0.6 // indirect
+	github.com/klauspost/compress v1.18.0 // indirect
+	golang.org/x/crypto v0.36.0 // indirect
+	golang.org/x/sys v0.31.0 // indirect
+)
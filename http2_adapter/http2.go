@@ -0,0 +1,968 @@
+package http2_adapter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	device_utils "github.com/BRUHItsABunny/go-device-utils"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+var (
+	ErrNoHTTPFingerprint = errors.New("the supplied browser has no HTTP fingerprint")
+	ErrConnClosed        = errors.New("the HTTP/2 connection is closed")
+	ErrStreamReset       = errors.New("the HTTP/2 stream was reset by the server")
+	ErrFrameUnsupported  = errors.New("the supplied frame can't be replayed")
+	ErrFlowControl       = errors.New("the server sent more data than the HTTP/2 window allows")
+	ErrBodyClosed        = errors.New("the HTTP/2 response body is closed")
+	ErrNoDialer          = errors.New("the supplied transport has no DialTLSContext")
+)
+
+const (
+	// src: https://datatracker.ietf.org/doc/html/rfc9113#section-6.5.2
+	defaultHeaderTableSize   = 4096
+	defaultInitialWindowSize = 65535
+	defaultMaxFrameSize      = 16384
+)
+
+var (
+	// defaultPseudoHeaderOrder is the order Go's own transport uses, applied when the fingerprint has none
+	defaultPseudoHeaderOrder = []string{":authority", ":method", ":path", ":scheme"}
+	// connectionHeaders are forbidden in HTTP/2, src: https://datatracker.ietf.org/doc/html/rfc9113#section-8.2.2
+	connectionHeaders = []string{"connection", "host", "keep-alive", "proxy-connection", "transfer-encoding", "upgrade"}
+)
+
+// Settings Converts the SETTINGS parameters of the fingerprint into http2 settings, in the order they are sent
+func Settings(fp *device_utils.Browser_HTTPFingerprint) []http2.Setting {
+	settings := fp.OrderedSettings()
+	result := make([]http2.Setting, 0, len(settings))
+	for _, setting := range settings {
+		result = append(result, http2.Setting{ID: http2.SettingID(setting.Id), Val: setting.Value})
+	}
+	return result
+}
+
+// FrameSettings Converts the settings of a recorded SETTINGS frame, keeping their order and unknown IDs
+func FrameSettings(frame *device_utils.Browser_HTTPFingerprint_Frame) []http2.Setting {
+	result := make([]http2.Setting, 0, len(frame.GetSettings()))
+	for _, setting := range frame.GetSettings() {
+		result = append(result, http2.Setting{ID: http2.SettingID(setting.Id), Val: setting.Value})
+	}
+	return result
+}
+
+// prefaceFrames Returns the recorded frames sent ahead of the first HEADERS frame
+func prefaceFrames(fp *device_utils.Browser_HTTPFingerprint) []*device_utils.Browser_HTTPFingerprint_Frame {
+	result := []*device_utils.Browser_HTTPFingerprint_Frame{}
+	for _, frame := range fp.GetFrames() {
+		if frame.Type == device_utils.Browser_HTTPFingerprint_HEADERS {
+			break
+		}
+		result = append(result, frame)
+	}
+	return result
+}
+
+// PriorityParam Converts a stored priority into a http2 PriorityParam, the stored weight is 1-256 like in the Akamai fingerprint
+func PriorityParam(priority *device_utils.Browser_HTTPFingerprint_PriorityFrameOpts) http2.PriorityParam {
+	weight := priority.GetWeight() - 1
+	if weight < 0 {
+		weight = 0
+	} else if weight > 255 {
+		weight = 255
+	}
+	return http2.PriorityParam{
+		StreamDep: uint32(priority.GetStreamDep()),
+		Exclusive: priority.GetExclusive(),
+		Weight:    uint8(weight),
+	}
+}
+
+// OrderHeaders Returns the header fields of a request in the order of the fingerprint, pseudo headers go first
+func OrderHeaders(fp *device_utils.Browser_HTTPFingerprint, req *http.Request) []hpack.HeaderField {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	path := req.URL.RequestURI()
+	if req.Method == http.MethodConnect {
+		path = ""
+	}
+	pseudoHeaders := map[string]string{
+		":authority": host,
+		":method":    req.Method,
+		":path":      path,
+		":scheme":    req.URL.Scheme,
+	}
+	pseudoHeaderOrder := fp.GetPseudoHeaderOrder()
+	if len(pseudoHeaderOrder) == 0 {
+		pseudoHeaderOrder = defaultPseudoHeaderOrder
+	}
+
+	result := make([]hpack.HeaderField, 0, len(pseudoHeaders)+len(req.Header))
+	for _, pseudoHeader := range pseudoHeaderOrder {
+		value, ok := pseudoHeaders[pseudoHeader]
+		if !ok || value == "" {
+			continue
+		}
+		result = append(result, hpack.HeaderField{Name: pseudoHeader, Value: value})
+		delete(pseudoHeaders, pseudoHeader)
+	}
+	for _, pseudoHeader := range defaultPseudoHeaderOrder {
+		// Pseudo headers the fingerprint didn't order still have to be sent
+		if value, ok := pseudoHeaders[pseudoHeader]; ok && value != "" {
+			result = append(result, hpack.HeaderField{Name: pseudoHeader, Value: value})
+		}
+	}
+
+	headers := make(map[string][]string, len(req.Header)+1)
+	for key, values := range req.Header {
+		key = strings.ToLower(key)
+		if strInSlice(connectionHeaders, key) {
+			continue
+		}
+		headers[key] = append(headers[key], values...)
+	}
+	if req.ContentLength > 0 {
+		headers["content-length"] = []string{strconv.FormatInt(req.ContentLength, 10)}
+	}
+	for _, key := range fp.GetHeaderOrder() {
+		key = strings.ToLower(key)
+		for _, value := range headers[key] {
+			result = append(result, hpack.HeaderField{Name: key, Value: value})
+		}
+		delete(headers, key)
+	}
+	// Headers the fingerprint doesn't know about go last, sorted so requests stay deterministic
+	remaining := make([]string, 0, len(headers))
+	for key := range headers {
+		remaining = append(remaining, key)
+	}
+	sort.Strings(remaining)
+	for _, key := range remaining {
+		for _, value := range headers[key] {
+			result = append(result, hpack.HeaderField{Name: key, Value: value})
+		}
+	}
+	return result
+}
+
+// ClientConn is a single HTTP/2 connection that opens with the SETTINGS, WINDOW_UPDATE and PRIORITY frames of a fingerprint
+type ClientConn struct {
+	fp     *device_utils.Browser_HTTPFingerprint
+	conn   net.Conn
+	framer *http2.Framer
+
+	// wMu guards framer writes and the hpack encoder
+	wMu      sync.Mutex
+	hBuf     bytes.Buffer
+	hEncoder *hpack.Encoder
+
+	mu                sync.Mutex
+	cond              *sync.Cond
+	streams           map[uint32]*clientStream
+	nextStreamID      uint32
+	peerMaxFrameSize  uint32
+	peerInitialWindow int32
+	connSendWindow    int32
+	// The receive windows are the ones the preface advertised, credit is only returned once the caller reads the body
+	initialRecvWindow int32
+	connRecvWindow    int32
+	connRecvAvailable int32
+	connUnacked       int32
+	closed            bool
+	closeErr          error
+}
+
+type clientStream struct {
+	id            uint32
+	req           *http.Request
+	sendWindow    int32
+	recvAvailable int32
+	unacked       int32
+	resc          chan *http.Response
+	body          *responseBody
+	// res is only touched by the read loop, once it is set further HEADERS frames are trailers
+	res *http.Response
+}
+
+// NewClientConn Writes the connection preface for the fingerprint to conn and starts reading frames, conn must have negotiated h2
+func NewClientConn(conn net.Conn, fp *device_utils.Browser_HTTPFingerprint) (*ClientConn, error) {
+	cc := &ClientConn{
+		fp:                fp,
+		conn:              conn,
+		framer:            http2.NewFramer(conn, conn),
+		streams:           map[uint32]*clientStream{},
+		nextStreamID:      1,
+		peerMaxFrameSize:  defaultMaxFrameSize,
+		peerInitialWindow: defaultInitialWindowSize,
+		connSendWindow:    defaultInitialWindowSize,
+	}
+	cc.cond = sync.NewCond(&cc.mu)
+	cc.hEncoder = hpack.NewEncoder(&cc.hBuf)
+
+	// The SETTINGS fields win over the recorded frame, so SetSetting and the Chromium version changes apply to imported
+	// profiles too. Only fingerprints without them send the recorded SETTINGS
+	settings := Settings(fp)
+	preface := prefaceFrames(fp)
+	if fp.GetSettings() == nil && fp.GetSettingsFrame() == nil {
+		for _, frame := range preface {
+			if frame.Type == device_utils.Browser_HTTPFingerprint_SETTINGS {
+				settings = FrameSettings(frame)
+				break
+			}
+		}
+	}
+	headerTableSize := uint32(defaultHeaderTableSize)
+	for _, setting := range settings {
+		switch setting.ID {
+		case http2.SettingHeaderTableSize:
+			headerTableSize = setting.Val
+			break
+		case http2.SettingMaxHeaderListSize:
+			cc.framer.MaxHeaderListSize = setting.Val
+			break
+		}
+	}
+	cc.framer.ReadMetaHeaders = hpack.NewDecoder(headerTableSize, nil)
+	cc.initialRecvWindow, cc.connRecvWindow = defaultInitialWindowSize, defaultInitialWindowSize
+	for _, setting := range settings {
+		if setting.ID == http2.SettingInitialWindowSize {
+			cc.initialRecvWindow = int32(setting.Val)
+		}
+	}
+	if len(preface) > 0 {
+		for _, frame := range preface {
+			if frame.Type == device_utils.Browser_HTTPFingerprint_WINDOW_UPDATE && frame.StreamId == 0 {
+				cc.connRecvWindow += int32(frame.Increment)
+			}
+		}
+	} else {
+		cc.connRecvWindow += int32(fp.GetWindowUpdateIncrement())
+	}
+	cc.connRecvAvailable = cc.connRecvWindow
+
+	_, err := io.WriteString(conn, http2.ClientPreface)
+	if err != nil {
+		return nil, fmt.Errorf("io.WriteString: %w", err)
+	}
+	if len(preface) > 0 {
+		// Replay the observed frames as they were sent
+		err = cc.writeFrames(preface, settings)
+		if err != nil {
+			return nil, fmt.Errorf("cc.writeFrames: %w", err)
+		}
+	} else {
+		err = cc.framer.WriteSettings(settings...)
+		if err != nil {
+			return nil, fmt.Errorf("framer.WriteSettings: %w", err)
+		}
+		if fp.GetWindowUpdateIncrement() > 0 {
+			err = cc.framer.WriteWindowUpdate(0, uint32(fp.WindowUpdateIncrement))
+			if err != nil {
+				return nil, fmt.Errorf("framer.WriteWindowUpdate: %w", err)
+			}
+		}
+		for _, priorityFrame := range fp.GetPriorityFrames() {
+			err = cc.framer.WritePriority(uint32(priorityFrame.StreamId), PriorityParam(priorityFrame))
+			if err != nil {
+				return nil, fmt.Errorf("framer.WritePriority: %w", err)
+			}
+			cc.skipStream(uint32(priorityFrame.StreamId))
+		}
+	}
+	if cc.nextStreamID%2 == 0 {
+		cc.nextStreamID++
+	}
+
+	go cc.readLoop()
+	return cc, nil
+}
+
+// writeFrames Writes recorded connection level frames in order, the SETTINGS frame carries settings
+func (cc *ClientConn) writeFrames(frames []*device_utils.Browser_HTTPFingerprint_Frame, settings []http2.Setting) error {
+	for _, frame := range frames {
+		switch frame.Type {
+		case device_utils.Browser_HTTPFingerprint_SETTINGS:
+			err := cc.framer.WriteSettings(settings...)
+			if err != nil {
+				return fmt.Errorf("framer.WriteSettings: %w", err)
+			}
+			break
+		case device_utils.Browser_HTTPFingerprint_WINDOW_UPDATE:
+			err := cc.framer.WriteWindowUpdate(frame.StreamId, frame.Increment)
+			if err != nil {
+				return fmt.Errorf("framer.WriteWindowUpdate: %w", err)
+			}
+			break
+		case device_utils.Browser_HTTPFingerprint_PRIORITY:
+			err := cc.framer.WritePriority(frame.StreamId, PriorityParam(frame.Priority))
+			if err != nil {
+				return fmt.Errorf("framer.WritePriority: %w", err)
+			}
+			cc.skipStream(frame.StreamId)
+			break
+		default:
+			return fmt.Errorf("%w: %s", ErrFrameUnsupported, frame.Type)
+		}
+	}
+	return nil
+}
+
+// skipStream Streams used by PRIORITY frames are idle placeholders, requests have to open the ones after them
+func (cc *ClientConn) skipStream(streamID uint32) {
+	if streamID >= cc.nextStreamID {
+		cc.nextStreamID = streamID + 2
+	}
+}
+
+// RoundTrip Sends the request on a new stream and waits for the response headers
+func (cc *ClientConn) RoundTrip(req *http.Request) (*http.Response, error) {
+	cs := &clientStream{
+		req:  req,
+		resc: make(chan *http.Response, 1),
+	}
+	cs.body = newResponseBody(cc, cs)
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("io.ReadAll: %w", err)
+		}
+		req.ContentLength = int64(len(body))
+	}
+
+	err := cc.writeHeaders(cs, OrderHeaders(cc.fp, req), len(body) == 0)
+	if err != nil {
+		cc.forgetStream(cs.id)
+		return nil, fmt.Errorf("cc.writeHeaders: %w", err)
+	}
+	if len(body) > 0 {
+		err = cc.writeData(cs, body)
+		if err != nil {
+			cc.forgetStream(cs.id)
+			return nil, fmt.Errorf("cc.writeData: %w", err)
+		}
+	}
+
+	select {
+	case res := <-cs.resc:
+		if res == nil {
+			return nil, cs.body.err
+		}
+		return res, nil
+	case <-req.Context().Done():
+		cc.cancelStream(cs)
+		return nil, req.Context().Err()
+	}
+}
+
+// cancelStream Resets the stream if the server may still send on it and forgets it, data already in flight for it only
+// refills the connection window
+func (cc *ClientConn) cancelStream(cs *clientStream) {
+	if cc.forgetStream(cs.id) == nil {
+		return
+	}
+	cc.wMu.Lock()
+	_ = cc.framer.WriteRSTStream(cs.id, http2.ErrCodeCancel)
+	cc.wMu.Unlock()
+}
+
+// refill Returns the credit of n bytes the caller consumed, cs is nil for bytes that never reach a body. WINDOW_UPDATE frames
+// go out once half a window was consumed, like browsers batch them
+func (cc *ClientConn) refill(cs *clientStream, n int32) {
+	if n <= 0 {
+		return
+	}
+	connIncrement, streamIncrement := int32(0), int32(0)
+	cc.mu.Lock()
+	if cc.closed {
+		cc.mu.Unlock()
+		return
+	}
+	cc.connUnacked += n
+	if cc.connUnacked >= cc.connRecvWindow/2 {
+		connIncrement, cc.connUnacked = cc.connUnacked, 0
+		cc.connRecvAvailable += connIncrement
+	}
+	if cs != nil {
+		// Once the stream is forgotten the server won't send on it anymore
+		if _, open := cc.streams[cs.id]; open {
+			cs.unacked += n
+			if cs.unacked >= cc.initialRecvWindow/2 {
+				streamIncrement, cs.unacked = cs.unacked, 0
+				cs.recvAvailable += streamIncrement
+			}
+		}
+	}
+	cc.mu.Unlock()
+	if connIncrement == 0 && streamIncrement == 0 {
+		return
+	}
+
+	cc.wMu.Lock()
+	var err error
+	if connIncrement > 0 {
+		err = cc.framer.WriteWindowUpdate(0, uint32(connIncrement))
+	}
+	if err == nil && streamIncrement > 0 {
+		err = cc.framer.WriteWindowUpdate(cs.id, uint32(streamIncrement))
+	}
+	cc.wMu.Unlock()
+	if err != nil {
+		cc.closeWithError(fmt.Errorf("framer.WriteWindowUpdate: %w", err))
+	}
+}
+
+// Close Sends GOAWAY and closes the underlying connection
+func (cc *ClientConn) Close() error {
+	cc.wMu.Lock()
+	_ = cc.framer.WriteGoAway(0, http2.ErrCodeNo, nil)
+	cc.wMu.Unlock()
+	cc.closeWithError(ErrConnClosed)
+	return cc.conn.Close()
+}
+
+// CanTakeNewRequest Reports whether the connection is still usable
+func (cc *ClientConn) CanTakeNewRequest() bool {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return !cc.closed && cc.nextStreamID < 1<<31
+}
+
+// writeHeaders Opens the stream, it takes its ID under wMu because streams have to be opened in the order of their IDs
+func (cc *ClientConn) writeHeaders(cs *clientStream, headers []hpack.HeaderField, endStream bool) error {
+	cc.wMu.Lock()
+	defer cc.wMu.Unlock()
+
+	cc.hBuf.Reset()
+	for _, header := range headers {
+		err := cc.hEncoder.WriteField(header)
+		if err != nil {
+			return fmt.Errorf("hEncoder.WriteField: %w", err)
+		}
+	}
+	block := cc.hBuf.Bytes()
+
+	cc.mu.Lock()
+	if cc.closed {
+		cc.mu.Unlock()
+		return ErrConnClosed
+	}
+	cs.id = cc.nextStreamID
+	cs.sendWindow = cc.peerInitialWindow
+	cs.recvAvailable = cc.initialRecvWindow
+	cc.nextStreamID += 2
+	cc.streams[cs.id] = cs
+	maxFrameSize := int(cc.peerMaxFrameSize)
+	cc.mu.Unlock()
+
+	first := true
+	for first || len(block) > 0 {
+		chunk := block
+		if len(chunk) > maxFrameSize {
+			chunk = chunk[:maxFrameSize]
+		}
+		block = block[len(chunk):]
+		endHeaders := len(block) == 0
+		if first {
+			params := http2.HeadersFrameParam{
+				StreamID:      cs.id,
+				BlockFragment: chunk,
+				EndStream:     endStream,
+				EndHeaders:    endHeaders,
+			}
+			if cc.fp.GetHeaderFramePriority() != nil {
+				params.Priority = PriorityParam(cc.fp.HeaderFramePriority)
+			}
+			err := cc.framer.WriteHeaders(params)
+			if err != nil {
+				return fmt.Errorf("framer.WriteHeaders: %w", err)
+			}
+			first = false
+		} else {
+			err := cc.framer.WriteContinuation(cs.id, endHeaders, chunk)
+			if err != nil {
+				return fmt.Errorf("framer.WriteContinuation: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (cc *ClientConn) writeData(cs *clientStream, data []byte) error {
+	for len(data) > 0 {
+		cc.mu.Lock()
+		for !cc.closed && (cc.connSendWindow <= 0 || cs.sendWindow <= 0) {
+			cc.cond.Wait()
+		}
+		if cc.closed {
+			cc.mu.Unlock()
+			return cc.closeErr
+		}
+		size := len(data)
+		for _, limit := range []int{int(cc.peerMaxFrameSize), int(cc.connSendWindow), int(cs.sendWindow)} {
+			if size > limit {
+				size = limit
+			}
+		}
+		cc.connSendWindow -= int32(size)
+		cs.sendWindow -= int32(size)
+		cc.mu.Unlock()
+
+		cc.wMu.Lock()
+		err := cc.framer.WriteData(cs.id, size == len(data), data[:size])
+		cc.wMu.Unlock()
+		if err != nil {
+			return fmt.Errorf("framer.WriteData: %w", err)
+		}
+		data = data[size:]
+	}
+	return nil
+}
+
+func (cc *ClientConn) readLoop() {
+	for {
+		frame, err := cc.framer.ReadFrame()
+		if err != nil {
+			cc.closeWithError(fmt.Errorf("framer.ReadFrame: %w", err))
+			return
+		}
+
+		switch typedFrame := frame.(type) {
+		case *http2.SettingsFrame:
+			err = cc.handleSettings(typedFrame)
+			break
+		case *http2.PingFrame:
+			if !typedFrame.IsAck() {
+				cc.wMu.Lock()
+				err = cc.framer.WritePing(true, typedFrame.Data)
+				cc.wMu.Unlock()
+			}
+			break
+		case *http2.WindowUpdateFrame:
+			cc.mu.Lock()
+			if typedFrame.StreamID == 0 {
+				cc.connSendWindow += int32(typedFrame.Increment)
+			} else if cs, ok := cc.streams[typedFrame.StreamID]; ok {
+				cs.sendWindow += int32(typedFrame.Increment)
+			}
+			cc.cond.Broadcast()
+			cc.mu.Unlock()
+			break
+		case *http2.MetaHeadersFrame:
+			cc.handleHeaders(typedFrame)
+			break
+		case *http2.DataFrame:
+			err = cc.handleData(typedFrame)
+			break
+		case *http2.RSTStreamFrame:
+			cs := cc.forgetStream(typedFrame.StreamID)
+			if cs != nil {
+				cs.finish(fmt.Errorf("%w: %s", ErrStreamReset, typedFrame.ErrCode))
+			}
+			break
+		case *http2.GoAwayFrame:
+			cc.closeWithError(fmt.Errorf("%w: GOAWAY %s", ErrConnClosed, typedFrame.ErrCode))
+			return
+		}
+		if err != nil {
+			cc.closeWithError(err)
+			return
+		}
+	}
+}
+
+func (cc *ClientConn) handleSettings(frame *http2.SettingsFrame) error {
+	if frame.IsAck() {
+		return nil
+	}
+
+	headerTableSize := int64(-1)
+	cc.mu.Lock()
+	err := frame.ForeachSetting(func(setting http2.Setting) error {
+		switch setting.ID {
+		case http2.SettingMaxFrameSize:
+			cc.peerMaxFrameSize = setting.Val
+			break
+		case http2.SettingInitialWindowSize:
+			// src: https://datatracker.ietf.org/doc/html/rfc9113#section-6.9.2
+			delta := int32(setting.Val) - cc.peerInitialWindow
+			for _, cs := range cc.streams {
+				cs.sendWindow += delta
+			}
+			cc.peerInitialWindow = int32(setting.Val)
+			break
+		case http2.SettingHeaderTableSize:
+			headerTableSize = int64(setting.Val)
+			break
+		}
+		return nil
+	})
+	cc.cond.Broadcast()
+	cc.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("frame.ForeachSetting: %w", err)
+	}
+
+	// wMu is taken before mu everywhere else, so the encoder is only touched once mu is released
+	cc.wMu.Lock()
+	defer cc.wMu.Unlock()
+	if headerTableSize >= 0 {
+		cc.hEncoder.SetMaxDynamicTableSizeLimit(uint32(headerTableSize))
+	}
+	err = cc.framer.WriteSettingsAck()
+	if err != nil {
+		return fmt.Errorf("framer.WriteSettingsAck: %w", err)
+	}
+	return nil
+}
+
+func (cc *ClientConn) handleHeaders(frame *http2.MetaHeadersFrame) {
+	cc.mu.Lock()
+	cs, ok := cc.streams[frame.StreamID]
+	cc.mu.Unlock()
+	if !ok {
+		return
+	}
+	if cs.res != nil {
+		// The response was delivered already, so these are trailers. They are complete before the body ends with io.EOF,
+		// which is when http.Response documents them as readable
+		if cs.res.Trailer == nil {
+			cs.res.Trailer = make(http.Header, len(frame.Fields))
+		}
+		for _, field := range frame.RegularFields() {
+			key := http.CanonicalHeaderKey(field.Name)
+			cs.res.Trailer[key] = append(cs.res.Trailer[key], field.Value)
+		}
+		cc.forgetStream(cs.id)
+		cs.body.closeWithError(io.EOF)
+		return
+	}
+
+	status := frame.PseudoValue("status")
+	statusCode, err := strconv.Atoi(status)
+	if err != nil {
+		cc.forgetStream(cs.id)
+		cs.finish(fmt.Errorf("strconv.Atoi: %w", err))
+		return
+	}
+	if statusCode >= 100 && statusCode < 200 {
+		// Informational responses are followed by the real one
+		return
+	}
+
+	header := make(http.Header, len(frame.Fields))
+	for _, field := range frame.RegularFields() {
+		header.Add(field.Name, field.Value)
+	}
+	contentLength := int64(-1)
+	if value := header.Get("Content-Length"); value != "" {
+		contentLength, _ = strconv.ParseInt(value, 10, 64)
+	}
+	var trailer http.Header
+	for _, value := range header.Values("Trailer") {
+		for _, key := range strings.Split(value, ",") {
+			if key = strings.TrimSpace(key); key != "" {
+				if trailer == nil {
+					trailer = http.Header{}
+				}
+				trailer[http.CanonicalHeaderKey(key)] = nil
+			}
+		}
+	}
+	cs.res = &http.Response{
+		Status:        status + " " + http.StatusText(statusCode),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/2.0",
+		ProtoMajor:    2,
+		Header:        header,
+		Body:          cs.body,
+		ContentLength: contentLength,
+		Trailer:       trailer,
+		Request:       cs.req,
+	}
+	cs.resc <- cs.res
+	if frame.StreamEnded() {
+		cc.forgetStream(cs.id)
+		cs.body.closeWithError(io.EOF)
+	}
+}
+
+func (cc *ClientConn) handleData(frame *http2.DataFrame) error {
+	// The length includes the padding, which counts against the windows too
+	length := int32(frame.Length)
+	cc.mu.Lock()
+	if length > cc.connRecvAvailable {
+		cc.mu.Unlock()
+		return fmt.Errorf("%w: %d bytes on the connection with %d left", ErrFlowControl, length, cc.connRecvAvailable)
+	}
+	cc.connRecvAvailable -= length
+	cs, ok := cc.streams[frame.StreamID]
+	streamViolation := ok && length > cs.recvAvailable
+	if ok && !streamViolation {
+		cs.recvAvailable -= length
+	}
+	cc.mu.Unlock()
+	if !ok {
+		// Data of a stream that was reset or cancelled is dropped, but it still used up the connection window
+		cc.refill(nil, length)
+		return nil
+	}
+	if streamViolation {
+		cc.forgetStream(cs.id)
+		cc.wMu.Lock()
+		err := cc.framer.WriteRSTStream(cs.id, http2.ErrCodeFlowControl)
+		cc.wMu.Unlock()
+		cc.refill(nil, length)
+		cs.finish(fmt.Errorf("%w: %d bytes on stream %d", ErrFlowControl, length, cs.id))
+		if err != nil {
+			return fmt.Errorf("framer.WriteRSTStream: %w", err)
+		}
+		return nil
+	}
+
+	// The stream window caps what is buffered, it only grows again as the caller reads
+	cs.body.write(frame.Data())
+	cc.refill(cs, length-int32(len(frame.Data())))
+	if frame.StreamEnded() {
+		cc.forgetStream(cs.id)
+		cs.body.closeWithError(io.EOF)
+	}
+	return nil
+}
+
+func (cc *ClientConn) forgetStream(id uint32) *clientStream {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	cs := cc.streams[id]
+	delete(cc.streams, id)
+	return cs
+}
+
+func (cc *ClientConn) closeWithError(err error) {
+	cc.mu.Lock()
+	if cc.closed {
+		cc.mu.Unlock()
+		return
+	}
+	cc.closed = true
+	cc.closeErr = err
+	streams := cc.streams
+	cc.streams = map[uint32]*clientStream{}
+	cc.cond.Broadcast()
+	cc.mu.Unlock()
+
+	for _, cs := range streams {
+		cs.finish(err)
+	}
+}
+
+// finish Fails the stream, before the response headers this surfaces from RoundTrip and after them from the body
+func (cs *clientStream) finish(err error) {
+	cs.body.closeWithError(err)
+	select {
+	case cs.resc <- nil:
+	default:
+	}
+}
+
+type responseBody struct {
+	cc *ClientConn
+	cs *clientStream
+
+	mu   sync.Mutex
+	cond *sync.Cond
+	buf  bytes.Buffer
+	err  error
+}
+
+func newResponseBody(cc *ClientConn, cs *clientStream) *responseBody {
+	body := &responseBody{cc: cc, cs: cs}
+	body.cond = sync.NewCond(&body.mu)
+	return body
+}
+
+func (b *responseBody) write(data []byte) {
+	b.mu.Lock()
+	b.buf.Write(data)
+	b.cond.Broadcast()
+	b.mu.Unlock()
+}
+
+func (b *responseBody) closeWithError(err error) {
+	b.mu.Lock()
+	if b.err == nil {
+		b.err = err
+	}
+	b.cond.Broadcast()
+	b.mu.Unlock()
+}
+
+func (b *responseBody) Read(p []byte) (int, error) {
+	b.mu.Lock()
+	for b.buf.Len() == 0 && b.err == nil {
+		b.cond.Wait()
+	}
+	if b.buf.Len() == 0 {
+		err := b.err
+		b.mu.Unlock()
+		return 0, err
+	}
+	n, err := b.buf.Read(p)
+	b.mu.Unlock()
+	b.cc.refill(b.cs, int32(n))
+	return n, err
+}
+
+// Close Resets the stream if it is still open and drops what was buffered, so an abandoned body stops receiving data
+func (b *responseBody) Close() error {
+	b.mu.Lock()
+	buffered := b.buf.Len()
+	b.buf.Reset()
+	if b.err == nil || b.err == io.EOF {
+		b.err = ErrBodyClosed
+	}
+	b.cond.Broadcast()
+	b.mu.Unlock()
+
+	b.cc.cancelStream(b.cs)
+	b.cc.refill(nil, int32(buffered))
+	return nil
+}
+
+// Transport is a http.RoundTripper that keeps one fingerprinted ClientConn per host
+type Transport struct {
+	Fingerprint *device_utils.Browser_HTTPFingerprint
+	// DialTLSContext has to return a connection that negotiated h2, utls_adapter.UClient fits here to match the TLS layer too
+	DialTLSContext func(ctx context.Context, network, addr string) (net.Conn, error)
+
+	mu    sync.Mutex
+	conns map[string]*ClientConn
+	dials map[string]*dialCall
+}
+
+// dialCall Is a connection being dialed, requests to the same host wait for it instead of dialing their own
+type dialCall struct {
+	done chan struct{}
+	cc   *ClientConn
+	err  error
+}
+
+// NewTransport Creates a Transport for the HTTP fingerprint of the browser
+func NewTransport(browser *device_utils.Browser, dialTLSContext func(ctx context.Context, network, addr string) (net.Conn, error)) (*Transport, error) {
+	if browser.GetHttpFingerprint() == nil {
+		return nil, ErrNoHTTPFingerprint
+	}
+	if dialTLSContext == nil {
+		return nil, ErrNoDialer
+	}
+	return &Transport{
+		Fingerprint:    browser.HttpFingerprint,
+		DialTLSContext: dialTLSContext,
+		conns:          map[string]*ClientConn{},
+		dials:          map[string]*dialCall{},
+	}, nil
+}
+
+// RoundTrip Implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	cc, err := t.clientConn(req)
+	if err != nil {
+		return nil, fmt.Errorf("t.clientConn: %w", err)
+	}
+	return cc.RoundTrip(req)
+}
+
+// CloseIdleConnections Closes every connection the Transport holds
+func (t *Transport) CloseIdleConnections() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for addr, cc := range t.conns {
+		_ = cc.Close()
+		delete(t.conns, addr)
+	}
+}
+
+func (t *Transport) clientConn(req *http.Request) (*ClientConn, error) {
+	addr := req.URL.Host
+	if req.URL.Port() == "" {
+		addr = net.JoinHostPort(req.URL.Hostname(), "443")
+	}
+
+	t.mu.Lock()
+	if t.conns == nil {
+		t.conns = map[string]*ClientConn{}
+	}
+	if t.dials == nil {
+		t.dials = map[string]*dialCall{}
+	}
+	if cc, ok := t.conns[addr]; ok && cc.CanTakeNewRequest() {
+		t.mu.Unlock()
+		return cc, nil
+	}
+	call, dialing := t.dials[addr]
+	if !dialing {
+		call = &dialCall{done: make(chan struct{})}
+		t.dials[addr] = call
+	}
+	t.mu.Unlock()
+
+	if dialing {
+		select {
+		case <-call.done:
+			return call.cc, call.err
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	// The dial and the handshake happen outside of mu, so a slow host doesn't hold up requests to the others
+	call.cc, call.err = t.dial(req.Context(), addr)
+	t.mu.Lock()
+	delete(t.dials, addr)
+	if call.err == nil {
+		t.conns[addr] = call.cc
+	}
+	t.mu.Unlock()
+	close(call.done)
+	return call.cc, call.err
+}
+
+func (t *Transport) dial(ctx context.Context, addr string) (*ClientConn, error) {
+	if t.DialTLSContext == nil {
+		return nil, ErrNoDialer
+	}
+	conn, err := t.DialTLSContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("t.DialTLSContext: %w", err)
+	}
+	cc, err := NewClientConn(conn, t.Fingerprint)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("NewClientConn: %w", err)
+	}
+	return cc, nil
+}
+
+func strInSlice(slice []string, str string) bool {
+	for _, entry := range slice {
+		if entry == str {
+			return true
+		}
+	}
+	return false
+}
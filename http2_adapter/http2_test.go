@@ -0,0 +1,501 @@
+package http2_adapter
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	device_utils "github.com/BRUHItsABunny/go-device-utils"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+var firefoxFingerprint = &device_utils.Browser_HTTPFingerprint{
+	HeaderOrder:       []string{"user-agent", "accept", "accept-language", "accept-encoding"},
+	PseudoHeaderOrder: []string{":method", ":path", ":authority", ":scheme"},
+	SettingsFrame: &device_utils.Browser_HTTPFingerprint_SettingsFrameOpts{
+		HeaderTableSize:      65536,
+		EnablePush:           -1,
+		MaxConcurrentStreams: -1,
+		InitialWindowSize:    131072,
+		MaxFrameSize:         16384,
+		MaxHeaderListSize:    -1,
+	},
+	WindowUpdateIncrement: 12517377,
+	PriorityFrames: []*device_utils.Browser_HTTPFingerprint_PriorityFrameOpts{
+		{StreamId: 3, StreamDep: 0, Exclusive: false, Weight: 201},
+		{StreamId: 5, StreamDep: 0, Exclusive: false, Weight: 101},
+		{StreamId: 7, StreamDep: 0, Exclusive: false, Weight: 1},
+		{StreamId: 9, StreamDep: 7, Exclusive: false, Weight: 1},
+		{StreamId: 11, StreamDep: 3, Exclusive: false, Weight: 1},
+		{StreamId: 13, StreamDep: 0, Exclusive: false, Weight: 241},
+	},
+	HeaderFramePriority: &device_utils.Browser_HTTPFingerprint_PriorityFrameOpts{
+		StreamId: 15, StreamDep: 13, Exclusive: false, Weight: 42,
+	},
+}
+
+func TestNewClientConn(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	go func() {
+		cc, err := NewClientConn(client, firefoxFingerprint)
+		if err != nil {
+			return
+		}
+		req, _ := http.NewRequest(http.MethodGet, "https://example.com/", nil)
+		req.Header.Set("Accept", "*/*")
+		req.Header.Set("User-Agent", "Mozilla/5.0")
+		_, _ = cc.RoundTrip(req)
+	}()
+
+	preface := make([]byte, len(http2.ClientPreface))
+	_, err := io.ReadFull(server, preface)
+	if err != nil {
+		t.Fatal(err)
+	}
+	framer := http2.NewFramer(server, server)
+	frame, err := framer.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings := ""
+	_ = frame.(*http2.SettingsFrame).ForeachSetting(func(setting http2.Setting) error {
+		settings += fmt.Sprintf("%d:%d,", setting.ID, setting.Val)
+		return nil
+	})
+	if settings != "1:65536,4:131072,5:16384," {
+		t.Errorf("unexpected SETTINGS: %s", settings)
+	}
+	frame, err = framer.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if frame.(*http2.WindowUpdateFrame).Increment != 12517377 {
+		t.Errorf("unexpected WINDOW_UPDATE: %d", frame.(*http2.WindowUpdateFrame).Increment)
+	}
+	for _, expected := range firefoxFingerprint.PriorityFrames {
+		frame, err = framer.ReadFrame()
+		if err != nil {
+			t.Fatal(err)
+		}
+		priorityFrame := frame.(*http2.PriorityFrame)
+		if int64(priorityFrame.StreamID) != expected.StreamId || int32(priorityFrame.Weight)+1 != expected.Weight {
+			t.Errorf("unexpected PRIORITY: %d %d", priorityFrame.StreamID, priorityFrame.Weight)
+		}
+	}
+	frame, err = framer.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	headersFrame := frame.(*http2.HeadersFrame)
+	fmt.Println(headersFrame.StreamID, headersFrame.Priority)
+	if headersFrame.StreamID != 15 || headersFrame.Priority.StreamDep != 13 || headersFrame.Priority.Weight != 41 {
+		t.Errorf("unexpected HEADERS: %d %+v", headersFrame.StreamID, headersFrame.Priority)
+	}
+}
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, "%s %s %s", r.Proto, r.Method, r.Header.Get("User-Agent"))
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	browser := &device_utils.Browser{HttpFingerprint: firefoxFingerprint}
+	transport, err := NewTransport(browser, func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialer := &tls.Dialer{Config: &tls.Config{InsecureSkipVerify: true, NextProtos: []string{"h2"}}}
+		return dialer.DialContext(ctx, network, addr)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.CloseIdleConnections()
+
+	client := &http.Client{Transport: transport}
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		req.Header.Set("User-Agent", "Mozilla/5.0")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Println(resp.Status, string(body))
+		if string(body) != "HTTP/2.0 GET Mozilla/5.0" {
+			t.Errorf("unexpected body: %s", string(body))
+		}
+	}
+}
+
+func TestNewClientConnFrames(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	fp := &device_utils.Browser_HTTPFingerprint{
+		Frames: []*device_utils.Browser_HTTPFingerprint_Frame{
+			{
+				Type: device_utils.Browser_HTTPFingerprint_SETTINGS,
+				Settings: []*device_utils.Browser_HTTPFingerprint_Setting{
+					{Id: 4, Value: 6291456},
+					{Id: 1, Value: 65536},
+					{Id: 2570, Value: 1},
+				},
+			},
+			{Type: device_utils.Browser_HTTPFingerprint_WINDOW_UPDATE, Increment: 15663105},
+			{
+				Type:     device_utils.Browser_HTTPFingerprint_PRIORITY,
+				StreamId: 3,
+				Priority: &device_utils.Browser_HTTPFingerprint_PriorityFrameOpts{StreamId: 3, Weight: 201},
+			},
+			{Type: device_utils.Browser_HTTPFingerprint_HEADERS, StreamId: 5, Flags: 0x25},
+		},
+	}
+	go func() {
+		_, _ = NewClientConn(client, fp)
+	}()
+
+	preface := make([]byte, len(http2.ClientPreface))
+	_, err := io.ReadFull(server, preface)
+	if err != nil {
+		t.Fatal(err)
+	}
+	framer := http2.NewFramer(server, server)
+	frame, err := framer.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings := ""
+	_ = frame.(*http2.SettingsFrame).ForeachSetting(func(setting http2.Setting) error {
+		settings += fmt.Sprintf("%d:%d,", setting.ID, setting.Val)
+		return nil
+	})
+	if settings != "4:6291456,1:65536,2570:1," {
+		t.Errorf("unexpected SETTINGS: %s", settings)
+	}
+	frame, err = framer.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if frame.(*http2.WindowUpdateFrame).Increment != 15663105 {
+		t.Errorf("unexpected WINDOW_UPDATE: %d", frame.(*http2.WindowUpdateFrame).Increment)
+	}
+	frame, err = framer.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if frame.(*http2.PriorityFrame).StreamID != 3 || frame.(*http2.PriorityFrame).Weight != 200 {
+		t.Errorf("unexpected PRIORITY: %+v", frame)
+	}
+
+	// Edits to the SETTINGS fields replace the recorded SETTINGS frame
+	client, server = net.Pipe()
+	defer client.Close()
+	defer server.Close()
+	fp.SetOrderedSettings([]*device_utils.Browser_HTTPFingerprint_Setting{{Id: 4, Value: 6291456}, {Id: 1, Value: 65536}, {Id: 2570, Value: 1}})
+	fp.SetSetting(device_utils.SettingMaxConcurrentStreams, 1000)
+	go func() {
+		_, _ = NewClientConn(client, fp)
+	}()
+	if _, err = io.ReadFull(server, preface); err != nil {
+		t.Fatal(err)
+	}
+	framer = http2.NewFramer(server, server)
+	frame, err = framer.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings = ""
+	_ = frame.(*http2.SettingsFrame).ForeachSetting(func(setting http2.Setting) error {
+		settings += fmt.Sprintf("%d:%d,", setting.ID, setting.Val)
+		return nil
+	})
+	if settings != "3:1000,4:6291456,1:65536,2570:1," {
+		t.Errorf("unexpected SETTINGS: %s", settings)
+	}
+	frame, err = framer.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if frame.(*http2.WindowUpdateFrame).Increment != 15663105 {
+		t.Errorf("unexpected WINDOW_UPDATE: %d", frame.(*http2.WindowUpdateFrame).Increment)
+	}
+}
+
+// testFrame Is what the tests check of a frame the client sent, the framer reuses frames once the next one is read
+type testFrame struct {
+	Type      http2.FrameType
+	StreamID  uint32
+	Increment uint32
+	ErrCode   http2.ErrCode
+}
+
+func TestClientConnFlowControl(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	frames := make(chan testFrame, 64)
+	go func() {
+		preface := make([]byte, len(http2.ClientPreface))
+		if _, err := io.ReadFull(server, preface); err != nil {
+			return
+		}
+		framer := http2.NewFramer(server, server)
+		for {
+			frame, err := framer.ReadFrame()
+			if err != nil {
+				close(frames)
+				return
+			}
+			result := testFrame{Type: frame.Header().Type, StreamID: frame.Header().StreamID}
+			switch typedFrame := frame.(type) {
+			case *http2.WindowUpdateFrame:
+				result.Increment = typedFrame.Increment
+				break
+			case *http2.RSTStreamFrame:
+				result.ErrCode = typedFrame.ErrCode
+				break
+			}
+			frames <- result
+		}
+	}()
+	next := func(frameType http2.FrameType) testFrame {
+		for {
+			select {
+			case frame, ok := <-frames:
+				if !ok {
+					t.Fatalf("no %s frame", frameType)
+				}
+				if frame.Type == frameType {
+					return frame
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("no %s frame", frameType)
+			}
+		}
+	}
+
+	cc, err := NewClientConn(client, firefoxFingerprint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	framer := http2.NewFramer(server, server)
+	var block bytes.Buffer
+	encoder := hpack.NewEncoder(&block)
+	writeHeaders := func(streamID uint32, endStream bool, fields ...hpack.HeaderField) {
+		block.Reset()
+		for _, field := range fields {
+			_ = encoder.WriteField(field)
+		}
+		err := framer.WriteHeaders(http2.HeadersFrameParam{StreamID: streamID, BlockFragment: block.Bytes(), EndStream: endStream, EndHeaders: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	roundTrip := func() *http.Response {
+		result := make(chan *http.Response, 1)
+		go func() {
+			req, _ := http.NewRequest(http.MethodGet, "https://example.com/", nil)
+			res, _ := cc.RoundTrip(req)
+			result <- res
+		}()
+		streamID := next(http2.FrameHeaders).StreamID
+		writeHeaders(streamID, false, hpack.HeaderField{Name: ":status", Value: "200"}, hpack.HeaderField{Name: "trailer", Value: "grpc-status"})
+		return <-result
+	}
+
+	// A whole stream window of data is accepted, but credit only comes back as the body is read
+	res := roundTrip()
+	streamID := uint32(15)
+	chunk := make([]byte, defaultMaxFrameSize)
+	for sent := 0; sent < 131072; sent += len(chunk) {
+		if err = framer.WriteData(streamID, false, chunk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	select {
+	case frame := <-frames:
+		t.Errorf("unexpected frame before the body was read: %+v", frame)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if _, err = io.ReadFull(res.Body, make([]byte, 65536)); err != nil {
+		t.Fatal(err)
+	}
+	if frame := next(http2.FrameWindowUpdate); frame.StreamID != streamID || frame.Increment != 65536 {
+		t.Errorf("unexpected WINDOW_UPDATE: %+v", frame)
+	}
+	// Closing the abandoned body resets its stream
+	_ = res.Body.Close()
+	if frame := next(http2.FrameRSTStream); frame.StreamID != streamID || frame.ErrCode != http2.ErrCodeCancel {
+		t.Errorf("unexpected RST_STREAM: %+v", frame)
+	}
+
+	// HEADERS after the response are trailers
+	res = roundTrip()
+	streamID += 2
+	if err = framer.WriteData(streamID, false, []byte("ok")); err != nil {
+		t.Fatal(err)
+	}
+	writeHeaders(streamID, true, hpack.HeaderField{Name: "grpc-status", Value: "0"})
+	body, err := io.ReadAll(res.Body)
+	if err != nil || string(body) != "ok" {
+		t.Errorf("unexpected body: %q, %v", body, err)
+	}
+	if res.Trailer.Get("Grpc-Status") != "0" {
+		t.Errorf("unexpected trailer: %v", res.Trailer)
+	}
+}
+
+func TestClientConnStreamOrder(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	// The requests race for the connection, which needs them to run in parallel
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(8))
+	const rounds, requests = 16, 64
+	streamIDs := make(chan []uint32, 1)
+	go func() {
+		preface := make([]byte, len(http2.ClientPreface))
+		if _, err := io.ReadFull(server, preface); err != nil {
+			return
+		}
+		framer := http2.NewFramer(server, server)
+		var block bytes.Buffer
+		encoder := hpack.NewEncoder(&block)
+		result := make([]uint32, 0, rounds*requests)
+		for len(result) < rounds*requests {
+			frame, err := framer.ReadFrame()
+			if err != nil {
+				break
+			}
+			if frame.Header().Type != http2.FrameHeaders {
+				continue
+			}
+			result = append(result, frame.Header().StreamID)
+			block.Reset()
+			_ = encoder.WriteField(hpack.HeaderField{Name: ":status", Value: "200"})
+			_ = framer.WriteHeaders(http2.HeadersFrameParam{StreamID: frame.Header().StreamID, BlockFragment: block.Bytes(), EndStream: true, EndHeaders: true})
+		}
+		streamIDs <- result
+	}()
+
+	cc, err := NewClientConn(client, firefoxFingerprint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for round := 0; round < rounds; round++ {
+		var wg sync.WaitGroup
+		for i := 0; i < requests; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				req, _ := http.NewRequest(http.MethodGet, "https://example.com/", nil)
+				res, err := cc.RoundTrip(req)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				_ = res.Body.Close()
+			}()
+		}
+		wg.Wait()
+	}
+
+	// A stream ID lower than one already used is a PROTOCOL_ERROR, so HEADERS have to go out in the order of their IDs
+	select {
+	case result := <-streamIDs:
+		for i := 1; i < len(result); i++ {
+			if result[i] <= result[i-1] {
+				t.Errorf("stream %d opened after stream %d", result[i], result[i-1])
+			}
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("not every request reached the server")
+	}
+}
+
+func TestTransportDial(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "fast")
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	dialing, release := make(chan struct{}), make(chan struct{})
+	browser := &device_utils.Browser{HttpFingerprint: firefoxFingerprint}
+	transport, err := NewTransport(browser, func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if addr == "slow.invalid:443" {
+			close(dialing)
+			<-release
+			return nil, fmt.Errorf("%s is too slow", addr)
+		}
+		dialer := &tls.Dialer{Config: &tls.Config{InsecureSkipVerify: true, NextProtos: []string{"h2"}}}
+		return dialer.DialContext(ctx, network, addr)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.CloseIdleConnections()
+
+	slow := make(chan error, 1)
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "https://slow.invalid/", nil)
+		_, err := transport.RoundTrip(req)
+		slow <- err
+	}()
+	<-dialing
+
+	// A host that is still dialing doesn't hold up the others
+	done := make(chan string, 1)
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		res, err := transport.RoundTrip(req)
+		if err != nil {
+			done <- err.Error()
+			return
+		}
+		body, _ := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		done <- string(body)
+	}()
+	select {
+	case body := <-done:
+		if body != "fast" {
+			t.Errorf("unexpected body: %s", body)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("the request waited for the dial to another host")
+	}
+	close(release)
+	if err = <-slow; err == nil {
+		t.Error("expected the slow dial to fail")
+	}
+
+	// A zero value Transport has nothing to dial with
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	_, err = (&Transport{Fingerprint: firefoxFingerprint}).RoundTrip(req)
+	if !errors.Is(err, ErrNoDialer) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -1,9 +1,18 @@
 package device_utils
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/xml"
+	"errors"
 	"fmt"
 	"github.com/davecgh/go-spew/spew"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -40,6 +49,795 @@ func TestRandomDevices(t *testing.T) {
 	}
 }
 
+func TestCatalogConcurrency(t *testing.T) {
+	// The test cities are scattered around the US and would otherwise turn up in the tests that geocode
+	t.Cleanup(func() {
+		locationDBLock.Lock()
+		defer locationDBLock.Unlock()
+		for i := 0; i < 8; i++ {
+			unregisterCity("US", fmt.Sprintf("testcity%d", i))
+		}
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				simCard := GetRandomDBSIMCard("US")
+				simCard.Imei.Generate("", "")
+				location := GetRandomDBLocation("US")
+				RegisterLocation("US", fmt.Sprintf("testcity%d", i), location)
+				location.Latitude = 0
+				browser, err := GetDBBrowser("chrome", "111.0.5563.147")
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				browser.UserAgent = ""
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for _, simCard := range GetDBSIMCards("US") {
+		if simCard.Imei != nil {
+			t.Error("SIM card catalog entry was modified")
+		}
+	}
+	browser, _ := GetDBBrowser("chrome", "111.0.5563.147")
+	if browser.UserAgent == "" {
+		t.Error("Browser catalog entry was modified")
+	}
+}
+
+func TestWeightedSelection(t *testing.T) {
+	carriers := map[string]int{}
+	for i := 0; i < 10000; i++ {
+		carriers[GetRandomDBSIMCard("NI").Carrier]++
+	}
+	fmt.Println(carriers)
+	if carriers["Fix Line"] > 0 {
+		t.Error("a fixed line network was picked")
+	}
+	if carriers["Claro"] < carriers["Movistar"] || carriers["Movistar"] < carriers["Empresa Nicaraguense de Telecomunicaciones SA (ENITEL)"]*5 {
+		t.Error("carriers were not picked by subscriber share")
+	}
+	if simCard := GetRandomDBSIMCard("ni"); simCard.CountryISO != "NI" {
+		t.Error(fmt.Sprintf("lowercase country got: %s", simCard.CountryISO))
+	}
+	simCard := new(SIMCard)
+	simCard.Randomize("ni")
+	if simCard.CountryISO != "NI" {
+		t.Error(fmt.Sprintf("lowercase country got: %s", simCard.CountryISO))
+	}
+	// A country with only fixed line networks falls back to another country
+	RegisterSIMCard(&SIMCard{MCC: "901", MNC: "999", Carrier: "Fixed Line Only", CountryISO: "XA", CountryCode: "999"})
+	for i := 0; i < 100; i++ {
+		if simCard := GetRandomDBSIMCard("XA"); simCard.MNC == "999" {
+			t.Fatal("a fixed line network was picked")
+		}
+	}
+
+	cities := map[string]int{}
+	newYork, _ := GetDBCity("US", "newyorkcity")
+	miami, _ := GetDBCity("US", "miami")
+	for i := 0; i < 10000; i++ {
+		location := GetRandomDBLocation("US")
+		for _, city := range []*City{newYork, miami} {
+			if city.Location().DistanceTo(location) < city.Radius()+4*float64(location.HorizontalAccuracy) {
+				cities[city.Name]++
+			}
+		}
+	}
+	fmt.Println(cities)
+	if cities[newYork.Name] < cities[miami.Name]*5 {
+		t.Error("cities were not picked by population")
+	}
+
+	browsers := map[string]int{}
+	for i := 0; i < 10000; i++ {
+		browser, err := GetRandomDBBrowser("Safari", "brave")
+		if err != nil {
+			t.Fatal(err)
+		}
+		browsers[browser.Name]++
+	}
+	fmt.Println(browsers)
+	if browsers["chrome"] > 0 || browsers["safari"] < browsers["brave"]*20 {
+		t.Error("browsers were not picked by adoption")
+	}
+	if _, err := GetRandomDBBrowser("netscape"); !errors.Is(err, ErrBrowserUnsupported) {
+		t.Error(fmt.Sprintf("got %v, want %v", err, ErrBrowserUnsupported))
+	}
+
+	devices := map[string]int{}
+	for i := 0; i < 2000; i++ {
+		devices[GetRandomDevice("in").Build.Model]++
+	}
+	fmt.Println(devices)
+	if devices["HD1905"] < devices["ONEPLUS A5000"] {
+		t.Error("devices were not picked by market share")
+	}
+}
+
+func TestNewIdentity(t *testing.T) {
+	for _, countryISO := range []string{"US", "ca", "MX"} {
+		for i := 0; i < 100; i++ {
+			identity, err := NewIdentity(countryISO)
+			if err != nil {
+				t.Fatal(err)
+			}
+			country := strings.ToUpper(countryISO)
+			if identity.Locale.CountryISO != country || identity.SIMCard.CountryISO != country {
+				t.Fatal(fmt.Sprintf("%s: got locale %s and SIM card %s", country, identity.Locale.CountryISO, identity.SIMCard.CountryISO))
+			}
+			location, err := GetDBLocation(country, identity.City.Key)
+			if err != nil || location.DistanceTo(identity.Location) > identity.City.Radius()+4*float64(identity.Location.HorizontalAccuracy) {
+				t.Fatal(fmt.Sprintf("%s: %s is not a city of the country", country, identity.City.Key))
+			}
+			if _, err := time.LoadLocation(identity.Timezone.Name); err != nil || identity.Timezone.Name != identity.City.Timezone {
+				t.Fatal(fmt.Sprintf("%s: got timezone %s", country, identity.Timezone.Name))
+			}
+			if len(identity.SIMCard.PhoneNumber) != 10 || !IsNumeric(identity.SIMCard.PhoneNumber) || identity.SIMCard.MNC == "999" {
+				t.Fatal(fmt.Sprintf("%s: got SIM card %v", country, identity.SIMCard))
+			}
+		}
+	}
+
+	for _, countryISO := range []string{"GL", "XX"} {
+		if _, err := NewIdentity(countryISO); !errors.Is(err, ErrCountryUnsupported) {
+			t.Error(fmt.Sprintf("%s: got %v, want %v", countryISO, err, ErrCountryUnsupported))
+		}
+	}
+
+	device, _ := GetDBDevice("oneplus5")
+	if err := device.RandomizeForCountry("CA"); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(spew.Sdump(device))
+	if !strings.HasPrefix(device.Timezone.GetName(), "America/") || device.Locale.CountryISO != "CA" {
+		t.Error("the device was not moved to Canada")
+	}
+	for _, slot := range device.SimSlots {
+		if slot.CountryISO != "CA" || slot.Imei.TAC != "86463003" || len(slot.Imei.Imei) != 15 {
+			t.Error(fmt.Sprintf("got SIM card %v", slot))
+		}
+	}
+}
+
+func TestCityDatabase(t *testing.T) {
+	cities := 0
+	for _, countryISO := range AvailableCountries() {
+		for _, key := range AvailableCities(countryISO) {
+			city, err := GetDBCity(countryISO, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if city.Key != key || city.CountryISO != countryISO {
+				t.Error(fmt.Sprintf("%s: got city %s in %s", key, city.Key, city.CountryISO))
+			}
+			if city.Latitude < -90 || city.Latitude > 90 || city.Longitude < -180 || city.Longitude > 180 {
+				t.Error(fmt.Sprintf("%s: got coordinates %f, %f", key, city.Latitude, city.Longitude))
+			}
+			if _, err := time.LoadLocation(city.Timezone); city.Timezone != "" && err != nil {
+				t.Error(fmt.Sprintf("%s: %v", key, err))
+			}
+			cities++
+		}
+	}
+	fmt.Println(fmt.Sprintf("%d cities in %d countries", cities, len(AvailableCountries())))
+	if len(AvailableCountries()) < 200 {
+		t.Error("the catalog does not cover the world")
+	}
+
+	lookups := []struct {
+		CountryISO string
+		Name       string
+		Region     []string
+		Want       string
+	}{
+		{CountryISO: "US", Name: "newyorkcity", Want: "newyorkcity"},
+		{CountryISO: "us", Name: "NYC", Want: "newyorkcity"},
+		{CountryISO: "CA", Name: "Montreal", Want: "montreal"},
+		{CountryISO: "DE", Name: "münchen", Want: "munich"},
+		{CountryISO: "DE", Name: "Koln", Want: "cologne"},
+		{CountryISO: "UA", Name: "Kiev", Want: "kyiv"},
+		{CountryISO: "PL", Name: "Lodz", Want: "lodz"},
+		{CountryISO: "TR", Name: "izmir", Want: "izmir"},
+		{CountryISO: "US", Name: "Portland", Want: "portland"},
+		{CountryISO: "US", Name: "Portland", Region: []string{"maine"}, Want: "portlandmaine"},
+	}
+	for _, lookup := range lookups {
+		city, err := GetDBCity(lookup.CountryISO, lookup.Name, lookup.Region...)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if city.Key != lookup.Want {
+			t.Error(fmt.Sprintf("%s: got %s, want %s", lookup.Name, city.Key, lookup.Want))
+		}
+	}
+	if _, err := GetDBCity("US", "Portland", "Texas"); !errors.Is(err, ErrCityUnsupported) {
+		t.Error(fmt.Sprintf("got %v, want %v", err, ErrCityUnsupported))
+	}
+	if _, err := GetDBLocation("XX", "Atlantis"); !errors.Is(err, ErrCountryUnsupported) {
+		t.Error(fmt.Sprintf("got %v, want %v", err, ErrCountryUnsupported))
+	}
+
+	for i := 0; i < 1000; i++ {
+		city, err := GetRandomDBCity("AU", "Queensland")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if city.Region != "Queensland" {
+			t.Fatal(fmt.Sprintf("got %s in %s", city.Name, city.Region))
+		}
+	}
+}
+
+func TestReverseGeocode(t *testing.T) {
+	timezones := map[string]bool{}
+	for _, area := range timezoneAreas {
+		timezones[area.Timezone] = true
+	}
+	for timezone := range timezones {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			t.Error(err)
+		}
+	}
+
+	for _, countryISO := range AvailableCountries() {
+		for _, key := range AvailableCities(countryISO) {
+			city, _ := GetDBCity(countryISO, key)
+			place, err := ReverseGeocode(city.Location())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if place.CountryISO != countryISO || (city.Timezone != "" && place.Timezone != city.Timezone) {
+				t.Error(fmt.Sprintf("%s: got %s in %s, want %s in %s", key, place.Timezone, place.CountryISO, city.Timezone, countryISO))
+			}
+			if place.City == nil || place.City.CountryISO != countryISO || place.Distance > city.Location().DistanceTo(place.City.Location())+1 {
+				t.Error(fmt.Sprintf("%s: got nearest city %v", key, place.City))
+			}
+		}
+	}
+
+	borders := []struct {
+		Name       string
+		Location   *GPSLocation
+		CountryISO string
+		Timezone   string
+	}{
+		{Name: "Windsor", Location: &GPSLocation{Latitude: 42.3149, Longitude: -83.0364}, CountryISO: "CA", Timezone: "America/Toronto"},
+		{Name: "Detroit", Location: &GPSLocation{Latitude: 42.3314, Longitude: -83.0458}, CountryISO: "US", Timezone: "America/Detroit"},
+		{Name: "Jeffersonville", Location: &GPSLocation{Latitude: 38.2773, Longitude: -85.7386}, CountryISO: "US", Timezone: "America/Indiana/Indianapolis"},
+		{Name: "Louisville", Location: &GPSLocation{Latitude: 38.2527, Longitude: -85.7585}, CountryISO: "US", Timezone: "America/Kentucky/Louisville"},
+		{Name: "Brownsville", Location: &GPSLocation{Latitude: 25.92, Longitude: -97.49}, CountryISO: "US", Timezone: "America/Chicago"},
+		{Name: "Matamoros", Location: &GPSLocation{Latitude: 25.88, Longitude: -97.5}, CountryISO: "MX", Timezone: "America/Matamoros"},
+		{Name: "Kehl", Location: &GPSLocation{Latitude: 48.57, Longitude: 7.81}, CountryISO: "DE", Timezone: "Europe/Berlin"},
+		{Name: "Strasbourg", Location: &GPSLocation{Latitude: 48.57, Longitude: 7.75}, CountryISO: "FR", Timezone: "Europe/Paris"},
+		{Name: "Maastricht", Location: &GPSLocation{Latitude: 50.8514, Longitude: 5.6910}, CountryISO: "NL", Timezone: "Europe/Amsterdam"},
+		{Name: "Lanaken", Location: &GPSLocation{Latitude: 50.893, Longitude: 5.647}, CountryISO: "BE", Timezone: "Europe/Brussels"},
+		{Name: "mid-Atlantic", Location: &GPSLocation{Latitude: 35, Longitude: -35}, Timezone: "Etc/GMT+2"},
+		{Name: "Pacific", Location: &GPSLocation{Latitude: 0, Longitude: -140}, Timezone: "Etc/GMT+9"},
+	}
+	for _, border := range borders {
+		place, err := ReverseGeocode(border.Location)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if place.CountryISO != border.CountryISO || place.Timezone != border.Timezone {
+			t.Error(fmt.Sprintf("%s: got %s in %s, want %s in %s", border.Name, place.Timezone, place.CountryISO, border.Timezone, border.CountryISO))
+		}
+	}
+	if _, err := ReverseGeocode(&GPSLocation{Latitude: 91}); !errors.Is(err, ErrLocationOutOfRange) {
+		t.Error(fmt.Sprintf("got %v, want %v", err, ErrLocationOutOfRange))
+	}
+	if _, err := NewIdentityAt(&GPSLocation{Latitude: 35, Longitude: -35}); !errors.Is(err, ErrCountryUnsupported) {
+		t.Error(fmt.Sprintf("got %v, want %v", err, ErrCountryUnsupported))
+	}
+
+	device, _ := GetDBDevice("oneplus5")
+	if err := device.RandomizeForCountry("US"); err != nil {
+		t.Fatal(err)
+	}
+	windsor := borders[0].Location
+	if err := device.RelocateTo(windsor); err != nil {
+		t.Fatal(err)
+	}
+	if device.Locale.CountryISO != "CA" || device.Timezone.Name != "America/Toronto" || device.Location.DistanceTo(windsor) > 0 {
+		t.Error(fmt.Sprintf("got %s in %s at %v", device.Timezone.Name, device.Locale.CountryISO, device.Location))
+	}
+	for _, slot := range device.SimSlots {
+		if slot.CountryISO != "CA" || len(slot.PhoneNumber) != 10 {
+			t.Error(fmt.Sprintf("got SIM card %v", slot))
+		}
+	}
+	phoneNumber := device.SimSlots[0].PhoneNumber
+	if err := device.RelocateTo(&GPSLocation{Latitude: 43.6532, Longitude: -79.3832}); err != nil {
+		t.Fatal(err)
+	}
+	if device.SimSlots[0].PhoneNumber != phoneNumber || device.Timezone.Name != "America/Toronto" {
+		t.Error("moving within the country replaced the SIM card")
+	}
+}
+
+func TestTimezone(t *testing.T) {
+	berlin := &Timezone{Name: "Europe/Berlin"}
+	summer := time.Date(2024, time.July, 1, 12, 0, 0, 0, time.UTC)
+	winter := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	checks := []struct {
+		Timezone     *Timezone
+		At           time.Time
+		Offset       time.Duration
+		DST          bool
+		Abbreviation string
+		JSOffset     int
+	}{
+		{Timezone: berlin, At: summer, Offset: 2 * time.Hour, DST: true, Abbreviation: "CEST", JSOffset: -120},
+		{Timezone: berlin, At: winter, Offset: time.Hour, Abbreviation: "CET", JSOffset: -60},
+		{Timezone: &Timezone{Name: "America/New_York"}, At: summer, Offset: -4 * time.Hour, DST: true, Abbreviation: "EDT", JSOffset: 240},
+		{Timezone: &Timezone{Name: "Asia/Kolkata"}, At: winter, Offset: 330 * time.Minute, Abbreviation: "IST", JSOffset: -330},
+		{Timezone: &Timezone{Name: "Australia/Sydney"}, At: winter, Offset: 11 * time.Hour, DST: true, Abbreviation: "AEDT", JSOffset: -660},
+		// Moscow kept summer time all year between 2011 and 2014
+		{Timezone: &Timezone{Name: "Europe/Moscow"}, At: time.Date(2013, time.January, 1, 12, 0, 0, 0, time.UTC), Offset: 4 * time.Hour, Abbreviation: "MSK", JSOffset: -240},
+	}
+	for _, check := range checks {
+		offset, err := check.Timezone.Offset(check.At)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dst, _ := check.Timezone.IsDST(check.At)
+		abbreviation, _ := check.Timezone.Abbreviation(check.At)
+		jsOffset, _ := check.Timezone.JSOffset(check.At)
+		if offset != check.Offset || dst != check.DST || abbreviation != check.Abbreviation || jsOffset != check.JSOffset {
+			t.Error(fmt.Sprintf("%s at %s: got %s, %t, %s, %d", check.Timezone.Name, check.At, offset, dst, abbreviation, jsOffset))
+		}
+	}
+	if observes, _ := berlin.ObservesDST(2024); !observes {
+		t.Error("Europe/Berlin does not observe DST")
+	}
+	if observes, _ := (&Timezone{Name: "Asia/Tokyo"}).ObservesDST(2024); observes {
+		t.Error("Asia/Tokyo observes DST")
+	}
+
+	typo := &Timezone{Name: "Europe/Berln"}
+	if _, err := typo.Offset(); !errors.Is(err, ErrTimezoneUnsupported) {
+		t.Error(fmt.Sprintf("got %v, want %v", err, ErrTimezoneUnsupported))
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("MustGoLocation fell back instead of panicking")
+			}
+		}()
+		typo.MustGoLocation()
+	}()
+
+	if zones := TimezonesForCountry("au"); !slices.Contains(zones, "Australia/Perth") || !slices.Contains(zones, "Australia/Sydney") || slices.Contains(zones, "Pacific/Auckland") {
+		t.Error(fmt.Sprintf("got %v", zones))
+	}
+	if zones := TimezonesForCountry("FR"); len(zones) == 0 || !sort.StringsAreSorted(zones) {
+		t.Error(fmt.Sprintf("got %v", zones))
+	}
+	for _, countryISO := range []string{"US", "DE", "RU", "BR"} {
+		for _, key := range AvailableCities(countryISO) {
+			city, _ := GetDBCity(countryISO, key)
+			if city.Timezone != "" && !slices.Contains(TimezonesForCountry(countryISO), city.Timezone) {
+				t.Error(fmt.Sprintf("%s: %s is not a zone of %s", key, city.Timezone, countryISO))
+			}
+		}
+	}
+	if city, _ := GetDBCity("US", "Louisville"); city.DominantTimezone() != "America/Kentucky/Louisville" {
+		t.Error(fmt.Sprintf("got %s", city.DominantTimezone()))
+	}
+
+	strasbourg := &GPSLocation{Latitude: 48.5734, Longitude: 7.7521}
+	if err := (&Timezone{Name: "Europe/Paris"}).Validate(strasbourg); err != nil {
+		t.Error(err)
+	}
+	if err := (&Timezone{Name: "Asia/Calcutta"}).Validate(&GPSLocation{Latitude: 19.076, Longitude: 72.8777}); err != nil {
+		t.Error(err)
+	}
+	if err := (&Timezone{Name: "America/New_York"}).Validate(strasbourg); !errors.Is(err, ErrTimezoneMismatch) {
+		t.Error(fmt.Sprintf("got %v, want %v", err, ErrTimezoneMismatch))
+	}
+	device, _ := GetDBDevice("oneplus5")
+	if err := device.RandomizeForCountry("DE"); err != nil {
+		t.Fatal(err)
+	}
+	if err := device.ValidateTimezone(); err != nil {
+		t.Error(err)
+	}
+	device.Timezone = &Timezone{Name: "Asia/Tokyo"}
+	if err := device.ValidateTimezone(); !errors.Is(err, ErrTimezoneMismatch) {
+		t.Error(fmt.Sprintf("got %v, want %v", err, ErrTimezoneMismatch))
+	}
+}
+
+func TestLocale(t *testing.T) {
+	parses := []struct {
+		Input         string
+		LanguageTag   string
+		AndroidString string
+	}{
+		{Input: "en", LanguageTag: "en", AndroidString: "en"},
+		{Input: "EN_us", LanguageTag: "en-US", AndroidString: "en_US"},
+		{Input: "zh-Hant-TW", LanguageTag: "zh-Hant-TW", AndroidString: "zh_TW_#Hant"},
+		{Input: "zh_TW_#Hant", LanguageTag: "zh-Hant-TW", AndroidString: "zh_TW_#Hant"},
+		{Input: "sr-latn-rs", LanguageTag: "sr-Latn-RS", AndroidString: "sr_RS_#Latn"},
+		{Input: "he-IL", LanguageTag: "he-IL", AndroidString: "iw_IL"},
+		{Input: "in_ID", LanguageTag: "id-ID", AndroidString: "in_ID"},
+		{Input: "es-419", LanguageTag: "es-419", AndroidString: "es_419"},
+		{Input: "ca-ES-valencia", LanguageTag: "ca-ES-valencia", AndroidString: "ca_ES_valencia"},
+		{Input: "th-TH-u-nu-thai", LanguageTag: "th-TH-u-nu-thai", AndroidString: "th_TH_#u-nu-thai"},
+		{Input: "ja-JP-u-hc-h12-ca-japanese", LanguageTag: "ja-JP-u-ca-japanese-hc-h12", AndroidString: "ja_JP_#u-ca-japanese-hc-h12"},
+		{Input: "ja-JP-x-private-u-ca-japanese", LanguageTag: "ja-JP-x-private-u-ca-japanese", AndroidString: "ja_JP_#x-private-u-ca-japanese"},
+		{Input: "de-DE-u-nu-latn-ca-gregory-t-en", LanguageTag: "de-DE-t-en-u-ca-gregory-nu-latn", AndroidString: "de_DE_#t-en-u-ca-gregory-nu-latn"},
+		{Input: "en-US-x-Twain", LanguageTag: "en-US-x-twain", AndroidString: "en_US_#x-twain"},
+	}
+	for _, parse := range parses {
+		locale, err := LocaleFromLocaleString(parse.Input)
+		if err != nil {
+			t.Error(fmt.Sprintf("%s: %v", parse.Input, err))
+			continue
+		}
+		if locale.ToLanguageTag() != parse.LanguageTag || locale.ToAndroidString() != parse.AndroidString {
+			t.Error(fmt.Sprintf("%s: got %s and %s, want %s and %s", parse.Input, locale.ToLanguageTag(), locale.ToAndroidString(), parse.LanguageTag, parse.AndroidString))
+		}
+	}
+	for _, input := range []string{"", "e", "en-US-a", "1234", "en-US-US", "en-u"} {
+		if _, err := LocaleFromLocaleString(input); !errors.Is(err, ErrLocaleFormatUnsupported) {
+			t.Error(fmt.Sprintf("%q: got %v, want %v", input, err, ErrLocaleFormatUnsupported))
+		}
+	}
+
+	locale, _ := ParseLocale("ar-EG-u-nu-arab-ca-islamic")
+	if locale.Language != "ar" || locale.CountryISO != "EG" || locale.Calendar != "islamic" || locale.NumberingSystem != "arab" {
+		t.Error(fmt.Sprintf("got %v", locale))
+	}
+	data, err := locale.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := new(Locale)
+	if err = decoded.UnmarshalVT(data); err != nil || decoded.ToLanguageTag() != locale.ToLanguageTag() || decoded.SizeVT() != len(data) {
+		t.Error(fmt.Sprintf("got %v, want %v", decoded, locale))
+	}
+
+	handmade := &Locale{Language: "SR", Script: "LATN", CountryISO: "rs", Keywords: []string{"hc-h23"}, Calendar: "Gregory"}
+	if err := handmade.Canonicalize(); err != nil || handmade.ToLanguageTag() != "sr-Latn-RS-u-ca-gregory-hc-h23" {
+		t.Error(fmt.Sprintf("got %s, %v", handmade.ToLanguageTag(), err))
+	}
+
+	german, _ := ParseLocale("de-DE")
+	swiss, _ := ParseLocale("fr-CH")
+	chrome := &Browser{UserAgent: "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Mobile Safari/537.36"}
+	chrome.SetLocales(german, swiss)
+	if strings.Join(chrome.Languages, ",") != "de-DE,de,fr-CH,fr,en-US,en" || chrome.Language != "de-DE" {
+		t.Error(fmt.Sprintf("got %v", chrome.Languages))
+	}
+	if chrome.AcceptLanguage() != "de-DE,de;q=0.9,fr-CH;q=0.8,fr;q=0.7,en-US;q=0.6,en;q=0.5" {
+		t.Error(fmt.Sprintf("got %s", chrome.AcceptLanguage()))
+	}
+	firefox := &Browser{UserAgent: "Mozilla/5.0 (Android 14; Mobile; rv:125.0) Gecko/125.0 Firefox/125.0"}
+	firefox.SetLocales(german)
+	if firefox.AcceptLanguage() != "de,en-US;q=0.7,en;q=0.3" {
+		t.Error(fmt.Sprintf("got %s", firefox.AcceptLanguage()))
+	}
+	british, _ := ParseLocale("en_GB")
+	if languages := PreferredLanguages(BrowserFamilyChromium, british); strings.Join(languages, ",") != "en-GB,en" {
+		t.Error(fmt.Sprintf("got %v", languages))
+	}
+}
+
+func TestGeodesic(t *testing.T) {
+	// src: https://geographiclib.sourceforge.io/cgi-bin/GeodSolve, Flinders Peak to Buninyong
+	flindersPeak := &GPSLocation{Latitude: -37.95103341666667, Longitude: 144.42486788888888}
+	buninyong := &GPSLocation{Latitude: -37.65282113888889, Longitude: 143.92649552777777}
+	distance, err := flindersPeak.VincentyDistanceTo(buninyong)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(distance-54972.271) > 0.01 {
+		t.Error(fmt.Sprintf("got Vincenty distance %f, want 54972.271", distance))
+	}
+	if haversine := flindersPeak.DistanceTo(buninyong); math.Abs(haversine-distance) > distance*0.005 {
+		t.Error(fmt.Sprintf("got haversine distance %f, want about %f", haversine, distance))
+	}
+	if _, err := (&GPSLocation{}).VincentyDistanceTo(&GPSLocation{Latitude: 0.5, Longitude: 179.7}); !errors.Is(err, ErrGeodesicNoConvergence) {
+		t.Error(fmt.Sprintf("got %v, want %v", err, ErrGeodesicNoConvergence))
+	}
+
+	bearing := flindersPeak.BearingTo(buninyong)
+	destination := flindersPeak.Destination(bearing, flindersPeak.DistanceTo(buninyong))
+	if destination.DistanceTo(buninyong) > 0.01 {
+		t.Error(fmt.Sprintf("got destination %v, want %v", destination, buninyong))
+	}
+	if east := (&GPSLocation{}).BearingTo(&GPSLocation{Longitude: 1}); math.Abs(east-90) > 1e-9 {
+		t.Error(fmt.Sprintf("got bearing %f, want 90", east))
+	}
+
+	center := &GPSLocation{Latitude: 40.712775, Longitude: -74.005973, Altitude: 10.44}
+	seen := map[float64]bool{}
+	for i := 0; i < 1000; i++ {
+		location := center.RandomLocationWithin(5000)
+		if center.DistanceTo(location) > 5000.001 || location.Altitude < 0 || seen[location.Latitude] {
+			t.Fatal(fmt.Sprintf("got %v", location))
+		}
+		seen[location.Latitude] = true
+	}
+
+	// Basel and El Paso are a few kilometers from the border, their scatter must not cross it
+	for _, name := range [][2]string{{"CH", "Basel"}, {"US", "El Paso"}} {
+		city, err := GetDBCity(name[0], name[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 500; i++ {
+			location := city.RandomLocation()
+			place, err := ReverseGeocode(location)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if place.CountryISO != city.CountryISO {
+				t.Fatal(fmt.Sprintf("%s: got %v in %s", city.Name, location, place.CountryISO))
+			}
+		}
+	}
+
+	// A 2 by 2 degree square with a 1 by 1 degree hole in the middle
+	polygon := []byte(`{"type": "Feature", "properties": {}, "geometry": {"type": "Polygon", "coordinates": [
+		[[10, 10], [12, 10], [12, 12], [10, 12], [10, 10]],
+		[[10.5, 10.5], [11.5, 10.5], [11.5, 11.5], [10.5, 11.5], [10.5, 10.5]]
+	]}}`)
+	for i := 0; i < 1000; i++ {
+		location, err := RandomLocationInPolygon(polygon)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inSquare := location.Longitude >= 10 && location.Longitude <= 12 && location.Latitude >= 10 && location.Latitude <= 12
+		inHole := location.Longitude > 10.5 && location.Longitude < 11.5 && location.Latitude > 10.5 && location.Latitude < 11.5
+		if !inSquare || inHole {
+			t.Fatal(fmt.Sprintf("got %v", location))
+		}
+	}
+	for _, data := range []string{`{"type": "Point", "coordinates": [1, 2]}`, `{"type": "Polygon", "coordinates": [[[1, 2]]]}`, `[`} {
+		if _, err := RandomLocationInPolygon([]byte(data)); !errors.Is(err, ErrGeoJSONMalformed) {
+			t.Error(fmt.Sprintf("%s: got %v, want %v", data, err, ErrGeoJSONMalformed))
+		}
+	}
+}
+
+func TestLocationFix(t *testing.T) {
+	location := &GPSLocation{Latitude: 40.712775, Longitude: -74.005973}
+	provider, accuracy := location.ProviderString(), location.Accuracy()
+	for i := 0; i < 10; i++ {
+		if location.ProviderString() != provider || location.Accuracy() != accuracy {
+			t.Fatal("reads of the same location disagree")
+		}
+	}
+
+	medians := map[GPSLocation_LocationProvider]float64{}
+	for _, provider := range []GPSLocation_LocationProvider{GPSLocation_GPS, GPSLocation_NETWORK, GPSLocation_FUSED, GPSLocation_PASSIVE} {
+		accuracies := []float64{}
+		within := 0
+		for i := 0; i < 2000; i++ {
+			center := &GPSLocation{Latitude: 40.712775, Longitude: -74.005973, Provider: provider}
+			fix := center.RandomFix()
+			if fix.Provider != provider || fix.HorizontalAccuracy <= 0 || fix.Speed < 0 || fix.Bearing < 0 || fix.Bearing >= 360 {
+				t.Fatal(fmt.Sprintf("got fix %v", fix))
+			}
+			age := time.Since(time.UnixMilli(fix.Timestamp))
+			if age < 0 || age > 5*time.Minute+time.Second || fix.ElapsedRealtimeNanos <= 0 {
+				t.Fatal(fmt.Sprintf("got fix %v", fix))
+			}
+			if provider == GPSLocation_NETWORK && (fix.VerticalAccuracy != 0 || fix.Speed != 0) {
+				t.Fatal(fmt.Sprintf("got network fix %v", fix))
+			}
+			if center.DistanceTo(fix) <= float64(fix.HorizontalAccuracy) {
+				within++
+			}
+			accuracies = append(accuracies, float64(fix.HorizontalAccuracy))
+		}
+		sort.Float64s(accuracies)
+		medians[provider] = accuracies[len(accuracies)/2]
+		// The accuracy is the radius of 68% confidence
+		if within < 1200 || within > 1520 {
+			t.Error(fmt.Sprintf("%s: %d of 2000 fixes within their accuracy", provider, within))
+		}
+	}
+	fmt.Println(medians)
+	if medians[GPSLocation_GPS] >= medians[GPSLocation_FUSED] || medians[GPSLocation_FUSED] >= medians[GPSLocation_NETWORK] {
+		t.Error("providers are not ordered by accuracy")
+	}
+
+	booted := &GPSLocation{Provider: GPSLocation_GPS}
+	booted.GenerateFix(time.Second)
+	if booted.ElapsedRealtimeNanos > int64(time.Second) {
+		t.Error(fmt.Sprintf("got elapsed realtime %d after a second of uptime", booted.ElapsedRealtimeNanos))
+	}
+}
+
+func TestTrajectory(t *testing.T) {
+	origin := &GPSLocation{Latitude: 52.370216, Longitude: 4.895168, Altitude: 2}
+	destination := &GPSLocation{Latitude: 52.379189, Longitude: 4.899431, Altitude: 5}
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	for _, profile := range []*SpeedProfile{WalkingProfile(), DrivingProfile()} {
+		trajectory, err := NewTrajectory(&TrajectoryOptions{Origin: origin, Destination: destination, Profile: profile, Start: start, Uptime: time.Hour})
+		if err != nil {
+			t.Fatal(err)
+		}
+		fixes := []*GPSLocation{}
+		for fix := range trajectory.Fixes() {
+			fixes = append(fixes, fix)
+			if len(fixes) > 10000 {
+				t.Fatal("the device never arrived")
+			}
+		}
+		last := fixes[len(fixes)-1]
+		seconds := float64(len(fixes) - 1)
+		// Slower than the cruising speed for the halts, but not by much more than them
+		if speed := origin.DistanceTo(destination) / seconds; speed > profile.MaxSpeed || speed < profile.Speed/4 {
+			t.Error(fmt.Sprintf("travelled at %f m/s", speed))
+		}
+		if last.DistanceTo(destination) > 50 || last.Timestamp != start.Add(time.Duration(seconds)*time.Second).UnixMilli() || last.ElapsedRealtimeNanos != int64(time.Hour)+int64(seconds)*int64(time.Second) {
+			t.Error(fmt.Sprintf("got last fix %v", last))
+		}
+		for i, fix := range fixes[1:] {
+			if fix.Provider != GPSLocation_GPS || fix.HorizontalAccuracy <= 0 || fix.Bearing < 0 || fix.Bearing >= 360 || fix.Speed > float32(profile.MaxSpeed)+2 {
+				t.Fatal(fmt.Sprintf("got fix %v", fix))
+			}
+			// The error drifts, consecutive fixes don't jump by more than the device moved
+			if jump := fixes[i].DistanceTo(fix); jump > profile.MaxSpeed+15 {
+				t.Fatal(fmt.Sprintf("fix %d jumped %f meters", i+1, jump))
+			}
+		}
+	}
+
+	walk, err := NewTrajectory(&TrajectoryOptions{Origin: origin, Radius: 200, Duration: 30 * time.Minute, Interval: 5 * time.Second, Profile: WalkingProfile()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	for fix := range walk.Fixes() {
+		count++
+		if origin.DistanceTo(fix) > 300 {
+			t.Fatal(fmt.Sprintf("wandered off to %v", fix))
+		}
+	}
+	if count != 361 {
+		t.Error(fmt.Sprintf("got %d fixes, want 361", count))
+	}
+	if _, err := NewTrajectory(&TrajectoryOptions{Origin: origin, Profile: WalkingProfile()}); !errors.Is(err, ErrTrajectoryInvalid) {
+		t.Error(fmt.Sprintf("got %v, want %v", err, ErrTrajectoryInvalid))
+	}
+
+	trajectory, _ := NewTrajectory(&TrajectoryOptions{Origin: origin, Duration: time.Minute, Profile: DrivingProfile()})
+	fixes := slices.Collect(trajectory.Fixes())
+	nmea, gpx := new(strings.Builder), new(strings.Builder)
+	if err := WriteNMEA(nmea, slices.Values(fixes)); err != nil {
+		t.Fatal(err)
+	}
+	if parsed, err := ParseNMEA(strings.NewReader(nmea.String())); err != nil || len(parsed) != len(fixes) {
+		t.Error(fmt.Sprintf("got %d NMEA fixes for %d fixes: %v", len(parsed), len(fixes), err))
+	}
+	if err := WriteGPX(gpx, slices.Values(fixes)); err != nil {
+		t.Fatal(err)
+	}
+	parsed := struct {
+		Points []struct {
+			Latitude float64 `xml:"lat,attr"`
+			Time     string  `xml:"time"`
+		} `xml:"trk>trkseg>trkpt"`
+	}{}
+	if err := xml.Unmarshal([]byte(gpx.String()), &parsed); err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed.Points) != len(fixes) || parsed.Points[0].Latitude != fixes[0].Latitude {
+		t.Error(fmt.Sprintf("got GPX %s", gpx.String()))
+	}
+}
+
+func TestNMEA(t *testing.T) {
+	fixes := []*GPSLocation{
+		{Latitude: 48.1173, Longitude: 11.516666667, Altitude: 545.4, Provider: GPSLocation_GPS, HorizontalAccuracy: 4.5, VerticalAccuracy: 7, Speed: 11.524, Bearing: 84.4, Timestamp: time.Date(1994, 3, 23, 12, 35, 19, 0, time.UTC).UnixMilli()},
+		{Latitude: -33.8688, Longitude: 151.2093, Altitude: 39, Provider: GPSLocation_NETWORK, HorizontalAccuracy: 900, Timestamp: time.Date(2024, 6, 1, 3, 0, 0, 250*int(time.Millisecond), time.UTC).UnixMilli()},
+		{Latitude: 40.712775, Longitude: -74.005973, Altitude: 10.44, Provider: GPSLocation_FUSED, HorizontalAccuracy: 2.1, Timestamp: time.Date(2024, 6, 1, 3, 0, 1, 0, time.UTC).UnixMilli()},
+		{Latitude: 40.712775, Longitude: -74.005973, Provider: GPSLocation_GPS, Timestamp: time.Date(2024, 6, 1, 3, 0, 2, 0, time.UTC).UnixMilli()},
+	}
+	golden, err := os.ReadFile("./_resources/samples/nmea_fixes.nmea")
+	if err != nil {
+		t.Fatal(err)
+	}
+	written := new(strings.Builder)
+	if err := WriteNMEA(written, slices.Values(fixes)); err != nil {
+		t.Fatal(err)
+	}
+	if written.String() != string(golden) {
+		t.Error(fmt.Sprintf("got\n%s", written.String()))
+	}
+
+	// 2D fixes have no altitude and network fixes use no satellites
+	if gga := fixes[1].NMEA()[1]; !strings.Contains(gga, ",6,00,300.0,,M,") {
+		t.Error(fmt.Sprintf("network fix got %s", gga))
+	}
+	if gsa := fixes[2].NMEA()[2]; !strings.HasPrefix(gsa, "$GPGSA,A,2,25,02,19,,") {
+		t.Error(fmt.Sprintf("2D fix got %s", gsa))
+	}
+
+	// Back without the fix that has none, to the precision of the sentences
+	parsed, err := ParseNMEA(bytes.NewReader(golden))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed) != 3 {
+		t.Fatal(fmt.Sprintf("got %d fixes, want 3", len(parsed)))
+	}
+	for i, fix := range parsed {
+		want := fixes[i]
+		altitude := roundTo(want.Altitude, 1)
+		if want.VerticalAccuracy <= 0 {
+			altitude = 0
+		}
+		if fix.DistanceTo(want) > 0.05 || fix.Altitude != altitude || fix.Timestamp != want.Timestamp || math.Abs(float64(fix.Speed-want.Speed)) > 0.01 || fix.Bearing != want.Bearing {
+			t.Error(fmt.Sprintf("fix %d: got %v, want %v", i, fix, want))
+		}
+		if math.Abs(float64(fix.HorizontalAccuracy-want.HorizontalAccuracy)) > 0.15 || math.Abs(float64(fix.VerticalAccuracy-want.VerticalAccuracy)) > 0.15 {
+			t.Error(fmt.Sprintf("fix %d: got accuracies %f and %f, want %f and %f", i, fix.HorizontalAccuracy, fix.VerticalAccuracy, want.HorizontalAccuracy, want.VerticalAccuracy))
+		}
+	}
+	if parsed[1].Provider != GPSLocation_NETWORK {
+		t.Error(fmt.Sprintf("got provider %s for a network fix", parsed[1].Provider))
+	}
+
+	satellites := fixes[0].Constellation()
+	used := 0
+	for _, satellite := range satellites {
+		if satellite.Elevation < 5 || satellite.Elevation > 90 || satellite.Azimuth < 0 || satellite.Azimuth >= 360 || satellite.SNR < 15 || satellite.SNR > 50 {
+			t.Error(fmt.Sprintf("got satellite %v", satellite))
+		}
+		if satellite.Used {
+			used++
+		}
+	}
+	if len(satellites) < 6 || len(satellites) > 14 || used < 4 {
+		t.Error(fmt.Sprintf("got %d satellites with %d used", len(satellites), used))
+	}
+
+	// A multi-GNSS receiver log that crosses midnight, with an epoch without a fix and sentences the parser skips
+	receiver, err := os.Open("./_resources/samples/nmea_receiver.nmea")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer receiver.Close()
+	parsed, err = ParseNMEA(receiver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wants := []*GPSLocation{
+		{Latitude: 52.370216, Longitude: 4.895168, Altitude: 49.6, Provider: GPSLocation_GPS, HorizontalAccuracy: 3.06, VerticalAccuracy: 4.62, Speed: 0.263, Timestamp: time.Date(2023, 12, 31, 23, 59, 58, 0, time.UTC).UnixMilli()},
+		{Latitude: 52.370225, Longitude: 4.895184, Altitude: 49.7, Provider: GPSLocation_GPS, HorizontalAccuracy: 3.06, VerticalAccuracy: 4.62, Speed: 2.696, Bearing: 37.25, Timestamp: time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC).UnixMilli()},
+		{Latitude: 52.37025, Longitude: 4.895217, Altitude: 49.8, Provider: GPSLocation_GPS, HorizontalAccuracy: 2.4, Timestamp: time.Date(2024, 1, 1, 0, 0, 1, 500*int(time.Millisecond), time.UTC).UnixMilli()},
+	}
+	if len(parsed) != len(wants) {
+		t.Fatal(fmt.Sprintf("got %d fixes, want %d", len(parsed), len(wants)))
+	}
+	for i, fix := range parsed {
+		want := wants[i]
+		if fix.DistanceTo(want) > 0.1 || fix.Altitude != want.Altitude || fix.Provider != want.Provider || fix.HorizontalAccuracy != want.HorizontalAccuracy || fix.VerticalAccuracy != want.VerticalAccuracy || fix.Speed != want.Speed || fix.Bearing != want.Bearing || fix.Timestamp != want.Timestamp {
+			t.Error(fmt.Sprintf("fix %d: got %v, want %v", i, fix, want))
+		}
+	}
+
+	for _, log := range []string{"$GPGGA,123519,4807.038,N,01131.000,E,1,08,0.9,545.4,M,46.9,M,,*48", "GPGGA,123519", "$GPRMC,123519,A,4807.038,X,01131.000,E,022.4,084.4,230394,003.1,W*6A"} {
+		if _, err := ParseNMEA(strings.NewReader(log)); !errors.Is(err, ErrNMEAMalformed) {
+			t.Error(fmt.Sprintf("%s: got %v, want %v", log, err, ErrNMEAMalformed))
+		}
+	}
+}
+
 func TestMACGeneration(t *testing.T) {
 	// When looking up the result of this MAC it should give us "OnePlus Electronics (Shenzhen) Co., Ltd." for OUI "A091A2"
 	mac := &MAC{
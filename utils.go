@@ -31,8 +31,51 @@ func randomStrSlice(strSlice []string) string {
 	return strSlice[rand.Intn(len(strSlice))]
 }
 
-func randomSIMSlice(strSlice []*SIMCard) *SIMCard {
-	return strSlice[rand.Intn(len(strSlice))]
+// randomWeighted Picks an index with a probability proportional to its weight, uniformly if no weight is positive
+func randomWeighted(weights []float64) int {
+	total := 0.0
+	for _, weight := range weights {
+		if weight > 0 {
+			total += weight
+		}
+	}
+	if total <= 0 {
+		return rand.Intn(len(weights))
+	}
+
+	target := rand.Float64() * total
+	last := 0
+	for i, weight := range weights {
+		if weight <= 0 {
+			continue
+		}
+		target -= weight
+		if target < 0 {
+			return i
+		}
+		last = i
+	}
+	// Rounding can leave a sliver of the total unassigned
+	return last
+}
+
+// fillMissingWeights Gives entries without a known weight, marked as negative, the smallest known one so they stay possible but rare
+func fillMissingWeights(weights []float64) []float64 {
+	smallest := -1.0
+	for _, weight := range weights {
+		if weight > 0 && (smallest < 0 || weight < smallest) {
+			smallest = weight
+		}
+	}
+	if smallest < 0 {
+		smallest = 1
+	}
+	for i, weight := range weights {
+		if weight < 0 {
+			weights[i] = smallest
+		}
+	}
+	return weights
 }
 
 func removeAllNONHex(r rune) rune {
@@ -1,6 +1,9 @@
 package device_utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"math/rand"
 	"sort"
 	"strconv"
@@ -113,3 +116,152 @@ func (fp *Browser_TLSFingerprint) FormatTLSFingerprint(strict ...bool) string {
 
 	return strings.Join(result, ",")
 }
+
+// FormatJA4 Formats the fingerprint as a JA4 over TCP
+// src: https://github.com/FoxIO-LLC/ja4/blob/main/technical_details/JA4.md
+func (fp *Browser_TLSFingerprint) FormatJA4() string {
+	version := fp.Version
+	sni := "i"
+	alpn := "00"
+	signatureAlgorithms := []string{}
+	for _, data := range fp.ExtensionData {
+		for _, supportedVersion := range data.GetSupportedVersions().GetVersions() {
+			if supportedVersion > version {
+				version = supportedVersion
+			}
+		}
+		protocols := data.GetApplicationLayerProtocolNegotiation().GetProtocols()
+		if len(protocols) > 0 && len(protocols[0]) > 0 {
+			alpn = protocols[0][:1] + protocols[0][len(protocols[0])-1:]
+		}
+		for _, signatureAlgorithm := range data.GetSignatureAlgorithms().GetSupportedSignatureAlgorithms() {
+			signatureAlgorithms = append(signatureAlgorithms, fmt.Sprintf("%04x", int(signatureAlgorithm)))
+		}
+	}
+	versionStr, ok := ja4Versions[version]
+	if !ok {
+		versionStr = "00"
+	}
+
+	cipherSuites := make([]string, len(fp.CipherSuites))
+	for i, cipherSuite := range fp.CipherSuites {
+		cipherSuites[i] = fmt.Sprintf("%04x", int(cipherSuite))
+	}
+	sort.Strings(cipherSuites)
+
+	// SNI and ALPN are counted but left out of the hash since they are already in the first section
+	extensions := make([]string, 0, len(fp.Extensions))
+	for _, extension := range fp.Extensions {
+		switch extension {
+		case Browser_TLSFingerprint_SERVER_NAME:
+			sni = "d"
+			break
+		case Browser_TLSFingerprint_APPLICATION_LAYER_PROTOCOL_NEGOTIATION:
+			break
+		default:
+			extensions = append(extensions, fmt.Sprintf("%04x", int(extension)))
+			break
+		}
+	}
+	sort.Strings(extensions)
+	cipherSuitesHash := ja4EmptyHash
+	if len(cipherSuites) > 0 {
+		cipherSuitesHash = ja4Hash(strings.Join(cipherSuites, ","))
+	}
+	extensionsHash := ja4EmptyHash
+	if len(extensions) > 0 {
+		extensionsStr := strings.Join(extensions, ",")
+		if len(signatureAlgorithms) > 0 {
+			extensionsStr += "_" + strings.Join(signatureAlgorithms, ",")
+		}
+		extensionsHash = ja4Hash(extensionsStr)
+	}
+
+	return fmt.Sprintf("t%s%s%02d%02d%s_%s_%s", versionStr, sni, min(len(fp.CipherSuites), 99), min(len(fp.Extensions), 99), alpn, cipherSuitesHash, extensionsHash)
+}
+
+const ja4EmptyHash = "000000000000"
+
+var ja4Versions = map[Browser_TLSFingerprint_ProtocolVersion]string{
+	Browser_TLSFingerprint_TLS1_3: "13",
+	Browser_TLSFingerprint_TLS1_2: "12",
+	Browser_TLSFingerprint_TLS1_1: "11",
+	Browser_TLSFingerprint_TLS1:   "10",
+}
+
+// ja4Hash Truncated SHA256 of the input
+func ja4Hash(input string) string {
+	hash := sha256.Sum256([]byte(input))
+	return hex.EncodeToString(hash[:])[:12]
+}
+
+// FormatPeetprint Formats the fingerprint like tls.peet.ws does, grease puts GREASE values where BoringSSL sends them
+func (fp *Browser_TLSFingerprint) FormatPeetprint(grease ...bool) string {
+	withGREASE := len(grease) > 0 && grease[0]
+	greaseStart := []string{}
+	if withGREASE {
+		greaseStart = append(greaseStart, "GREASE")
+	}
+
+	versions := append([]string{}, greaseStart...)
+	protocols := []string{}
+	groups := append([]string{}, greaseStart...)
+	signatureAlgorithms := []string{}
+	pskModes := []string{}
+	compressionAlgorithms := []string{}
+	hasGroups := false
+	for _, data := range fp.ExtensionData {
+		for _, version := range data.GetSupportedVersions().GetVersions() {
+			versions = append(versions, mustString(int(version)))
+		}
+		for _, protocol := range data.GetApplicationLayerProtocolNegotiation().GetProtocols() {
+			protocols = append(protocols, strings.TrimPrefix(strings.TrimPrefix(protocol, "http/"), "h"))
+		}
+		if data.GetSupportedGroups() != nil {
+			hasGroups = true
+			for _, group := range data.SupportedGroups.Groups {
+				groups = append(groups, mustString(int(group)))
+			}
+		}
+		for _, signatureAlgorithm := range data.GetSignatureAlgorithms().GetSupportedSignatureAlgorithms() {
+			signatureAlgorithms = append(signatureAlgorithms, mustString(int(signatureAlgorithm)))
+		}
+		for _, mode := range data.GetPskKeyExchangeModes().GetModes() {
+			pskModes = append(pskModes, mustString(int(mode)))
+		}
+		for _, algorithm := range data.GetCompressCertificate().GetAlgorithms() {
+			compressionAlgorithms = append(compressionAlgorithms, mustString(int(algorithm)))
+		}
+	}
+	if !hasGroups {
+		for _, curve := range fp.EllipticCurves {
+			groups = append(groups, mustString(int(curve)))
+		}
+	}
+
+	cipherSuites := append([]string{}, greaseStart...)
+	for _, cipherSuite := range fp.CipherSuites {
+		cipherSuites = append(cipherSuites, mustString(int(cipherSuite)))
+	}
+
+	// Extensions are sorted as text, the GREASE extensions go last
+	extensions := make([]string, 0, len(fp.Extensions)+2)
+	for _, extension := range fp.Extensions {
+		extensions = append(extensions, mustString(int(extension)))
+	}
+	sort.Strings(extensions)
+	if withGREASE {
+		extensions = append(extensions, "GREASE", "GREASE")
+	}
+
+	return strings.Join([]string{
+		strings.Join(versions, "-"),
+		strings.Join(protocols, "-"),
+		strings.Join(groups, "-"),
+		strings.Join(signatureAlgorithms, "-"),
+		strings.Join(pskModes, "-"),
+		strings.Join(compressionAlgorithms, "-"),
+		strings.Join(cipherSuites, "-"),
+		strings.Join(extensions, "-"),
+	}, "|")
+}
@@ -55,12 +55,13 @@ func DeviceFromUserAgent(userAgent string) (*AndroidDevice, error) {
 	for _, elem := range strings.Split(deviceStr, "; ") {
 		if strings.Contains(elem, "Android ") {
 			device.Version, err = AndroidVersionFromVersionString(strings.Split(elem, " ")[1])
-		} else if strings.Contains(elem, "-") {
-			device.Locale, err = LocaleFromLocaleString(elem)
 		} else if strings.Contains(elem, "Build/") {
+			// Before the locale, models like SM-G930F have a dash too
 			parts := strings.Split(elem, " Build/")
 			device.Build.Model = parts[0]
 			device.Build.Id = parts[1]
+		} else if strings.Contains(elem, "-") {
+			device.Locale, err = LocaleFromLocaleString(elem)
 		}
 
 		if err != nil {
@@ -0,0 +1,165 @@
+package device_utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Identity Is a locale, SIM card, location and timezone that all belong to the same country
+type Identity struct {
+	Locale   *Locale
+	SIMCard  *SIMCard
+	Location *GPSLocation
+	Timezone *Timezone
+	City     *City
+}
+
+// NewIdentity Generates an identity within countryISO, the error wraps ErrCountryUnsupported and names the missing data if the
+// catalogs can't cover the country without borrowing from another one
+func NewIdentity(countryISO string) (*Identity, error) {
+	countryISO = strings.ToUpper(countryISO)
+
+	plan, ok := phoneNumberPlans[countryISO]
+	if !ok {
+		return nil, fmt.Errorf("%w: no phone number plan for %s", ErrCountryUnsupported, countryISO)
+	}
+	city, ok := getRandomDBCity(countryISO, len(plan.Prefixes) == 0)
+	if !ok {
+		return nil, fmt.Errorf("%w: no cities with a timezone and area codes for %s", ErrCountryUnsupported, countryISO)
+	}
+	return newIdentity(countryISO, city, city.RandomLocation(), city.Timezone)
+}
+
+// NewIdentityAt Generates an identity for a device at the location, the country and timezone are the ones ReverseGeocode finds
+// and the phone number is one of the nearest city. The error wraps ErrCountryUnsupported out at sea and like NewIdentity
+func NewIdentityAt(location *GPSLocation) (*Identity, error) {
+	place, err := ReverseGeocode(location)
+	if err != nil {
+		return nil, fmt.Errorf("NewIdentityAt: %w", err)
+	}
+	if len(place.CountryISO) == 0 {
+		return nil, fmt.Errorf("NewIdentityAt: %w: no country at %f, %f", ErrCountryUnsupported, location.Latitude, location.Longitude)
+	}
+
+	plan, ok := phoneNumberPlans[place.CountryISO]
+	if !ok {
+		return nil, fmt.Errorf("NewIdentityAt: %w: no phone number plan for %s", ErrCountryUnsupported, place.CountryISO)
+	}
+	city, ok := nearestDBCity(place.CountryISO, location, func(city *City) bool {
+		return len(plan.Prefixes) > 0 || len(cityAreaCodes[city.CountryISO][city.Key]) > 0
+	})
+	if !ok {
+		return nil, fmt.Errorf("NewIdentityAt: %w: no cities with area codes for %s", ErrCountryUnsupported, place.CountryISO)
+	}
+	result, err := newIdentity(place.CountryISO, city, proto.Clone(location).(*GPSLocation), place.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("NewIdentityAt: %w", err)
+	}
+	return result, nil
+}
+
+// newIdentity Picks the language, carrier and phone number of an identity in the city
+func newIdentity(countryISO string, city *dbCity, location *GPSLocation, timezone string) (*Identity, error) {
+	languages, ok := countryLanguages[countryISO]
+	if !ok {
+		return nil, fmt.Errorf("%w: no languages for %s", ErrCountryUnsupported, countryISO)
+	}
+	simCard, ok := getRandomDBMobileSIMCard(countryISO)
+	if !ok {
+		return nil, fmt.Errorf("%w: no mobile carriers for %s", ErrCountryUnsupported, countryISO)
+	}
+	plan := phoneNumberPlans[countryISO]
+	prefixes := plan.Prefixes
+	if len(city.AreaCodes) > 0 {
+		prefixes = city.AreaCodes
+	}
+
+	weights := make([]float64, len(languages))
+	for i, language := range languages {
+		weights[i] = language.Share
+	}
+	simCard.Imei = new(SIMCard_IMEI)
+	simCard.PhoneNumber = plan.generate(randomStrSlice(prefixes))
+
+	return &Identity{
+		Locale: &Locale{
+			Language:   languages[randomWeighted(weights)].Language,
+			CountryISO: countryISO,
+		},
+		SIMCard:  simCard,
+		Location: location,
+		Timezone: &Timezone{Name: timezone},
+		City:     city.City,
+	}, nil
+}
+
+// Apply Moves the device into the identity, the SIM card goes into every slot while keeping the TAC of the slot
+func (identity *Identity) Apply(device *AndroidDevice) {
+	device.Locale = proto.Clone(identity.Locale).(*Locale)
+	device.Location = proto.Clone(identity.Location).(*GPSLocation)
+	device.Timezone = proto.Clone(identity.Timezone).(*Timezone)
+	if len(device.SimSlots) == 0 {
+		device.SimSlots = []*SIMCard{{}}
+	}
+	for i, slot := range device.SimSlots {
+		simCard := proto.Clone(identity.SIMCard).(*SIMCard)
+		simCard.Imei = &SIMCard_IMEI{TAC: slot.GetImei().GetTAC()}
+		simCard.Imei.Generate("", "")
+		device.SimSlots[i] = simCard
+	}
+}
+
+// RandomizeForCountry Is Randomize with every country bound piece of the device generated within countryISO, see NewIdentity
+func (device *AndroidDevice) RandomizeForCountry(countryISO string) error {
+	identity, err := NewIdentity(countryISO)
+	if err != nil {
+		return fmt.Errorf("AndroidDevice.RandomizeForCountry: %w", err)
+	}
+	device.Id = NewAndroidID()
+	identity.Apply(device)
+	if device.MacAddress == nil {
+		device.MacAddress = new(MAC)
+	}
+	device.MacAddress.Generate("", false, true)
+	return nil
+}
+
+// RelocateTo Moves the device to the location, with the locale, timezone and SIM cards of the country it is in, see
+// NewIdentityAt. Moving within the country of the locale and the SIM cards keeps both
+func (device *AndroidDevice) RelocateTo(location *GPSLocation) error {
+	identity, err := NewIdentityAt(location)
+	if err != nil {
+		return fmt.Errorf("AndroidDevice.RelocateTo: %w", err)
+	}
+	sameCountry := device.GetLocale().GetCountryISO() == identity.Locale.CountryISO && len(device.SimSlots) > 0
+	for _, slot := range device.SimSlots {
+		sameCountry = sameCountry && slot.GetCountryISO() == identity.Locale.CountryISO
+	}
+	if !sameCountry {
+		identity.Apply(device)
+		return nil
+	}
+	device.Location = identity.Location
+	device.Timezone = identity.Timezone
+	return nil
+}
+
+// generate Fills the national significant number up from prefix with random digits
+func (plan *phoneNumberPlan) generate(prefix string) string {
+	for {
+		result := prefix
+		for len(result) < plan.Length {
+			if plan.NANP && len(result) == 3 {
+				result += strconv.Itoa(randomInt(2, 10))
+				continue
+			}
+			result += strconv.Itoa(randomInt(0, 10))
+		}
+		if !plan.NANP || result[3:6] != "555" {
+			return result
+		}
+	}
+}
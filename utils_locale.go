@@ -2,6 +2,8 @@ package device_utils
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -10,7 +12,25 @@ var (
 	ErrLocaleUnsupported       = errors.New("the supplied locale is unsupported")
 )
 
+// androidLanguages Are the codes java.util.Locale kept for renamed languages, Android still reports them in Locale.toString()
+var androidLanguages = map[string]string{
+	"he": "iw",
+	"id": "in",
+	"yi": "ji",
+}
+
+// firefoxRegionalLanguages Are the languages Firefox ships regional builds of, the others advertise the bare language
+var firefoxRegionalLanguages = map[string]bool{
+	"en": true,
+	"es": true,
+	"pt": true,
+	"zh": true,
+}
+
 func (locale *Locale) ToLocale(separator string, iso bool) string {
+	if len(locale.CountryISO) == 0 {
+		return locale.Language
+	}
 	result := locale.Language + separator + locale.GetCountry(iso)
 	return result
 }
@@ -23,35 +43,314 @@ func (locale *Locale) GetCountry(iso bool) string {
 	}
 }
 
-func fromSlice(parts []string) (*Locale, error) {
+// LocaleFromLocaleString Parses a locale like "en_US", "en-US", "zh-Hant-TW", "sr_RS_#Latn" or a bare "en", see ParseLocale
+func LocaleFromLocaleString(localeStr string) (*Locale, error) {
+	result, err := ParseLocale(localeStr)
+	if err != nil {
+		return nil, fmt.Errorf("LocaleFromLocaleString: %w", err)
+	}
+	return result, nil
+}
+
+func isAlpha(subtag string) bool {
+	for _, r := range subtag {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return len(subtag) > 0
+}
+
+func isAlphanumeric(subtag string) bool {
+	for _, r := range subtag {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return len(subtag) > 0
+}
+
+// isVariant Is 5 to 8 alphanumerics or 4 starting with a digit, by RFC 5646
+func isVariant(subtag string) bool {
+	return isAlphanumeric(subtag) && (len(subtag) >= 5 && len(subtag) <= 8 || len(subtag) == 4 && subtag[0] >= '0' && subtag[0] <= '9')
+}
+
+// ParseLocale Parses a BCP 47 language tag, or the java.util.Locale.toString() form Android logs, into a canonical locale:
+// lowercase language and variants, titlecase script, uppercase region and sorted extensions. Renamed languages keep the code
+// Android has for them, so "he" becomes "iw". The error wraps ErrLocaleFormatUnsupported
+// src: https://www.rfc-editor.org/rfc/rfc5646.html
+func ParseLocale(tag string) (*Locale, error) {
+	subtags := strings.FieldsFunc(strings.ReplaceAll(tag, "_#", "-"), func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+	if len(subtags) == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrLocaleFormatUnsupported, tag)
+	}
+
 	result := new(Locale)
-	if len(parts) == 2 {
-		_, ok := stringsAreNotNull(parts...)
-		if ok {
-			result.Language = strings.ToLower(parts[0])
-			result.CountryISO = strings.ToUpper(parts[1])
+	language := strings.ToLower(subtags[0])
+	if !isAlpha(language) || len(language) < 2 || len(language) > 8 || len(language) == 4 {
+		return nil, fmt.Errorf("%w: %q has no language", ErrLocaleFormatUnsupported, tag)
+	}
+	if language != "und" {
+		result.Language = language
+	}
+	if android, ok := androidLanguages[language]; ok {
+		result.Language = android
+	}
+
+	// Script, region and variants by their shape, in any order since the form of java.util.Locale puts the script last
+	i := 1
+	for ; i < len(subtags) && len(subtags[i]) > 1; i++ {
+		subtag := subtags[i]
+		switch {
+		case len(subtag) == 4 && isAlpha(subtag) && len(result.Script) == 0:
+			result.Script = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+			break
+		case (len(subtag) == 2 && isAlpha(subtag) || len(subtag) == 3 && IsNumeric(subtag)) && len(result.CountryISO) == 0:
+			result.CountryISO = strings.ToUpper(subtag)
+			break
+		case isVariant(subtag):
+			result.Variants = append(result.Variants, strings.ToLower(subtag))
+			break
+		default:
+			return nil, fmt.Errorf("%w: %q has an unexpected subtag %q", ErrLocaleFormatUnsupported, tag, subtag)
+		}
+	}
+
+	for i < len(subtags) {
+		singleton := strings.ToLower(subtags[i])
+		if !isAlphanumeric(singleton) {
+			return nil, fmt.Errorf("%w: %q has an unexpected subtag %q", ErrLocaleFormatUnsupported, tag, singleton)
+		}
+		end := i + 1
+		for end < len(subtags) && (singleton == "x" || len(subtags[end]) > 1) {
+			end++
+		}
+		values := make([]string, 0, end-i-1)
+		for _, subtag := range subtags[i+1 : end] {
+			if !isAlphanumeric(subtag) || len(subtag) > 8 {
+				return nil, fmt.Errorf("%w: %q has an unexpected subtag %q", ErrLocaleFormatUnsupported, tag, subtag)
+			}
+			values = append(values, strings.ToLower(subtag))
+		}
+		if len(values) == 0 {
+			return nil, fmt.Errorf("%w: %q has an empty extension %q", ErrLocaleFormatUnsupported, tag, singleton)
+		}
+		if singleton == "u" {
+			result.parseUnicodeExtension(values)
 		} else {
-			return result, ErrLocaleFormatUnsupported
+			result.Extensions = append(result.Extensions, singleton+"-"+strings.Join(values, "-"))
 		}
-	} else {
-		return result, ErrLocaleFormatUnsupported
+		i = end
 	}
+	sortKeywords(result.Keywords)
+	sort.SliceStable(result.Extensions, func(a, b int) bool {
+		// Private use goes last whatever its singleton sorts as
+		if result.Extensions[a][0] == 'x' || result.Extensions[b][0] == 'x' {
+			return result.Extensions[b][0] == 'x' && result.Extensions[a][0] != 'x'
+		}
+		return result.Extensions[a] < result.Extensions[b]
+	})
 	return result, nil
 }
 
-func LocaleFromLocaleString(localeStr string) (*Locale, error) {
-	// Support en_US and en-US
-	var err error
-
-	if strings.Contains(localeStr, "-") {
-		parts := strings.Split(localeStr, "-")
-		return fromSlice(parts)
-	} else if strings.Contains(localeStr, "_") {
-		parts := strings.Split(localeStr, "_")
-		return fromSlice(parts)
-	} else {
-		err = ErrLocaleFormatUnsupported
+// parseUnicodeExtension Splits the -u- extension into its attributes and keywords, a keyword of type "true" is its bare key
+// src: https://unicode.org/reports/tr35/#Unicode_locale_identifier
+func (locale *Locale) parseUnicodeExtension(subtags []string) {
+	for i := 0; i < len(subtags); {
+		if len(subtags[i]) != 2 {
+			// An attribute
+			locale.Keywords = append(locale.Keywords, subtags[i])
+			i++
+			continue
+		}
+		end := i + 1
+		for end < len(subtags) && len(subtags[end]) > 2 {
+			end++
+		}
+		key, value := subtags[i], strings.Join(subtags[i+1:end], "-")
+		switch {
+		case key == "ca" && len(value) > 0:
+			locale.Calendar = value
+			break
+		case key == "nu" && len(value) > 0:
+			locale.NumberingSystem = value
+			break
+		case len(value) == 0 || value == "true":
+			locale.Keywords = append(locale.Keywords, key)
+			break
+		default:
+			locale.Keywords = append(locale.Keywords, key+"-"+value)
+			break
+		}
+		i = end
+	}
+}
+
+// sortKeywords Sorts the keywords of a -u- extension by key, after its attributes which keep their order
+func sortKeywords(keywords []string) {
+	key := func(keyword string) string {
+		return strings.SplitN(keyword, "-", 2)[0]
+	}
+	sort.SliceStable(keywords, func(a, b int) bool {
+		attributeA, attributeB := len(key(keywords[a])) != 2, len(key(keywords[b])) != 2
+		if attributeA != attributeB {
+			return attributeA
+		}
+		return !attributeA && key(keywords[a]) < key(keywords[b])
+	})
+}
+
+// Canonicalize Rewrites the locale in the canonical form of ParseLocale, for locales that were filled by hand
+func (locale *Locale) Canonicalize() error {
+	result, err := ParseLocale(locale.ToLanguageTag())
+	if err != nil {
+		return fmt.Errorf("Locale.Canonicalize: %w", err)
+	}
+	locale.Language, locale.Script, locale.CountryISO, locale.Variants = result.Language, result.Script, result.CountryISO, result.Variants
+	locale.Calendar, locale.NumberingSystem, locale.Keywords, locale.Extensions = result.Calendar, result.NumberingSystem, result.Keywords, result.Extensions
+	return nil
+}
+
+// BCPLanguage Is the language as BCP 47 has it, so "he" where Android keeps "iw", "und" if the locale has none
+func (locale *Locale) BCPLanguage() string {
+	language := strings.ToLower(locale.GetLanguage())
+	for bcp, android := range androidLanguages {
+		if language == android {
+			return bcp
+		}
+	}
+	if len(language) == 0 {
+		return "und"
+	}
+	return language
+}
+
+// extensionString Is the extensions in tag order, the -u- one with its keywords sorted by key, private use last
+func (locale *Locale) extensionString() string {
+	keywords := append([]string{}, locale.Keywords...)
+	if len(locale.Calendar) > 0 {
+		keywords = append(keywords, "ca-"+locale.Calendar)
+	}
+	if len(locale.NumberingSystem) > 0 {
+		keywords = append(keywords, "nu-"+locale.NumberingSystem)
+	}
+	sortKeywords(keywords)
+
+	extensions := []string{}
+	private := ""
+	unicode := ""
+	if len(keywords) > 0 {
+		unicode = "u-" + strings.Join(keywords, "-")
+	}
+	for _, extension := range locale.Extensions {
+		if strings.HasPrefix(extension, "x-") {
+			private = extension
+			continue
+		}
+		if len(unicode) > 0 && extension > unicode {
+			extensions, unicode = append(extensions, unicode), ""
+		}
+		extensions = append(extensions, extension)
+	}
+	if len(unicode) > 0 {
+		extensions = append(extensions, unicode)
+	}
+	if len(private) > 0 {
+		extensions = append(extensions, private)
+	}
+	return strings.Join(extensions, "-")
+}
+
+// ToLanguageTag Formats the locale like java.util.Locale.toLanguageTag(), "zh-Hant-TW" or "th-TH-u-nu-thai"
+func (locale *Locale) ToLanguageTag() string {
+	result := []string{locale.BCPLanguage()}
+	if len(locale.Script) > 0 {
+		result = append(result, locale.Script)
+	}
+	if len(locale.CountryISO) > 0 {
+		result = append(result, strings.ToUpper(locale.CountryISO))
+	}
+	result = append(result, locale.Variants...)
+	if extensions := locale.extensionString(); len(extensions) > 0 {
+		result = append(result, extensions)
+	}
+	return strings.Join(result, "-")
+}
+
+// ToAndroidString Formats the locale like java.util.Locale.toString() on Android, "zh_TW_#Hant" or "th_TH_#u-nu-thai"
+func (locale *Locale) ToAndroidString() string {
+	language, region, variant := locale.GetLanguage(), strings.ToUpper(locale.GetCountryISO()), strings.Join(locale.Variants, "_")
+	script, extensions := locale.GetScript(), locale.extensionString()
+	hasLanguage, hasRegion := len(language) > 0, len(region) > 0
+
+	result := language
+	if hasRegion || hasLanguage && (len(variant) > 0 || len(script) > 0 || len(extensions) > 0) {
+		result += "_" + region
+	}
+	if len(variant) > 0 && (hasLanguage || hasRegion) {
+		result += "_" + variant
+	}
+	if len(script) > 0 && (hasLanguage || hasRegion) {
+		result += "_#" + script
+	}
+	if len(extensions) > 0 && (hasLanguage || hasRegion) {
+		if len(script) == 0 {
+			result += "_#"
+		} else {
+			result += "_"
+		}
+		result += extensions
+	}
+	return result
+}
+
+// browserLanguage Is the tag a browser lists the locale as in navigator.languages, without variants and extensions and with
+// the script only when there is no region
+func (locale *Locale) browserLanguage() string {
+	if len(locale.CountryISO) > 0 {
+		return locale.BCPLanguage() + "-" + strings.ToUpper(locale.CountryISO)
 	}
+	if len(locale.Script) > 0 {
+		return locale.BCPLanguage() + "-" + locale.Script
+	}
+	return locale.BCPLanguage()
+}
+
+// PreferredLanguages Is navigator.languages of a browser of the family for a user that prefers the locales in order. Chromium
+// and Safari follow each regional locale by its bare language, Firefox advertises most languages bare. Both fall back to
+// English like their default settings do
+func PreferredLanguages(family string, locales ...*Locale) []string {
+	result := []string{}
+	add := func(language string) {
+		if _, ok := strInSlice(result, language); !ok {
+			result = append(result, language)
+		}
+	}
+	english := false
+	for i, locale := range locales {
+		language := locale.BCPLanguage()
+		english = english || language == "en"
+		if family == BrowserFamilyFirefox && !firefoxRegionalLanguages[language] {
+			add(language)
+			continue
+		}
+		add(locale.browserLanguage())
+		if i == len(locales)-1 || locales[i+1].BCPLanguage() != language {
+			add(language)
+		}
+	}
+	if !english {
+		add("en-US")
+		add("en")
+	}
+	return result
+}
 
-	return nil, err
+// SetLocales Sets navigator.language and navigator.languages of the browser to what its family reports for the locales, so
+// AcceptLanguage matches them
+func (b *Browser) SetLocales(locales ...*Locale) {
+	b.Languages = PreferredLanguages(b.GetFamily(), locales...)
+	b.Language = b.Languages[0]
 }
@@ -1,18 +1,448 @@
 package device_utils
 
-import "strings"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+)
 
+var (
+	ErrGeoJSONMalformed        = errors.New("the supplied GeoJSON is malformed")
+	ErrGeodesicNoConvergence   = errors.New("the supplied locations are too close to antipodal")
+	ErrPolygonSamplingExceeded = errors.New("the supplied polygon covers too little of its bounding box")
+	ErrLocationOutOfRange      = errors.New("the supplied location is out of range")
+)
+
+const (
+	// earthRadius Is the mean radius of the earth in meters
+	earthRadius = 6371008.8
+	// wgs84A, wgs84F and wgs84B Are the semi-major axis, flattening and semi-minor axis of the WGS 84 ellipsoid
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+	wgs84B = wgs84A * (1 - wgs84F)
+)
+
+// providerModel Is how a kind of fix of a provider is distributed
+type providerModel struct {
+	// Share is how often the provider produces this kind of fix, in percent
+	Share float64
+	// Horizontal and Vertical are the median accuracies in meters, Vertical is 0 if the provider has no altitude accuracy.
+	// Spread is the sigma of the log-normal distribution around both
+	Horizontal    float64
+	Vertical      float64
+	Spread        float64
+	MinHorizontal float64
+	MaxHorizontal float64
+	// SpeedNoise is the standard deviation in meters per second of the speed a stationary device reports, 0 if the provider
+	// reports no speed
+	SpeedNoise float64
+	// MaxAge is how old the fix can be by the time it is read
+	MaxAge time.Duration
+}
+
+var (
+	gpsModel   = &providerModel{Share: 100, Horizontal: 4.5, Vertical: 7, Spread: 0.45, MinHorizontal: 1.5, MaxHorizontal: 50, SpeedNoise: 0.25, MaxAge: 5 * time.Second}
+	fusedModel = &providerModel{Share: 100, Horizontal: 12, Vertical: 8, Spread: 0.6, MinHorizontal: 3, MaxHorizontal: 150, SpeedNoise: 0.15, MaxAge: 30 * time.Second}
+	// wifiModel and cellModel Are network fixes by Wi-Fi access points and by cell towers
+	wifiModel = &providerModel{Share: 80, Horizontal: 18, Spread: 0.5, MinHorizontal: 5, MaxHorizontal: 100, MaxAge: time.Minute}
+	cellModel = &providerModel{Share: 20, Horizontal: 900, Spread: 0.6, MinHorizontal: 150, MaxHorizontal: 5000, MaxAge: time.Minute}
+)
+
+// providerModels Are the kinds of fixes per provider, passive fixes are whatever another app last requested
+var providerModels = map[GPSLocation_LocationProvider][]*providerModel{
+	GPSLocation_GPS:     {gpsModel},
+	GPSLocation_NETWORK: {wifiModel, cellModel},
+	GPSLocation_FUSED:   {fusedModel},
+	GPSLocation_PASSIVE: {
+		passiveModel(gpsModel, 30),
+		passiveModel(fusedModel, 45),
+		passiveModel(wifiModel, 25),
+	},
+}
+
+// providerShares Are how often apps read each provider, fused being what Google Play services hands out
+var providerShares = map[GPSLocation_LocationProvider]float64{
+	GPSLocation_GPS:     20,
+	GPSLocation_NETWORK: 15,
+	GPSLocation_FUSED:   60,
+	GPSLocation_PASSIVE: 5,
+}
+
+// accuracySigmas Is how many standard deviations of a 2D normal error the 68% confidence radius of an accuracy spans
+var accuracySigmas = math.Sqrt(-2 * math.Log(1-0.68))
+
+// passiveModel Is a fix of model that another app requested, read up to 5 minutes later
+func passiveModel(model *providerModel, share float64) *providerModel {
+	result := *model
+	result.Share = share
+	result.MaxAge = 5 * time.Minute
+	return &result
+}
+
+// horizontalAccuracy Is the horizontal accuracy deviation standard deviations away from the median, within the bounds of the model
+func (model *providerModel) horizontalAccuracy(deviation float64) float64 {
+	return math.Max(model.MinHorizontal, math.Min(model.MaxHorizontal, model.Horizontal*math.Exp(deviation*model.Spread)))
+}
+
+// verticalAccuracy Is the vertical accuracy deviation standard deviations away from the median, 0 if the model has none
+func (model *providerModel) verticalAccuracy(deviation float64) float64 {
+	if model.Vertical <= 0 {
+		return 0
+	}
+	return model.Vertical * math.Exp(deviation*model.Spread)
+}
+
+// resolveProvider Picks a provider for locations that have none, once, so later reads agree
+func (location *GPSLocation) resolveProvider() {
+	if location.Provider != GPSLocation_LocationProvider_NONE {
+		return
+	}
+	providers := []GPSLocation_LocationProvider{GPSLocation_GPS, GPSLocation_NETWORK, GPSLocation_FUSED, GPSLocation_PASSIVE}
+	weights := make([]float64, len(providers))
+	for i, provider := range providers {
+		weights[i] = providerShares[provider]
+	}
+	location.Provider = providers[randomWeighted(weights)]
+}
+
+// pickModel Picks the kind of fix of the provider, picking a provider if the location has none
+func (location *GPSLocation) pickModel() *providerModel {
+	location.resolveProvider()
+	models := providerModels[location.Provider]
+	weights := make([]float64, len(models))
+	for i, model := range models {
+		weights[i] = model.Share
+	}
+	return models[randomWeighted(weights)]
+}
+
+// GenerateFix Fills the accuracies, speed, bearing and time of the fix by the distributions of its provider, picking a provider
+// if it has none. uptime is how long the device has been booted, a random one is used if it is omitted
+func (location *GPSLocation) GenerateFix(uptime ...time.Duration) {
+	model := location.pickModel()
+	location.HorizontalAccuracy = float32(roundTo(model.horizontalAccuracy(rand.NormFloat64()), 3))
+	location.VerticalAccuracy = float32(roundTo(model.verticalAccuracy(rand.NormFloat64()), 3))
+	location.Speed, location.Bearing = 0, 0
+	if speed := math.Abs(rand.NormFloat64() * model.SpeedNoise); speed >= 0.1 {
+		// Below that the chipsets report no bearing
+		location.Speed = float32(roundTo(speed, 3))
+		location.Bearing = float32(roundTo(rand.Float64()*360, 3))
+	}
+
+	bootTime := randomUptime()
+	if len(uptime) > 0 {
+		bootTime = uptime[0]
+	}
+	age := time.Duration(rand.Int63n(int64(model.MaxAge)))
+	if age > bootTime {
+		age = bootTime
+	}
+	location.Timestamp = time.Now().Add(-age).UnixMilli()
+	location.ElapsedRealtimeNanos = int64(bootTime - age)
+}
+
+// randomUptime Is log-uniform between 10 minutes and 2 weeks, phones get rebooted both often and rarely
+func randomUptime() time.Duration {
+	return time.Duration(math.Exp(math.Log(float64(10*time.Minute)) + rand.Float64()*math.Log(float64(14*24*time.Hour)/float64(10*time.Minute))))
+}
+
+// hasFix Reports whether GenerateFix ran, it always fills HorizontalAccuracy and Timestamp
+func (location *GPSLocation) hasFix() bool {
+	return location.HorizontalAccuracy > 0 && location.Timestamp > 0
+}
+
+func roundTo(value float64, decimals int) float64 {
+	factor := math.Pow(10, float64(decimals))
+	return math.Round(value*factor) / factor
+}
+
+// Accuracy Is the horizontal accuracy in whole meters, generating the fix on the first read if it has none, see GenerateFix
 func (location *GPSLocation) Accuracy() int {
-	if location.Provider == 0 {
-		return randomInt(1, 3)
+	if !location.hasFix() {
+		location.GenerateFix()
 	}
-	return int(location.Provider)
+	return int(math.Ceil(float64(location.HorizontalAccuracy)))
 }
 
+// ProviderString Is the name of the provider as android.location.LocationManager has it, picking one on the first read if it
+// has none
 func (location *GPSLocation) ProviderString() string {
-	provider := location.Provider
-	if location.Provider == 0 {
-		provider = GPSLocation_LocationProvider(randomInt(1, 3))
+	location.resolveProvider()
+	return strings.ToLower(GPSLocation_LocationProvider_name[int32(location.Provider)])
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func toDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
+
+// DistanceTo Is the great-circle distance in meters between the locations, by the haversine formula on a spherical earth
+func (location *GPSLocation) DistanceTo(other *GPSLocation) float64 {
+	lat1, lat2 := toRadians(location.Latitude), toRadians(other.Latitude)
+	dLat := lat2 - lat1
+	dLon := toRadians(other.Longitude - location.Longitude)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// VincentyDistanceTo Is the distance in meters between the locations on the WGS 84 ellipsoid, accurate to within a millimeter.
+// It fails with ErrGeodesicNoConvergence for nearly antipodal locations, DistanceTo covers those
+// src: https://en.wikipedia.org/wiki/Vincenty%27s_formulae#Inverse_problem
+func (location *GPSLocation) VincentyDistanceTo(other *GPSLocation) (float64, error) {
+	l := toRadians(other.Longitude - location.Longitude)
+	u1 := math.Atan((1 - wgs84F) * math.Tan(toRadians(location.Latitude)))
+	u2 := math.Atan((1 - wgs84F) * math.Tan(toRadians(other.Latitude)))
+	sinU1, cosU1 := math.Sincos(u1)
+	sinU2, cosU2 := math.Sincos(u2)
+
+	lambda := l
+	for i := 0; i < 200; i++ {
+		sinLambda, cosLambda := math.Sincos(lambda)
+		sinSigma := math.Sqrt(math.Pow(cosU2*sinLambda, 2) + math.Pow(cosU1*sinU2-sinU1*cosU2*cosLambda, 2))
+		if sinSigma == 0 {
+			return 0, nil
+		}
+		cosSigma := sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma := math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha := 1 - sinAlpha*sinAlpha
+		cos2SigmaM := 0.0
+		if cosSqAlpha != 0 {
+			// Both points on the equator otherwise
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+		c := wgs84F / 16 * cosSqAlpha * (4 + wgs84F*(4-3*cosSqAlpha))
+		previous := lambda
+		lambda = l + (1-c)*wgs84F*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-previous) > 1e-12 {
+			continue
+		}
+
+		uSq := cosSqAlpha * (wgs84A*wgs84A - wgs84B*wgs84B) / (wgs84B * wgs84B)
+		a := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+		b := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+		deltaSigma := b * sinSigma * (cos2SigmaM + b/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-b/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+		return wgs84B * a * (sigma - deltaSigma), nil
+	}
+	return 0, fmt.Errorf("GPSLocation.VincentyDistanceTo: %w", ErrGeodesicNoConvergence)
+}
+
+// BearingTo Is the initial great-circle bearing from the location to other, in degrees clockwise from north in [0, 360)
+func (location *GPSLocation) BearingTo(other *GPSLocation) float64 {
+	lat1, lat2 := toRadians(location.Latitude), toRadians(other.Latitude)
+	dLon := toRadians(other.Longitude - location.Longitude)
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return math.Mod(toDegrees(math.Atan2(y, x))+360, 360)
+}
+
+// Destination Is the location reached by travelling distance meters along a great circle with the initial bearing in degrees,
+// it keeps the altitude and provider
+func (location *GPSLocation) Destination(bearing, distance float64) *GPSLocation {
+	lat1, lon1 := toRadians(location.Latitude), toRadians(location.Longitude)
+	delta := distance / earthRadius
+	theta := toRadians(bearing)
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(delta) + math.Cos(lat1)*math.Sin(delta)*math.Cos(theta))
+	lon2 := lon1 + math.Atan2(math.Sin(theta)*math.Sin(delta)*math.Cos(lat1), math.Cos(delta)-math.Sin(lat1)*math.Sin(lat2))
+	return &GPSLocation{
+		Longitude: math.Mod(toDegrees(lon2)+540, 360) - 180,
+		Latitude:  toDegrees(lat2),
+		Altitude:  location.Altitude,
+		Provider:  location.Provider,
+	}
+}
+
+// RandomLocationWithin Picks a location uniformly by area within radius meters of the location. The altitude drifts further
+// from the one of the center the further away the location is, by a meter per 100 up to 50 meters
+func (location *GPSLocation) RandomLocationWithin(radius float64) *GPSLocation {
+	// Uniform over the spherical cap, so not denser near the center like a uniform distance would be
+	delta := math.Acos(1 - rand.Float64()*(1-math.Cos(radius/earthRadius)))
+	distance := delta * earthRadius
+	result := location.Destination(rand.Float64()*360, distance)
+	result.Altitude = jitterAltitude(location.Altitude, math.Min(distance/100, 50))
+	return result
+}
+
+// RandomFix Simulates a fix of the location, generating it with GenerateFix and moving the result by an error that agrees
+// with its accuracies
+func (location *GPSLocation) RandomFix() *GPSLocation {
+	result := &GPSLocation{Provider: location.Provider}
+	result.GenerateFix()
+	sigma := float64(result.HorizontalAccuracy) / accuracySigmas
+	// The distance of a 2D normal error is Rayleigh distributed
+	moved := location.Destination(rand.Float64()*360, sigma*math.Sqrt(-2*math.Log(1-rand.Float64())))
+	result.Latitude, result.Longitude = moved.Latitude, moved.Longitude
+	result.Altitude = location.Altitude
+	if result.VerticalAccuracy > 0 {
+		result.Altitude = jitterAltitude(location.Altitude, float64(result.VerticalAccuracy))
+	}
+	return result
+}
+
+// jitterAltitude Moves altitude by a normally distributed error, without sinking places at or above sea level below it
+func jitterAltitude(altitude, deviation float64) float64 {
+	result := altitude + rand.NormFloat64()*deviation
+	if altitude >= 0 && result < 0 {
+		result = -result
+	}
+	return roundTo(result, 3)
+}
+
+// geoJSON Covers the GeoJSON objects that can hold polygons
+// src: https://datatracker.ietf.org/doc/html/rfc7946
+type geoJSON struct {
+	Type        string          `json:"type"`
+	Properties  json.RawMessage `json:"properties"`
+	Coordinates json.RawMessage `json:"coordinates"`
+	Geometry    *geoJSON        `json:"geometry"`
+	Geometries  []*geoJSON      `json:"geometries"`
+	Features    []*geoJSON      `json:"features"`
+}
+
+// polygons Flattens the object into polygons of rings of [longitude, latitude] positions, the first ring being the exterior
+func (object *geoJSON) polygons() ([][][][]float64, error) {
+	result := [][][][]float64{}
+	switch object.Type {
+	case "Polygon":
+		polygon := [][][]float64{}
+		if err := json.Unmarshal(object.Coordinates, &polygon); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrGeoJSONMalformed, err)
+		}
+		result = append(result, polygon)
+		break
+	case "MultiPolygon":
+		if err := json.Unmarshal(object.Coordinates, &result); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrGeoJSONMalformed, err)
+		}
+		break
+	case "Feature":
+		if object.Geometry == nil {
+			return nil, fmt.Errorf("%w: feature without geometry", ErrGeoJSONMalformed)
+		}
+		return object.Geometry.polygons()
+	case "FeatureCollection", "GeometryCollection":
+		for _, child := range append(object.Features, object.Geometries...) {
+			polygons, err := child.polygons()
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, polygons...)
+		}
+		break
+	default:
+		return nil, fmt.Errorf("%w: %q has no area", ErrGeoJSONMalformed, object.Type)
+	}
+
+	for _, polygon := range result {
+		if len(polygon) == 0 {
+			return nil, fmt.Errorf("%w: polygon without rings", ErrGeoJSONMalformed)
+		}
+		for _, ring := range polygon {
+			if len(ring) < 4 {
+				return nil, fmt.Errorf("%w: ring with less than 4 positions", ErrGeoJSONMalformed)
+			}
+			for _, position := range ring {
+				if len(position) < 2 {
+					return nil, fmt.Errorf("%w: position with less than 2 coordinates", ErrGeoJSONMalformed)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// ringContains Is the even-odd rule, treating the ring as planar in longitude and latitude like RFC 7946 does
+func ringContains(ring [][]float64, longitude, latitude float64) bool {
+	result := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		xi, yi, xj, yj := ring[i][0], ring[i][1], ring[j][0], ring[j][1]
+		if (yi > latitude) != (yj > latitude) && longitude < (xj-xi)*(latitude-yi)/(yj-yi)+xi {
+			result = !result
+		}
+	}
+	return result
+}
+
+// RandomLocationInPolygon Picks a location uniformly by area inside a GeoJSON Polygon or MultiPolygon, or a Feature or collection
+// of them, holes excluded. Polygons may not cross the antimeridian
+func RandomLocationInPolygon(data []byte) (*GPSLocation, error) {
+	object := new(geoJSON)
+	if err := json.Unmarshal(data, object); err != nil {
+		return nil, fmt.Errorf("RandomLocationInPolygon: %w: %w", ErrGeoJSONMalformed, err)
+	}
+	polygons, err := object.polygons()
+	if err != nil {
+		return nil, fmt.Errorf("RandomLocationInPolygon: %w", err)
+	}
+	if len(polygons) == 0 {
+		return nil, fmt.Errorf("RandomLocationInPolygon: %w: no polygons", ErrGeoJSONMalformed)
+	}
+
+	minLon, maxLon, minLat, maxLat := 180.0, -180.0, 90.0, -90.0
+	for _, polygon := range polygons {
+		for _, position := range polygon[0] {
+			minLon, maxLon = math.Min(minLon, position[0]), math.Max(maxLon, position[0])
+			minLat, maxLat = math.Min(minLat, position[1]), math.Max(maxLat, position[1])
+		}
+	}
+
+	// Rejection sampling over the bounding box, uniform in the sine of the latitude to be uniform by area
+	minSin, maxSin := math.Sin(toRadians(minLat)), math.Sin(toRadians(maxLat))
+	for i := 0; i < 10000; i++ {
+		longitude := minLon + rand.Float64()*(maxLon-minLon)
+		latitude := toDegrees(math.Asin(minSin + rand.Float64()*(maxSin-minSin)))
+		for _, polygon := range polygons {
+			inside := ringContains(polygon[0], longitude, latitude)
+			for _, hole := range polygon[1:] {
+				inside = inside && !ringContains(hole, longitude, latitude)
+			}
+			if inside {
+				return &GPSLocation{Longitude: longitude, Latitude: latitude}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("RandomLocationInPolygon: %w", ErrPolygonSamplingExceeded)
+}
+
+// Place Is what ReverseGeocode makes of a location
+type Place struct {
+	// CountryISO is empty out at sea
+	CountryISO string
+	// City is the nearest catalog city of the country, or of any country out at sea
+	City *City
+	// Distance is how far the location is from City in meters
+	Distance float64
+	// Timezone is the IANA name of the timezone of the location, the nautical Etc/GMT zone of its longitude out at sea
+	Timezone string
+}
+
+// ReverseGeocode Looks up the country, the nearest city and the timezone of the location offline. Country and timezone come
+// from the embedded timezone boundaries rather than from the nearest city, so locations near a border get the side they are on
+func ReverseGeocode(location *GPSLocation) (*Place, error) {
+	if math.IsNaN(location.Latitude) || math.IsNaN(location.Longitude) || math.Abs(location.Latitude) > 90 || math.Abs(location.Longitude) > 180 {
+		return nil, fmt.Errorf("ReverseGeocode: %w: %f, %f", ErrLocationOutOfRange, location.Latitude, location.Longitude)
+	}
+
+	result := &Place{Timezone: nauticalTimezone(location.Longitude)}
+	if area, ok := findTimezoneArea(location.Longitude, location.Latitude); ok {
+		result.CountryISO, result.Timezone = area.CountryISO, area.Timezone
+	}
+	everyCity := func(city *City) bool { return true }
+	city, ok := nearestDBCity(result.CountryISO, location, everyCity)
+	if !ok && len(result.CountryISO) > 0 {
+		city, ok = nearestDBCity("", location, everyCity)
+	}
+	if ok {
+		result.City = city.City
+		result.Distance = location.DistanceTo(city.Location())
 	}
-	return strings.ToLower(GPSLocation_LocationProvider_name[int32(provider)])
+	return result, nil
 }
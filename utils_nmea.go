@@ -0,0 +1,502 @@
+package device_utils
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrNMEAMalformed = errors.New("the supplied NMEA sentence is malformed")
+
+const (
+	// gpsOrbitRadius, gpsOrbitPeriod and gpsInclination Are the nominal orbit of the GPS constellation
+	gpsOrbitRadius = 26559700.0
+	gpsOrbitPeriod = 43082.0
+	gpsInclination = 55.0
+	// gpsMinElevation Is the elevation mask in degrees below which receivers ignore satellites
+	gpsMinElevation = 5.0
+	// nmeaUERE Is the user equivalent range error in meters that turns accuracies into dilutions of precision and back
+	nmeaUERE = 3.0
+	// nmeaKnots Is how many meters per second a knot is
+	nmeaKnots = 1852.0 / 3600
+)
+
+// gpsPRNs Are the PRNs of the 24 nominal slots, 4 per orbital plane A to F
+var gpsPRNs = [24]int{
+	24, 31, 30, 7,
+	16, 25, 5, 26,
+	19, 27, 17, 29,
+	2, 20, 22, 21,
+	28, 13, 12, 18,
+	32, 14, 10, 15,
+}
+
+// GPSSatellite Is a satellite as GSV reports it, elevation and azimuth are in degrees and SNR in dB-Hz
+type GPSSatellite struct {
+	PRN       int
+	Elevation float64
+	Azimuth   float64
+	SNR       int
+	// Used is whether the fix was computed with the satellite, see GSA
+	Used bool
+}
+
+// Constellation Is the GPS satellites above the elevation mask at the time and place of the fix, highest first. Satellites
+// move along the nominal circular orbits, so consecutive fixes see the constellation turn like it does in the sky. The ones
+// used in the fix are the highest, 3 for a 2D fix without a vertical accuracy and as many as the horizontal accuracy calls
+// for otherwise. Network fixes use none
+func (location *GPSLocation) Constellation() []*GPSSatellite {
+	fixTime := time.UnixMilli(location.Timestamp).UTC()
+	seconds := float64(fixTime.Sub(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC))) / float64(time.Second)
+	// Earth rotation angle, the ECI to ECEF rotation without precession and nutation
+	earthRotation := 2 * math.Pi * (0.7790572732640 + 1.00273781191135448*seconds/86400)
+
+	lat, lon := toRadians(location.Latitude), toRadians(location.Longitude)
+	sinLat, cosLat := math.Sincos(lat)
+	sinLon, cosLon := math.Sincos(lon)
+	receiverRadius := earthRadius + location.Altitude
+	rx, ry, rz := receiverRadius*cosLat*cosLon, receiverRadius*cosLat*sinLon, receiverRadius*sinLat
+	sinInc, cosInc := math.Sincos(toRadians(gpsInclination))
+
+	result := []*GPSSatellite{}
+	for slot, prn := range gpsPRNs {
+		plane, index := slot/4, slot%4
+		// Planes are 60 degrees apart, slots 90 degrees apart within a plane and shifted by 15 degrees between planes
+		node := toRadians(float64(plane)*60) - earthRotation
+		anomaly := toRadians(float64(index)*90+float64(plane)*15) + 2*math.Pi*seconds/gpsOrbitPeriod
+		sinNode, cosNode := math.Sincos(node)
+		sinAnomaly, cosAnomaly := math.Sincos(anomaly)
+		sx := gpsOrbitRadius * (cosAnomaly*cosNode - sinAnomaly*cosInc*sinNode)
+		sy := gpsOrbitRadius * (cosAnomaly*sinNode + sinAnomaly*cosInc*cosNode)
+		sz := gpsOrbitRadius * sinAnomaly * sinInc
+
+		dx, dy, dz := sx-rx, sy-ry, sz-rz
+		east := -sinLon*dx + cosLon*dy
+		north := -sinLat*cosLon*dx - sinLat*sinLon*dy + cosLat*dz
+		up := cosLat*cosLon*dx + cosLat*sinLon*dy + sinLat*dz
+		elevation := toDegrees(math.Atan2(up, math.Hypot(east, north)))
+		if elevation < gpsMinElevation {
+			continue
+		}
+		result = append(result, &GPSSatellite{
+			PRN:       prn,
+			Elevation: math.Round(elevation),
+			Azimuth:   math.Mod(math.Round(toDegrees(math.Atan2(east, north)))+360, 360),
+			// Stronger the higher the satellite, with a fixed offset per satellite for the differences between blocks
+			SNR: int(math.Round(20+25*math.Sin(toRadians(elevation)))) + prn%5 - 2,
+		})
+	}
+	slices.SortStableFunc(result, func(a, b *GPSSatellite) int {
+		if a.Elevation != b.Elevation {
+			return int(b.Elevation - a.Elevation)
+		}
+		return a.PRN - b.PRN
+	})
+
+	if quality := nmeaQuality(location); quality > 0 && quality != 6 {
+		used := 3
+		if location.VerticalAccuracy > 0 {
+			used = max(4, min(12, int(math.Round(14-2*location.nmeaHDOP()))))
+		}
+		for i := 0; i < len(result) && i < used; i++ {
+			result[i].Used = true
+		}
+	}
+	return result
+}
+
+// nmeaSentence Frames the fields as an NMEA 0183 sentence, the checksum is the XOR of everything between $ and *
+// src: https://gpsd.gitlab.io/gpsd/NMEA.html
+func nmeaSentence(fields ...string) string {
+	body := strings.Join(fields, ",")
+	return fmt.Sprintf("$%s*%02X", body, nmeaChecksum(body))
+}
+
+func nmeaChecksum(body string) byte {
+	result := byte(0)
+	for i := 0; i < len(body); i++ {
+		result ^= body[i]
+	}
+	return result
+}
+
+// nmeaCoordinate Is the degrees as ddmm.mmmmm or dddmm.mmmmm with its hemisphere
+func nmeaCoordinate(degrees float64, digits int, positive, negative string) (string, string) {
+	hemisphere := positive
+	if degrees < 0 {
+		hemisphere, degrees = negative, -degrees
+	}
+	whole := math.Floor(degrees)
+	minutes := roundTo((degrees-whole)*60, 5)
+	if minutes >= 60 {
+		whole, minutes = whole+1, 0
+	}
+	return fmt.Sprintf("%0*d%08.5f", digits, int(whole), minutes), hemisphere
+}
+
+// nmeaHDOP Is the horizontal dilution of precision that goes with the accuracy
+func (location *GPSLocation) nmeaHDOP() float64 {
+	return math.Max(0.5, roundTo(float64(location.HorizontalAccuracy)/nmeaUERE, 1))
+}
+
+// nmeaVDOP Is the vertical dilution of precision that goes with the accuracy, 1.5 times the horizontal one without it
+func (location *GPSLocation) nmeaVDOP() float64 {
+	if location.VerticalAccuracy <= 0 {
+		return roundTo(location.nmeaHDOP()*1.5, 1)
+	}
+	return math.Max(0.5, roundTo(float64(location.VerticalAccuracy)/nmeaUERE, 1))
+}
+
+// nmeaQuality Is the GGA fix quality, 0 without a fix, 6 for network fixes since those are estimated, 2 for fixes accurate
+// enough to be SBAS corrected and 1 otherwise
+func nmeaQuality(location *GPSLocation) int {
+	switch {
+	case location.HorizontalAccuracy <= 0:
+		return 0
+	case location.Provider == GPSLocation_NETWORK:
+		return 6
+	case location.HorizontalAccuracy < 2.5:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// NMEA Is the fix as RMC, GGA, GSA and GSV sentences without line endings, see Constellation for the satellites. The fix
+// quality, the satellites in use and the dilutions of precision follow from the provider and accuracies. Fixes without a
+// vertical accuracy are 2D fixes without an altitude, the altitude of the others is reported as is since the geoid
+// separation is unknown
+func (location *GPSLocation) NMEA() []string {
+	fixTime := time.UnixMilli(location.Timestamp).UTC()
+	clock := fmt.Sprintf("%s.%02d", fixTime.Format("150405"), fixTime.Nanosecond()/int(10*time.Millisecond))
+	latitude, north := nmeaCoordinate(location.Latitude, 2, "N", "S")
+	longitude, east := nmeaCoordinate(location.Longitude, 3, "E", "W")
+	altitude := fmt.Sprintf("%.1f", location.Altitude)
+	speed, course := fmt.Sprintf("%.2f", float64(location.Speed)/nmeaKnots), fmt.Sprintf("%.1f", location.Bearing)
+	quality := nmeaQuality(location)
+	hdop, vdop, pdop := fmt.Sprintf("%.1f", location.nmeaHDOP()), fmt.Sprintf("%.1f", location.nmeaVDOP()), fmt.Sprintf("%.1f", roundTo(math.Hypot(location.nmeaHDOP(), location.nmeaVDOP()), 1))
+	satellites := location.Constellation()
+
+	status, mode, fixType := "A", "A", "3"
+	switch quality {
+	case 0:
+		// Receivers leave the position empty and report the worst dilution of precision without a fix
+		status, mode, fixType = "V", "N", "1"
+		latitude, north, longitude, east, altitude, speed, course = "", "", "", "", "", "", ""
+		hdop, vdop, pdop = "99.99", "99.99", "99.99"
+		break
+	case 2:
+		mode = "D"
+		break
+	case 6:
+		mode = "E"
+		break
+	}
+	if quality > 0 && location.VerticalAccuracy <= 0 {
+		fixType, altitude = "2", ""
+	}
+
+	used := []string{}
+	for _, satellite := range satellites {
+		if satellite.Used {
+			used = append(used, fmt.Sprintf("%02d", satellite.PRN))
+		}
+	}
+	gsa := append([]string{"GPGSA", "A", fixType}, used...)
+	for len(gsa) < 15 {
+		gsa = append(gsa, "")
+	}
+	gsa = append(gsa, pdop, hdop, vdop)
+
+	result := []string{
+		nmeaSentence("GPRMC", clock, status, latitude, north, longitude, east, speed, course, fixTime.Format("020106"), "", "", mode),
+		nmeaSentence("GPGGA", clock, latitude, north, longitude, east, strconv.Itoa(quality), fmt.Sprintf("%02d", len(used)), hdop, altitude, "M", "", "M", "", ""),
+		nmeaSentence(gsa...),
+	}
+	// GSV fits 4 satellites per sentence
+	count := (len(satellites) + 3) / 4
+	for i := 0; i < count; i++ {
+		gsv := []string{"GPGSV", strconv.Itoa(count), strconv.Itoa(i + 1), fmt.Sprintf("%02d", len(satellites))}
+		for _, satellite := range satellites[i*4 : min(len(satellites), i*4+4)] {
+			gsv = append(gsv, fmt.Sprintf("%02d", satellite.PRN), fmt.Sprintf("%02.0f", satellite.Elevation), fmt.Sprintf("%03.0f", satellite.Azimuth), fmt.Sprintf("%02d", satellite.SNR))
+		}
+		result = append(result, nmeaSentence(gsv...))
+	}
+	return result
+}
+
+// WriteNMEA Writes the sentences of the fixes with CRLF line endings, for mock-location tools and emulators that replay NMEA
+func WriteNMEA(w io.Writer, fixes iter.Seq[*GPSLocation]) error {
+	for fix := range fixes {
+		for _, sentence := range fix.NMEA() {
+			if _, err := io.WriteString(w, sentence+"\r\n"); err != nil {
+				return fmt.Errorf("WriteNMEA: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// nmeaEpoch Is a fix being assembled from the sentences that share its time of day
+type nmeaEpoch struct {
+	clock    string
+	location *GPSLocation
+	// seconds is the time of day, the date comes from RMC or the previous epoch
+	seconds float64
+	date    time.Time
+	valid   bool
+}
+
+// nmeaParser Is the state of ParseNMEA between lines
+type nmeaParser struct {
+	result []*GPSLocation
+	epoch  *nmeaEpoch
+	// date and seconds are those of the last finished epoch, for logs that lack RMC and for crossing midnight
+	date    time.Time
+	seconds float64
+}
+
+// ParseNMEA Reads a log of NMEA 0183 sentences into a fix per epoch, the sentences of an epoch share the time of day of their
+// GGA and RMC. Fixes take their position and altitude from GGA or RMC, their date, speed and bearing from RMC and their
+// accuracies from the dilutions of precision of GGA and GSA. Any talker is accepted, unknown sentences are skipped, epochs
+// without a valid fix are dropped and a log without RMC is dated 1970-01-01. The error wraps ErrNMEAMalformed and names the
+// line
+func ParseNMEA(r io.Reader) ([]*GPSLocation, error) {
+	parser := &nmeaParser{result: []*GPSLocation{}, date: time.Unix(0, 0).UTC()}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		if err := parser.parse(text); err != nil {
+			return nil, fmt.Errorf("ParseNMEA: line %d: %w", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ParseNMEA: %w", err)
+	}
+	parser.finish()
+	return parser.result, nil
+}
+
+func (parser *nmeaParser) parse(sentence string) error {
+	if !strings.HasPrefix(sentence, "$") {
+		return fmt.Errorf("%w: %q does not start with $", ErrNMEAMalformed, sentence)
+	}
+	body := sentence[1:]
+	if index := strings.LastIndexByte(body, '*'); index >= 0 {
+		checksum, err := strconv.ParseUint(body[index+1:], 16, 8)
+		if err != nil {
+			return fmt.Errorf("%w: %q has an unreadable checksum", ErrNMEAMalformed, sentence)
+		}
+		body = body[:index]
+		if byte(checksum) != nmeaChecksum(body) {
+			return fmt.Errorf("%w: %q has checksum %02X, want %02X", ErrNMEAMalformed, sentence, checksum, nmeaChecksum(body))
+		}
+	}
+	fields := strings.Split(body, ",")
+	if len(fields[0]) != 5 {
+		// Proprietary sentences start with P and have a manufacturer code instead of a talker
+		return nil
+	}
+
+	var err error
+	switch fields[0][2:] {
+	case "GGA":
+		err = parser.parseGGA(fields)
+		break
+	case "RMC":
+		err = parser.parseRMC(fields)
+		break
+	case "GSA":
+		err = parser.parseGSA(fields)
+		break
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %q: %w", ErrNMEAMalformed, sentence, err)
+	}
+	return nil
+}
+
+// startEpoch Finishes the current epoch if clock is another time of day and returns the one of clock
+func (parser *nmeaParser) startEpoch(clock string) (*nmeaEpoch, error) {
+	if parser.epoch != nil && parser.epoch.clock == clock {
+		return parser.epoch, nil
+	}
+	parser.finish()
+	if len(clock) < 6 {
+		return nil, fmt.Errorf("time %q is too short", clock)
+	}
+	hours, errHours := strconv.Atoi(clock[0:2])
+	minutes, errMinutes := strconv.Atoi(clock[2:4])
+	seconds, errSeconds := strconv.ParseFloat(clock[4:], 64)
+	if err := errors.Join(errHours, errMinutes, errSeconds); err != nil {
+		return nil, fmt.Errorf("time %q: %w", clock, err)
+	}
+	parser.epoch = &nmeaEpoch{clock: clock, location: &GPSLocation{Provider: GPSLocation_GPS}, seconds: float64(hours*3600+minutes*60) + seconds}
+	return parser.epoch, nil
+}
+
+// finish Adds the current epoch to the result if it has a fix, dating it
+func (parser *nmeaParser) finish() {
+	epoch := parser.epoch
+	parser.epoch = nil
+	if epoch == nil {
+		return
+	}
+	date := epoch.date
+	if date.IsZero() {
+		date = parser.date
+		if epoch.seconds < parser.seconds {
+			// Crossed midnight since the last dated epoch
+			date = date.AddDate(0, 0, 1)
+		}
+	}
+	parser.date, parser.seconds = date, epoch.seconds
+	if !epoch.valid {
+		return
+	}
+	epoch.location.Timestamp = date.Add(time.Duration(math.Round(epoch.seconds*1000)) * time.Millisecond).UnixMilli()
+	parser.result = append(parser.result, epoch.location)
+}
+
+// parseCoordinate Reads ddmm.mmmm or dddmm.mmmm with its hemisphere into degrees
+func parseCoordinate(value, hemisphere string, digits int) (float64, error) {
+	if len(value) < digits+2 {
+		return 0, fmt.Errorf("coordinate %q is too short", value)
+	}
+	degrees, err := strconv.Atoi(value[:digits])
+	if err != nil {
+		return 0, fmt.Errorf("coordinate %q: %w", value, err)
+	}
+	minutes, err := strconv.ParseFloat(value[digits:], 64)
+	if err != nil {
+		return 0, fmt.Errorf("coordinate %q: %w", value, err)
+	}
+	result := float64(degrees) + minutes/60
+	switch hemisphere {
+	case "N", "E":
+		return result, nil
+	case "S", "W":
+		return -result, nil
+	}
+	return 0, fmt.Errorf("hemisphere %q is unknown", hemisphere)
+}
+
+// parsePosition Reads the latitude and longitude fields starting at index into the location
+func parsePosition(location *GPSLocation, fields []string, index int) error {
+	latitude, err := parseCoordinate(fields[index], fields[index+1], 2)
+	if err != nil {
+		return err
+	}
+	longitude, err := parseCoordinate(fields[index+2], fields[index+3], 3)
+	if err != nil {
+		return err
+	}
+	location.Latitude, location.Longitude = latitude, longitude
+	return nil
+}
+
+// parseOptionalFloat Is 0 for empty fields, which NMEA uses for unknown values
+func parseOptionalFloat(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// parseGGA Reads $--GGA,time,lat,N,lon,E,quality,satellites,hdop,altitude,M,separation,M,age,station
+func (parser *nmeaParser) parseGGA(fields []string) error {
+	if len(fields) < 12 {
+		return fmt.Errorf("%d fields, want at least 12", len(fields))
+	}
+	epoch, err := parser.startEpoch(fields[1])
+	if err != nil {
+		return err
+	}
+	quality, err := parseOptionalFloat(fields[6])
+	if err != nil {
+		return err
+	}
+	if quality == 0 {
+		return nil
+	}
+	if err := parsePosition(epoch.location, fields, 2); err != nil {
+		return err
+	}
+	hdop, errHDOP := parseOptionalFloat(fields[8])
+	altitude, errAltitude := parseOptionalFloat(fields[9])
+	separation, errSeparation := parseOptionalFloat(fields[11])
+	if err := errors.Join(errHDOP, errAltitude, errSeparation); err != nil {
+		return err
+	}
+	// Android reports the height above the ellipsoid, which is the one above the geoid plus the separation
+	epoch.location.Altitude = roundTo(altitude+separation, 3)
+	epoch.location.HorizontalAccuracy = float32(roundTo(hdop*nmeaUERE, 3))
+	if quality == 6 {
+		epoch.location.Provider = GPSLocation_NETWORK
+	}
+	epoch.valid = true
+	return nil
+}
+
+// parseRMC Reads $--RMC,time,status,lat,N,lon,E,knots,course,date,variation,E,mode
+func (parser *nmeaParser) parseRMC(fields []string) error {
+	if len(fields) < 10 {
+		return fmt.Errorf("%d fields, want at least 10", len(fields))
+	}
+	epoch, err := parser.startEpoch(fields[1])
+	if err != nil {
+		return err
+	}
+	if fields[9] != "" {
+		date, err := time.Parse("020106", fields[9])
+		if err != nil {
+			return fmt.Errorf("date %q: %w", fields[9], err)
+		}
+		epoch.date = date
+	}
+	if fields[2] != "A" {
+		return nil
+	}
+	if !epoch.valid {
+		// GGA is more precise on the rest, RMC covers logs without it
+		if err := parsePosition(epoch.location, fields, 3); err != nil {
+			return err
+		}
+		epoch.valid = true
+	}
+	knots, errKnots := parseOptionalFloat(fields[7])
+	course, errCourse := parseOptionalFloat(fields[8])
+	if err := errors.Join(errKnots, errCourse); err != nil {
+		return err
+	}
+	epoch.location.Speed = float32(roundTo(knots*nmeaKnots, 3))
+	epoch.location.Bearing = float32(course)
+	return nil
+}
+
+// parseGSA Reads $--GSA,mode,type,12 PRNs,pdop,hdop,vdop, which has no time and belongs to the current epoch
+func (parser *nmeaParser) parseGSA(fields []string) error {
+	if len(fields) < 18 {
+		return fmt.Errorf("%d fields, want at least 18", len(fields))
+	}
+	if parser.epoch == nil || fields[2] != "3" {
+		// Without a 3D fix there is no vertical accuracy
+		return nil
+	}
+	vdop, err := parseOptionalFloat(fields[17])
+	if err != nil {
+		return err
+	}
+	parser.epoch.location.VerticalAccuracy = float32(roundTo(vdop*nmeaUERE, 3))
+	return nil
+}
@@ -34,11 +34,7 @@ func (s *SIMCard) GetCarrierName(heuristic bool) string {
 }
 
 func (s *SIMCard) Randomize(countryISO string) {
-	_, ok := AvailableSIMCards[countryISO]
-	if !ok {
-		countryISO = randomStrSlice(AvailableCountries)
-	}
-	simCard := randomSIMSlice(AvailableSIMCards[countryISO])
+	simCard := GetRandomDBSIMCard(countryISO)
 	s.MNC = simCard.MNC
 	s.MCC = simCard.MCC
 	s.Carrier = simCard.Carrier
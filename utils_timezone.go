@@ -1,10 +1,38 @@
 package device_utils
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
+	// The zone names have to load on systems without a timezone database too, Windows and slim containers
+	_ "time/tzdata"
 )
 
+var (
+	ErrTimezoneUnsupported = errors.New("the supplied timezone is unsupported")
+	ErrTimezoneMismatch    = errors.New("the supplied timezone does not match the location")
+)
+
+// timezoneLinks Are the old names of renamed zones, Android keeps reporting some of them, Asia/Calcutta among others
+var timezoneLinks = map[string]string{
+	"America/Buenos_Aires": "America/Argentina/Buenos_Aires",
+	"America/Godthab":      "America/Nuuk",
+	"America/Indianapolis": "America/Indiana/Indianapolis",
+	"America/Louisville":   "America/Kentucky/Louisville",
+	"Asia/Calcutta":        "Asia/Kolkata",
+	"Asia/Katmandu":        "Asia/Kathmandu",
+	"Asia/Rangoon":         "Asia/Yangon",
+	"Asia/Saigon":          "Asia/Ho_Chi_Minh",
+	"Asia/Ulan_Bator":      "Asia/Ulaanbaatar",
+	"Atlantic/Faeroe":      "Atlantic/Faroe",
+	"Europe/Kiev":          "Europe/Kyiv",
+	"Pacific/Enderbury":    "Pacific/Kanton",
+	"Pacific/Ponape":       "Pacific/Pohnpei",
+	"Pacific/Truk":         "Pacific/Chuuk",
+}
+
 func (tz *Timezone) FromName(name string) error {
 	_, err := time.LoadLocation(name)
 	if err == nil {
@@ -18,10 +46,175 @@ func (tz *Timezone) FromLocation(loc *time.Location) {
 	tz.Name = loc.String()
 }
 
+// GoLocation Loads the zone, the error wraps ErrTimezoneUnsupported if the name is not in the timezone database. An empty name
+// is UTC like time.LoadLocation has it
+func (tz *Timezone) GoLocation() (*time.Location, error) {
+	result, err := time.LoadLocation(tz.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("Timezone.GoLocation: %w: %q: %w", ErrTimezoneUnsupported, tz.GetName(), err)
+	}
+	return result, nil
+}
+
+// MustGoLocation Is GoLocation for names that are known to be valid, it panics on the others rather than hiding a typo behind UTC.
+// It used to fall back to UTC, callers that relied on that have to handle the error of GoLocation instead
 func (tz *Timezone) MustGoLocation() *time.Location {
-	result, err := time.LoadLocation(tz.Name)
+	result, err := tz.GoLocation()
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Canonical Is the current name of the zone, resolving the old names Android still reports
+func (tz *Timezone) Canonical() string {
+	if name, ok := timezoneLinks[tz.GetName()]; ok {
+		return name
+	}
+	return tz.GetName()
+}
+
+// in Is at in the zone, at defaults to now
+func (tz *Timezone) in(at []time.Time) (time.Time, error) {
+	location, err := tz.GoLocation()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(at) > 0 {
+		return at[0].In(location), nil
+	}
+	return time.Now().In(location), nil
+}
+
+// Offset Is how far the zone is ahead of UTC at the time, now if at is omitted
+func (tz *Timezone) Offset(at ...time.Time) (time.Duration, error) {
+	local, err := tz.in(at)
+	if err != nil {
+		return 0, err
+	}
+	_, offset := local.Zone()
+	return time.Duration(offset) * time.Second, nil
+}
+
+// IsDST Reports whether the zone observes daylight saving time at the time, now if at is omitted
+func (tz *Timezone) IsDST(at ...time.Time) (bool, error) {
+	local, err := tz.in(at)
+	if err != nil {
+		return false, err
+	}
+	return local.IsDST(), nil
+}
+
+// Abbreviation Is the abbreviation of the zone at the time like "CEST", now if at is omitted. Zones without one in the
+// timezone database have their offset like "+03"
+func (tz *Timezone) Abbreviation(at ...time.Time) (string, error) {
+	local, err := tz.in(at)
+	if err != nil {
+		return "", err
+	}
+	name, _ := local.Zone()
+	return name, nil
+}
+
+// JSOffset Is what Date.prototype.getTimezoneOffset() returns at the time, now if at is omitted: minutes behind UTC, so
+// negative east of Greenwich
+func (tz *Timezone) JSOffset(at ...time.Time) (int, error) {
+	offset, err := tz.Offset(at...)
+	if err != nil {
+		return 0, err
+	}
+	return -int(offset / time.Minute), nil
+}
+
+// Validate Checks that the zone is the one of the location, or of a border within reach of it since the boundaries are coarse.
+// The error wraps ErrTimezoneMismatch and names the zone of the location
+func (tz *Timezone) Validate(location *GPSLocation) error {
+	if _, err := tz.GoLocation(); err != nil {
+		return fmt.Errorf("Timezone.Validate: %w", err)
+	}
+	place, err := ReverseGeocode(location)
 	if err != nil {
-		return time.UTC
+		return fmt.Errorf("Timezone.Validate: %w", err)
+	}
+	name := tz.Canonical()
+	if name == place.Timezone {
+		return nil
+	}
+	for _, area := range timezoneAreas {
+		if area.Timezone == name && (area.contains(location.Longitude, location.Latitude) || area.distanceTo(location.Longitude, location.Latitude) <= timezoneCoastDistance) {
+			return nil
+		}
+	}
+	return fmt.Errorf("Timezone.Validate: %w: %f, %f is in %s, not %s", ErrTimezoneMismatch, location.Latitude, location.Longitude, place.Timezone, tz.GetName())
+}
+
+// ValidateTimezone Checks that the timezone of the device is the one of its location, see Timezone.Validate
+func (device *AndroidDevice) ValidateTimezone() error {
+	if device.GetLocation() == nil {
+		return fmt.Errorf("AndroidDevice.ValidateTimezone: %w: the device has no location", ErrTimezoneMismatch)
+	}
+	if err := device.GetTimezone().Validate(device.GetLocation()); err != nil {
+		return fmt.Errorf("AndroidDevice.ValidateTimezone: %w", err)
+	}
+	return nil
+}
+
+// TimezonesForCountry Lists the zones of the country by the timezone boundaries, sorted by name
+func TimezonesForCountry(countryISO string) []string {
+	countryISO = strings.ToUpper(countryISO)
+	seen := map[string]bool{}
+	result := []string{}
+	for _, area := range timezoneAreas {
+		if area.CountryISO == countryISO && !seen[area.Timezone] {
+			seen[area.Timezone] = true
+			result = append(result, area.Timezone)
+		}
 	}
+	sort.Strings(result)
 	return result
 }
+
+// DominantTimezone Is the zone that covers most of the area of the city, by the timezone boundaries at points spread over its
+// radius. It is the timezone of the catalog for cities out of reach of the boundaries
+func (city *City) DominantTimezone() string {
+	center := city.Location()
+	votes := map[string]int{}
+	if area, ok := findTimezoneArea(center.Longitude, center.Latitude); ok {
+		votes[area.Timezone]++
+	}
+	// 8 points at half the radius and 16 at the radius, as many per ring as its share of the disc
+	for _, ring := range []struct {
+		Points   int
+		Distance float64
+	}{{Points: 8, Distance: city.Radius() / 2}, {Points: 16, Distance: city.Radius()}} {
+		for i := 0; i < ring.Points; i++ {
+			point := center.Destination(float64(i)*360/float64(ring.Points), ring.Distance)
+			if area, ok := findTimezoneArea(point.Longitude, point.Latitude); ok {
+				votes[area.Timezone]++
+			}
+		}
+	}
+
+	result, most := city.Timezone, 0
+	for timezone, count := range votes {
+		// Ties go to the timezone of the catalog, then by name so the result is stable
+		if count > most || count == most && (timezone == city.Timezone || result != city.Timezone && timezone < result) {
+			result, most = timezone, count
+		}
+	}
+	return result
+}
+
+// ObservesDST Reports whether the zone switches to daylight saving time in the year, the way scripts detect it by comparing
+// getTimezoneOffset() in January and July
+func (tz *Timezone) ObservesDST(year int) (bool, error) {
+	january, err := tz.Offset(time.Date(year, time.January, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		return false, err
+	}
+	july, err := tz.Offset(time.Date(year, time.July, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		return false, err
+	}
+	return january != july, nil
+}
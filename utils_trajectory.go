@@ -0,0 +1,333 @@
+package device_utils
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"math"
+	"math/rand"
+	"time"
+)
+
+var ErrTrajectoryInvalid = errors.New("the supplied trajectory options are invalid")
+
+const (
+	// trajectoryErrorCorrelation and trajectoryAccuracyCorrelation Are how long the position error and the accuracy of
+	// consecutive fixes stay alike, the error of a receiver drifts rather than jumps
+	trajectoryErrorCorrelation    = 30 * time.Second
+	trajectoryAccuracyCorrelation = time.Minute
+	// trajectoryStep Is the longest time the motion is integrated over at once, longer intervals take several steps
+	trajectoryStep = time.Second
+)
+
+// SpeedProfile Is how a device moves, speeds are in meters per second
+type SpeedProfile struct {
+	// Speed is the mean cruising speed, Deviation how much the cruising speed differs between stretches of the trajectory
+	Speed     float64
+	Deviation float64
+	MaxSpeed  float64
+	// Acceleration and Deceleration are in meters per second squared
+	Acceleration float64
+	Deceleration float64
+	// TurnRate is how fast the heading can change, and Wander the standard deviation of how much it drifts by itself, both
+	// in degrees per second
+	TurnRate float64
+	Wander   float64
+	// StopsPerKilometer is how often the device halts, at crossings or traffic lights, StopDuration is the mean length of a halt
+	StopsPerKilometer float64
+	StopDuration      time.Duration
+}
+
+// WalkingProfile Is a pedestrian at about 5 km/h
+func WalkingProfile() *SpeedProfile {
+	return &SpeedProfile{Speed: 1.4, Deviation: 0.2, MaxSpeed: 2.5, Acceleration: 0.5, Deceleration: 1, TurnRate: 90, Wander: 4, StopsPerKilometer: 2, StopDuration: 20 * time.Second}
+}
+
+// DrivingProfile Is a car in town at about 40 km/h
+func DrivingProfile() *SpeedProfile {
+	return &SpeedProfile{Speed: 11, Deviation: 3, MaxSpeed: 22, Acceleration: 2, Deceleration: 3, TurnRate: 20, Wander: 1, StopsPerKilometer: 1.5, StopDuration: 30 * time.Second}
+}
+
+// TrajectoryOptions Is where a trajectory starts, where it heads and how the device gets there
+type TrajectoryOptions struct {
+	// Origin is where the device starts, its provider is the one of every fix, GPS if it has none
+	Origin *GPSLocation
+	// Destination is where the device heads in a straight line, the trajectory ends on arrival. Without one the device
+	// wanders at random, within Radius meters of the origin unless that is 0
+	Destination *GPSLocation
+	Radius      float64
+	// Duration ends the trajectory after that long, it is required without a destination
+	Duration time.Duration
+	Profile  *SpeedProfile
+	// Interval is the time between fixes, a second if 0
+	Interval time.Duration
+	// Start is the time of the first fix, now if zero, and Uptime how long the device has been booted by then, random if 0
+	Start  time.Time
+	Uptime time.Duration
+}
+
+// Trajectory Is a time series of fixes of a moving device, see NewTrajectory
+type Trajectory struct {
+	options TrajectoryOptions
+	model   *providerModel
+	// position, heading and speed are the true motion of the device, fixes report them with the error of the provider
+	position *GPSLocation
+	heading  float64
+	speed    float64
+	cruise   float64
+	// stopping is set once the device decided to halt until it did, halt is what is left of the halt after that
+	stopping bool
+	halt     time.Duration
+	distance float64
+	elapsed  time.Duration
+	// errorEast, errorNorth and accuracyDeviation drift from fix to fix, see trajectoryErrorCorrelation
+	errorEast         float64
+	errorNorth        float64
+	accuracyDeviation float64
+	started           bool
+	arrived           bool
+	done              bool
+}
+
+// NewTrajectory Validates the options and positions the device at the origin, the error wraps ErrTrajectoryInvalid
+func NewTrajectory(options *TrajectoryOptions) (*Trajectory, error) {
+	switch {
+	case options.Origin == nil:
+		return nil, fmt.Errorf("NewTrajectory: %w: no origin", ErrTrajectoryInvalid)
+	case options.Profile == nil:
+		return nil, fmt.Errorf("NewTrajectory: %w: no speed profile", ErrTrajectoryInvalid)
+	case options.Profile.Speed <= 0 || options.Profile.MaxSpeed < options.Profile.Speed:
+		return nil, fmt.Errorf("NewTrajectory: %w: speed must be positive and at most the max speed", ErrTrajectoryInvalid)
+	case options.Profile.Acceleration <= 0 || options.Profile.Deceleration <= 0 || options.Profile.TurnRate <= 0:
+		return nil, fmt.Errorf("NewTrajectory: %w: acceleration, deceleration and turn rate must be positive", ErrTrajectoryInvalid)
+	case options.Interval < 0 || options.Duration < 0 || options.Radius < 0:
+		return nil, fmt.Errorf("NewTrajectory: %w: negative interval, duration or radius", ErrTrajectoryInvalid)
+	case options.Destination == nil && options.Duration == 0:
+		return nil, fmt.Errorf("NewTrajectory: %w: a random walk needs a duration", ErrTrajectoryInvalid)
+	}
+
+	profile := *options.Profile
+	result := &Trajectory{options: *options}
+	result.options.Profile = &profile
+	if result.options.Interval == 0 {
+		result.options.Interval = time.Second
+	}
+	if result.options.Start.IsZero() {
+		result.options.Start = time.Now()
+	}
+	if result.options.Uptime == 0 {
+		result.options.Uptime = randomUptime()
+	}
+
+	result.position = &GPSLocation{Latitude: options.Origin.Latitude, Longitude: options.Origin.Longitude, Altitude: options.Origin.Altitude, Provider: options.Origin.Provider}
+	if result.position.Provider == GPSLocation_LocationProvider_NONE {
+		result.position.Provider = GPSLocation_GPS
+	}
+	result.model = result.position.pickModel()
+	result.heading = rand.Float64() * 360
+	if options.Destination != nil {
+		result.heading = result.position.BearingTo(options.Destination)
+	}
+	result.cruise = result.cruiseSpeed()
+	sigma := result.model.horizontalAccuracy(0) / accuracySigmas
+	result.errorEast, result.errorNorth = rand.NormFloat64()*sigma, rand.NormFloat64()*sigma
+	result.accuracyDeviation = rand.NormFloat64()
+	return result, nil
+}
+
+// cruiseSpeed Draws the speed of the next stretch of the trajectory
+func (trajectory *Trajectory) cruiseSpeed() float64 {
+	profile := trajectory.options.Profile
+	return math.Max(profile.Speed/4, math.Min(profile.MaxSpeed, profile.Speed+rand.NormFloat64()*profile.Deviation))
+}
+
+// step Moves the device over seconds, which should not exceed trajectoryStep
+func (trajectory *Trajectory) step(seconds float64) {
+	profile := trajectory.options.Profile
+	if trajectory.halt > 0 {
+		trajectory.halt -= time.Duration(seconds * float64(time.Second))
+		if trajectory.halt <= 0 {
+			trajectory.halt = 0
+			trajectory.cruise = trajectory.cruiseSpeed()
+		}
+		return
+	}
+
+	remaining := math.Inf(1)
+	desired := trajectory.heading
+	if trajectory.options.Destination != nil {
+		remaining = trajectory.position.DistanceTo(trajectory.options.Destination)
+		desired = trajectory.position.BearingTo(trajectory.options.Destination)
+	} else if trajectory.options.Radius > 0 && trajectory.options.Origin.DistanceTo(trajectory.position) > trajectory.options.Radius {
+		desired = trajectory.position.BearingTo(trajectory.options.Origin)
+	}
+	turn := math.Mod(desired-trajectory.heading+540, 360) - 180
+	maxTurn := profile.TurnRate * seconds
+	trajectory.heading += math.Max(-maxTurn, math.Min(maxTurn, turn)) + rand.NormFloat64()*profile.Wander*math.Sqrt(seconds)
+	trajectory.heading = math.Mod(trajectory.heading+360, 360)
+
+	// Slow down to halt, to arrive and to take sharp turns
+	target := trajectory.cruise
+	if trajectory.stopping {
+		target = 0
+	}
+	target = math.Min(target, math.Sqrt(2*profile.Deceleration*remaining))
+	target *= math.Max(0.1, math.Cos(toRadians(math.Min(90, math.Abs(turn)))))
+	if trajectory.speed < target {
+		trajectory.speed = math.Min(target, trajectory.speed+profile.Acceleration*seconds)
+	} else {
+		trajectory.speed = math.Max(target, trajectory.speed-profile.Deceleration*seconds)
+	}
+
+	distance := trajectory.speed * seconds
+	if distance >= remaining || remaining < 0.5 {
+		trajectory.position.Latitude, trajectory.position.Longitude = trajectory.options.Destination.Latitude, trajectory.options.Destination.Longitude
+		trajectory.distance += remaining
+		trajectory.speed = 0
+		trajectory.arrived = true
+	} else {
+		moved := trajectory.position.Destination(trajectory.heading, distance)
+		trajectory.position.Latitude, trajectory.position.Longitude = moved.Latitude, moved.Longitude
+		trajectory.distance += distance
+	}
+	if destination := trajectory.options.Destination; destination != nil {
+		total := trajectory.distance + trajectory.position.DistanceTo(destination)
+		trajectory.position.Altitude = destination.Altitude + (trajectory.options.Origin.Altitude-destination.Altitude)*(1-trajectory.distance/math.Max(total, 1))
+	}
+
+	if !trajectory.stopping && rand.Float64() < profile.StopsPerKilometer*distance/1000 {
+		trajectory.stopping = true
+	}
+	if trajectory.stopping && trajectory.speed == 0 {
+		trajectory.stopping = false
+		trajectory.halt = time.Duration(rand.ExpFloat64() * float64(profile.StopDuration))
+	}
+	if rand.Float64() < seconds/60 {
+		trajectory.cruise = trajectory.cruiseSpeed()
+	}
+}
+
+// fix Is what the provider reports of the current position
+func (trajectory *Trajectory) fix() *GPSLocation {
+	model := trajectory.model
+	interval := float64(trajectory.options.Interval)
+	if !trajectory.started {
+		interval = 0
+	}
+	// First-order Gauss-Markov processes, stationary at the distributions of the model
+	rho := math.Exp(-interval / float64(trajectoryAccuracyCorrelation))
+	trajectory.accuracyDeviation = rho*trajectory.accuracyDeviation + math.Sqrt(1-rho*rho)*rand.NormFloat64()
+	accuracy := model.horizontalAccuracy(trajectory.accuracyDeviation)
+	sigma := accuracy / accuracySigmas
+	rho = math.Exp(-interval / float64(trajectoryErrorCorrelation))
+	trajectory.errorEast = rho*trajectory.errorEast + math.Sqrt(1-rho*rho)*rand.NormFloat64()*sigma
+	trajectory.errorNorth = rho*trajectory.errorNorth + math.Sqrt(1-rho*rho)*rand.NormFloat64()*sigma
+
+	result := trajectory.position.Destination(toDegrees(math.Atan2(trajectory.errorEast, trajectory.errorNorth)), math.Hypot(trajectory.errorEast, trajectory.errorNorth))
+	result.HorizontalAccuracy = float32(roundTo(accuracy, 3))
+	result.VerticalAccuracy = float32(roundTo(model.verticalAccuracy(trajectory.accuracyDeviation), 3))
+	result.Altitude = roundTo(trajectory.position.Altitude, 3)
+	if result.VerticalAccuracy > 0 {
+		result.Altitude = jitterAltitude(trajectory.position.Altitude, float64(result.VerticalAccuracy))
+	}
+	if model.SpeedNoise > 0 {
+		// Below 0.1 m/s the chipsets report no bearing, the error of the bearing grows as the speed drops
+		if speed := math.Abs(trajectory.speed + rand.NormFloat64()*model.SpeedNoise); speed >= 0.1 {
+			bearing := trajectory.heading + rand.NormFloat64()*math.Min(180, toDegrees(model.SpeedNoise/math.Max(trajectory.speed, 0.1)))
+			result.Speed = float32(roundTo(speed, 3))
+			result.Bearing = float32(roundTo(math.Mod(math.Mod(bearing, 360)+360, 360), 3))
+		}
+	}
+	result.Timestamp = trajectory.options.Start.Add(trajectory.elapsed).UnixMilli()
+	result.ElapsedRealtimeNanos = int64(trajectory.options.Uptime + trajectory.elapsed)
+	return result
+}
+
+// Next Advances the device by the interval and returns its fix, the first fix is at the origin. It returns false once the
+// device arrived or the duration passed
+func (trajectory *Trajectory) Next() (*GPSLocation, bool) {
+	if trajectory.done {
+		return nil, false
+	}
+	if trajectory.started {
+		for remaining := trajectory.options.Interval; remaining > 0 && !trajectory.arrived; remaining -= trajectoryStep {
+			trajectory.step(min(remaining, trajectoryStep).Seconds())
+		}
+		trajectory.elapsed += trajectory.options.Interval
+	}
+	result := trajectory.fix()
+	trajectory.started = true
+	if trajectory.arrived || (trajectory.options.Duration > 0 && trajectory.elapsed+trajectory.options.Interval > trajectory.options.Duration) {
+		trajectory.done = true
+	}
+	return result, true
+}
+
+// Fixes Iterates over the remaining fixes, see Next
+func (trajectory *Trajectory) Fixes() iter.Seq[*GPSLocation] {
+	return func(yield func(*GPSLocation) bool) {
+		for fix, ok := trajectory.Next(); ok; fix, ok = trajectory.Next() {
+			if !yield(fix) {
+				return
+			}
+		}
+	}
+}
+
+// gpxTrackPoint Is a GPX 1.1 trkpt, speed and course are in the Garmin TrackPointExtension since GPX 1.1 dropped them
+// src: https://www.topografix.com/GPX/1/1/
+type gpxTrackPoint struct {
+	XMLName   xml.Name `xml:"trkpt"`
+	Latitude  float64  `xml:"lat,attr"`
+	Longitude float64  `xml:"lon,attr"`
+	Elevation float64  `xml:"ele"`
+	Time      string   `xml:"time"`
+	Speed     *float32 `xml:"extensions>gpxtpx:TrackPointExtension>gpxtpx:speed,omitempty"`
+	Course    *float32 `xml:"extensions>gpxtpx:TrackPointExtension>gpxtpx:course,omitempty"`
+}
+
+// WriteGPX Writes the fixes as a single GPX track, for replay tools and emulators that load routes
+func WriteGPX(w io.Writer, fixes iter.Seq[*GPSLocation]) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("WriteGPX: %w", err)
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	gpx := xml.StartElement{Name: xml.Name{Local: "gpx"}, Attr: []xml.Attr{
+		{Name: xml.Name{Local: "version"}, Value: "1.1"},
+		{Name: xml.Name{Local: "creator"}, Value: "go-device-utils"},
+		{Name: xml.Name{Local: "xmlns"}, Value: "http://www.topografix.com/GPX/1/1"},
+		{Name: xml.Name{Local: "xmlns:gpxtpx"}, Value: "http://www.garmin.com/xmlschemas/TrackPointExtension/v2"},
+	}}
+	trk, trkseg := xml.StartElement{Name: xml.Name{Local: "trk"}}, xml.StartElement{Name: xml.Name{Local: "trkseg"}}
+	for _, token := range []xml.Token{gpx, trk, trkseg} {
+		if err := encoder.EncodeToken(token); err != nil {
+			return fmt.Errorf("WriteGPX: %w", err)
+		}
+	}
+	for fix := range fixes {
+		point := &gpxTrackPoint{
+			Latitude:  fix.Latitude,
+			Longitude: fix.Longitude,
+			Elevation: fix.Altitude,
+			Time:      time.UnixMilli(fix.Timestamp).UTC().Format("2006-01-02T15:04:05.000Z"),
+		}
+		if fix.Speed > 0 {
+			point.Speed, point.Course = &fix.Speed, &fix.Bearing
+		}
+		if err := encoder.Encode(point); err != nil {
+			return fmt.Errorf("WriteGPX: %w", err)
+		}
+	}
+	for _, token := range []xml.Token{trkseg.End(), trk.End(), gpx.End()} {
+		if err := encoder.EncodeToken(token); err != nil {
+			return fmt.Errorf("WriteGPX: %w", err)
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("WriteGPX: %w", err)
+	}
+	return nil
+}
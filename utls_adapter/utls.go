@@ -0,0 +1,450 @@
+package utls_adapter
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	device_utils "github.com/BRUHItsABunny/go-device-utils"
+	tls "github.com/refraction-networking/utls"
+)
+
+var (
+	ErrNoTLSFingerprint     = errors.New("the supplied browser has no TLS fingerprint")
+	ErrUnknownExtension     = errors.New("the supplied extension could not be identified")
+	ErrUnsupportedExtension = errors.New("the supplied extension has no known ClientHello encoding")
+)
+
+var (
+	// greaseBrowsers are the browser names that GREASE their ClientHello, Firefox is the odd one out
+	greaseBrowsers = []string{"brave", "chrome", "chromium", "edge", "opera", "vivaldi", "safari"}
+
+	// Defaults for when a fingerprint lists an extension without matching ExtensionData, taken from Chrome 120
+	defaultSignatureAlgorithms = []tls.SignatureScheme{
+		tls.ECDSAWithP256AndSHA256,
+		tls.PSSWithSHA256,
+		tls.PKCS1WithSHA256,
+		tls.ECDSAWithP384AndSHA384,
+		tls.PSSWithSHA384,
+		tls.PKCS1WithSHA384,
+		tls.PSSWithSHA512,
+		tls.PKCS1WithSHA512,
+	}
+	defaultDelegatedCredentials = []tls.SignatureScheme{
+		tls.ECDSAWithP256AndSHA256,
+		tls.ECDSAWithP384AndSHA384,
+		tls.ECDSAWithP521AndSHA512,
+		tls.ECDSAWithSHA1,
+	}
+	defaultALPN                 = []string{"h2", "http/1.1"}
+	defaultALPS                 = []string{"h2"}
+	defaultRecordSizeLimit      = uint16(0x4001)
+	defaultECHCipherSuites      = []tls.HPKESymmetricCipherSuite{{KdfId: 1, AeadId: 1}, {KdfId: 1, AeadId: 3}}
+	defaultECHPayloadLens       = []uint16{128, 160, 192, 224}
+	defaultCertCompressionAlgos = []tls.CertCompressionAlgo{tls.CertCompressionBrotli}
+)
+
+// IsGREASEBrowser reports whether the browser GREASEs its ClientHello, FromPEET strips GREASE values so this can't be derived from the fingerprint
+func IsGREASEBrowser(browser *device_utils.Browser) bool {
+	for _, name := range greaseBrowsers {
+		if strings.EqualFold(browser.GetName(), name) {
+			return true
+		}
+	}
+	return strings.Contains(browser.GetBrandHeader(), "Chromium")
+}
+
+// UClient Wraps conn in a uTLS connection that presents the ClientHello of the supplied browser profile
+func UClient(conn net.Conn, config *tls.Config, browser *device_utils.Browser) (*tls.UConn, error) {
+	spec, err := BrowserClientHelloSpec(browser)
+	if err != nil {
+		return nil, fmt.Errorf("BrowserClientHelloSpec: %w", err)
+	}
+
+	uConn := tls.UClient(conn, config, tls.HelloCustom)
+	err = uConn.ApplyPreset(spec)
+	if err != nil {
+		return nil, fmt.Errorf("uConn.ApplyPreset: %w", err)
+	}
+	return uConn, nil
+}
+
+// BrowserClientHelloSpec Builds a ClientHelloSpec for the browser, GREASE is added for Chromium based browsers and Safari
+func BrowserClientHelloSpec(browser *device_utils.Browser) (*tls.ClientHelloSpec, error) {
+	if browser.GetTlsFingerprint() == nil {
+		return nil, ErrNoTLSFingerprint
+	}
+	return ClientHelloSpec(browser.TlsFingerprint, IsGREASEBrowser(browser))
+}
+
+// ClientHelloSpec Converts a TLS fingerprint into a uTLS ClientHelloSpec, extensions without ExtensionData get Chrome's defaults
+func ClientHelloSpec(fp *device_utils.Browser_TLSFingerprint, grease bool) (*tls.ClientHelloSpec, error) {
+	extensionData := make(map[device_utils.Browser_TLSFingerprint_Extension]*device_utils.Browser_TLSFingerprint_ExtensionData)
+	for _, data := range fp.ExtensionData {
+		extensionData[data.ExtensionId] = data
+	}
+
+	spec := &tls.ClientHelloSpec{
+		CipherSuites:       make([]uint16, 0, len(fp.CipherSuites)+1),
+		CompressionMethods: []uint8{0x00},
+		Extensions:         make([]tls.TLSExtension, 0, len(fp.Extensions)+2),
+	}
+
+	if grease {
+		spec.CipherSuites = append(spec.CipherSuites, tls.GREASE_PLACEHOLDER)
+		spec.Extensions = append(spec.Extensions, &tls.UtlsGREASEExtension{})
+	}
+	for _, cipherSuite := range fp.CipherSuites {
+		spec.CipherSuites = append(spec.CipherSuites, uint16(cipherSuite))
+	}
+
+	// Chrome sends its second GREASE extension right before padding and pre_shared_key, which have to come last
+	trailingGREASE := grease
+	for _, extension := range fp.Extensions {
+		if trailingGREASE && (extension == device_utils.Browser_TLSFingerprint_PADDING || extension == device_utils.Browser_TLSFingerprint_PRE_SHARED_KEY) {
+			spec.Extensions = append(spec.Extensions, &tls.UtlsGREASEExtension{})
+			trailingGREASE = false
+		}
+		tlsExtension, err := toExtension(fp, extension, extensionData[extension], grease)
+		if err != nil {
+			return nil, fmt.Errorf("toExtension: %w", err)
+		}
+		spec.Extensions = append(spec.Extensions, tlsExtension)
+	}
+	if trailingGREASE {
+		spec.Extensions = append(spec.Extensions, &tls.UtlsGREASEExtension{})
+	}
+
+	return spec, nil
+}
+
+func toExtension(fp *device_utils.Browser_TLSFingerprint, extension device_utils.Browser_TLSFingerprint_Extension, data *device_utils.Browser_TLSFingerprint_ExtensionData, grease bool) (tls.TLSExtension, error) {
+	switch extension {
+	case device_utils.Browser_TLSFingerprint_SERVER_NAME:
+		return &tls.SNIExtension{}, nil
+	case device_utils.Browser_TLSFingerprint_STATUS_REQUEST:
+		return &tls.StatusRequestExtension{}, nil
+	case device_utils.Browser_TLSFingerprint_SUPPORTED_GROUPS:
+		groups := fp.EllipticCurves
+		if data.GetSupportedGroups() != nil {
+			groups = data.SupportedGroups.Groups
+		}
+		curves := make([]tls.CurveID, 0, len(groups)+1)
+		if grease {
+			curves = append(curves, tls.GREASE_PLACEHOLDER)
+		}
+		for _, curve := range groups {
+			curves = append(curves, tls.CurveID(curve))
+		}
+		return &tls.SupportedCurvesExtension{Curves: curves}, nil
+	case device_utils.Browser_TLSFingerprint_EC_POINT_FORMATS:
+		points := make([]uint8, 0, len(fp.EllipticCurvePointFormats))
+		for _, point := range fp.EllipticCurvePointFormats {
+			points = append(points, uint8(point))
+		}
+		return &tls.SupportedPointsExtension{SupportedPoints: points}, nil
+	case device_utils.Browser_TLSFingerprint_SIGNATURE_ALGORITHMS:
+		algorithms := defaultSignatureAlgorithms
+		if data.GetSignatureAlgorithms() != nil {
+			algorithms = make([]tls.SignatureScheme, 0, len(data.SignatureAlgorithms.SupportedSignatureAlgorithms))
+			for _, algorithm := range data.SignatureAlgorithms.SupportedSignatureAlgorithms {
+				algorithms = append(algorithms, tls.SignatureScheme(algorithm))
+			}
+		}
+		return &tls.SignatureAlgorithmsExtension{SupportedSignatureAlgorithms: algorithms}, nil
+	case device_utils.Browser_TLSFingerprint_APPLICATION_LAYER_PROTOCOL_NEGOTIATION:
+		protocols := defaultALPN
+		if data.GetApplicationLayerProtocolNegotiation() != nil {
+			protocols = data.ApplicationLayerProtocolNegotiation.Protocols
+		}
+		return &tls.ALPNExtension{AlpnProtocols: protocols}, nil
+	case device_utils.Browser_TLSFingerprint_STATUS_REQUEST_V2:
+		return &tls.StatusRequestV2Extension{}, nil
+	case device_utils.Browser_TLSFingerprint_SIGNED_CERTIFICATE_TIMESTAMP:
+		return &tls.SCTExtension{}, nil
+	case device_utils.Browser_TLSFingerprint_PADDING:
+		// The recorded length only fits the recorded ClientHello, it changes with SNI, session tickets and key shares. It is kept
+		// for diffing and the padding is computed like the browsers do
+		return &tls.UtlsPaddingExtension{GetPaddingLen: tls.BoringPaddingStyle}, nil
+	case device_utils.Browser_TLSFingerprint_EXTENDED_MASTER_SECRET:
+		return &tls.ExtendedMasterSecretExtension{}, nil
+	case device_utils.Browser_TLSFingerprint_COMPRESS_CERTIFICATE:
+		algorithms := defaultCertCompressionAlgos
+		if data.GetCompressCertificate() != nil {
+			algorithms = make([]tls.CertCompressionAlgo, 0, len(data.CompressCertificate.Algorithms))
+			for _, algorithm := range data.CompressCertificate.Algorithms {
+				algorithms = append(algorithms, tls.CertCompressionAlgo(algorithm))
+			}
+		}
+		return &tls.UtlsCompressCertExtension{Algorithms: algorithms}, nil
+	case device_utils.Browser_TLSFingerprint_RECORD_SIZE_LIMIT:
+		limit := defaultRecordSizeLimit
+		if data.GetRecordSizeLimit() != nil {
+			limit = uint16(data.RecordSizeLimit.Limit)
+		}
+		return &tls.FakeRecordSizeLimitExtension{Limit: limit}, nil
+	case device_utils.Browser_TLSFingerprint_DELEGATED_CREDENTIAL:
+		algorithms := defaultDelegatedCredentials
+		if data.GetDelegatedCredentials() != nil {
+			algorithms = make([]tls.SignatureScheme, 0, len(data.DelegatedCredentials.SupportedSignatureAlgorithms))
+			for _, algorithm := range data.DelegatedCredentials.SupportedSignatureAlgorithms {
+				algorithms = append(algorithms, tls.SignatureScheme(algorithm))
+			}
+		}
+		return &tls.FakeDelegatedCredentialsExtension{SupportedSignatureAlgorithms: algorithms}, nil
+	case device_utils.Browser_TLSFingerprint_SESSION_TICKET:
+		return &tls.SessionTicketExtension{}, nil
+	case device_utils.Browser_TLSFingerprint_PRE_SHARED_KEY:
+		return &tls.UtlsPreSharedKeyExtension{}, nil
+	case device_utils.Browser_TLSFingerprint_SUPPORTED_VERSIONS:
+		versions := make([]uint16, 0, 3)
+		if grease {
+			versions = append(versions, tls.GREASE_PLACEHOLDER)
+		}
+		if data.GetSupportedVersions() != nil {
+			for _, version := range data.SupportedVersions.Versions {
+				versions = append(versions, uint16(version))
+			}
+		} else {
+			versions = append(versions, tls.VersionTLS13, tls.VersionTLS12)
+		}
+		return &tls.SupportedVersionsExtension{Versions: versions}, nil
+	case device_utils.Browser_TLSFingerprint_COOKIE:
+		return &tls.CookieExtension{}, nil
+	case device_utils.Browser_TLSFingerprint_PSK_KEY_EXCHANGE_MODES:
+		modes := []uint8{tls.PskModeDHE}
+		if data.GetPskKeyExchangeModes() != nil {
+			modes = make([]uint8, 0, len(data.PskKeyExchangeModes.Modes))
+			for _, mode := range data.PskKeyExchangeModes.Modes {
+				modes = append(modes, uint8(mode))
+			}
+		}
+		return &tls.PSKKeyExchangeModesExtension{Modes: modes}, nil
+	case device_utils.Browser_TLSFingerprint_SIGNATURE_ALGORITHMS_CERT:
+		return &tls.SignatureAlgorithmsCertExtension{SupportedSignatureAlgorithms: defaultSignatureAlgorithms}, nil
+	case device_utils.Browser_TLSFingerprint_KEY_SHARE:
+		keyShares := make([]tls.KeyShare, 0, 3)
+		if grease {
+			keyShares = append(keyShares, tls.KeyShare{Group: tls.GREASE_PLACEHOLDER, Data: []byte{0}})
+		}
+		if data.GetKeyShareExtension() != nil {
+			for _, keyShare := range data.KeyShareExtension.KeyShares {
+				// Key material is generated per connection, only the group is replayed
+				keyShares = append(keyShares, tls.KeyShare{Group: tls.CurveID(keyShare.Group)})
+			}
+		} else if len(fp.EllipticCurves) > 0 {
+			keyShares = append(keyShares, tls.KeyShare{Group: tls.CurveID(fp.EllipticCurves[0])})
+		}
+		return &tls.KeyShareExtension{KeyShares: keyShares}, nil
+	case device_utils.Browser_TLSFingerprint_EXTENSION_APPLICATIONS_SETTINGS:
+		protocols := defaultALPS
+		if data.GetExtensionApplicationsSettings() != nil {
+			protocols = data.ExtensionApplicationsSettings.Protocols
+		}
+		return &tls.ApplicationSettingsExtension{SupportedProtocols: protocols}, nil
+	case device_utils.Browser_TLSFingerprint_EXTENSION_ENCRYPTED_CLIENT_HELLO:
+		ech := &tls.GREASEEncryptedClientHelloExtension{
+			CandidateCipherSuites: defaultECHCipherSuites,
+			CandidatePayloadLens:  defaultECHPayloadLens,
+		}
+		if data.GetExtensionEncryptedClientHello() != nil {
+			ech.CandidateCipherSuites = make([]tls.HPKESymmetricCipherSuite, 0, len(data.ExtensionEncryptedClientHello.CandidateCipherSuites))
+			for _, cipherSuite := range data.ExtensionEncryptedClientHello.CandidateCipherSuites {
+				ech.CandidateCipherSuites = append(ech.CandidateCipherSuites, tls.HPKESymmetricCipherSuite{
+					KdfId:  tls.HPKE_KDF_ID(cipherSuite.KdfId),
+					AeadId: tls.HPKE_AEAD_ID(cipherSuite.AeadId),
+				})
+			}
+			ech.CandidatePayloadLens = make([]uint16, 0, len(data.ExtensionEncryptedClientHello.CandidatePayloadLens))
+			for _, payloadLen := range data.ExtensionEncryptedClientHello.CandidatePayloadLens {
+				ech.CandidatePayloadLens = append(ech.CandidatePayloadLens, uint16(payloadLen))
+			}
+		}
+		return ech, nil
+	case device_utils.Browser_TLSFingerprint_EXTENSION_RENEGOTIATION_INFO:
+		renegotiation := tls.RenegotiateOnceAsClient
+		if data.GetExtensionRenegotiationInfo() != nil {
+			renegotiation = tls.RenegotiationSupport(data.ExtensionRenegotiationInfo.RenegotiationSupport)
+		}
+		return &tls.RenegotiationInfoExtension{Renegotiation: renegotiation}, nil
+	case device_utils.Browser_TLSFingerprint_ENCRYPT_THEN_MAC, device_utils.Browser_TLSFingerprint_EARLY_DATA, device_utils.Browser_TLSFingerprint_POST_HANDSHAKE_AUTH:
+		// These are empty in a ClientHello
+		return &tls.GenericExtension{Id: uint16(extension)}, nil
+	default:
+		// An empty body would be malformed for anything else, and a ClientHello that fails to parse is worse than no connection
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedExtension, extension.String())
+	}
+}
+
+// FromClientHelloSpec Converts a uTLS ClientHelloSpec back into a TLS fingerprint, GREASE values are dropped just like FromPEET does
+func FromClientHelloSpec(spec *tls.ClientHelloSpec) (*device_utils.Browser_TLSFingerprint, error) {
+	fp := &device_utils.Browser_TLSFingerprint{
+		Version:                   device_utils.Browser_TLSFingerprint_TLS1_2,
+		CipherSuites:              make([]device_utils.Browser_TLSFingerprint_CipherSuite, 0, len(spec.CipherSuites)),
+		Extensions:                make([]device_utils.Browser_TLSFingerprint_Extension, 0, len(spec.Extensions)),
+		EllipticCurves:            make([]device_utils.Browser_TLSFingerprint_EllipticCurve, 0),
+		EllipticCurvePointFormats: make([]device_utils.Browser_TLSFingerprint_EllipticCurvePointFormat, 0),
+		ExtensionData:             make([]*device_utils.Browser_TLSFingerprint_ExtensionData, 0),
+	}
+	// The ClientHello legacy_version is capped at TLS 1.2 since TLS 1.3 negotiates through supported_versions
+	if spec.TLSVersMax != 0 && spec.TLSVersMax < tls.VersionTLS12 {
+		fp.Version = device_utils.Browser_TLSFingerprint_ProtocolVersion(spec.TLSVersMax)
+	}
+
+	for _, cipherSuite := range spec.CipherSuites {
+		if isGREASE(cipherSuite) {
+			continue
+		}
+		fp.CipherSuites = append(fp.CipherSuites, device_utils.Browser_TLSFingerprint_CipherSuite(cipherSuite))
+	}
+
+	for _, extension := range spec.Extensions {
+		if _, ok := extension.(*tls.UtlsGREASEExtension); ok {
+			continue
+		}
+		extensionId, err := extensionID(extension)
+		if err != nil {
+			return nil, fmt.Errorf("extensionID: %w", err)
+		}
+		fp.Extensions = append(fp.Extensions, extensionId)
+
+		data := &device_utils.Browser_TLSFingerprint_ExtensionData{ExtensionId: extensionId}
+		switch typedExtension := extension.(type) {
+		case *tls.SupportedCurvesExtension:
+			data.SupportedGroups = &device_utils.Browser_TLSFingerprint_ExtensionData_SupportedGroups{
+				Groups: make([]device_utils.Browser_TLSFingerprint_EllipticCurve, 0, len(typedExtension.Curves)),
+			}
+			for _, curve := range typedExtension.Curves {
+				if isGREASE(uint16(curve)) {
+					continue
+				}
+				fp.EllipticCurves = append(fp.EllipticCurves, device_utils.Browser_TLSFingerprint_EllipticCurve(curve))
+				data.SupportedGroups.Groups = append(data.SupportedGroups.Groups, device_utils.Browser_TLSFingerprint_EllipticCurve(curve))
+			}
+		case *tls.SupportedPointsExtension:
+			for _, point := range typedExtension.SupportedPoints {
+				fp.EllipticCurvePointFormats = append(fp.EllipticCurvePointFormats, device_utils.Browser_TLSFingerprint_EllipticCurvePointFormat(point))
+			}
+			continue
+		case *tls.SignatureAlgorithmsExtension:
+			data.SignatureAlgorithms = &device_utils.Browser_TLSFingerprint_ExtensionData_SignatureAlgorithms{
+				SupportedSignatureAlgorithms: make([]device_utils.Browser_TLSFingerprint_SignatureScheme, 0, len(typedExtension.SupportedSignatureAlgorithms)),
+			}
+			for _, algorithm := range typedExtension.SupportedSignatureAlgorithms {
+				data.SignatureAlgorithms.SupportedSignatureAlgorithms = append(data.SignatureAlgorithms.SupportedSignatureAlgorithms, device_utils.Browser_TLSFingerprint_SignatureScheme(algorithm))
+			}
+		case *tls.ALPNExtension:
+			data.ApplicationLayerProtocolNegotiation = &device_utils.Browser_TLSFingerprint_ExtensionData_ApplicationLayerProtocolNegotiation{
+				Protocols: typedExtension.AlpnProtocols,
+			}
+		case *tls.UtlsCompressCertExtension:
+			data.CompressCertificate = &device_utils.Browser_TLSFingerprint_ExtensionData_CompressCertificate{
+				Algorithms: make([]device_utils.Browser_TLSFingerprint_ExtensionData_CompressCertificate_CertificateCompression, 0, len(typedExtension.Algorithms)),
+			}
+			for _, algorithm := range typedExtension.Algorithms {
+				data.CompressCertificate.Algorithms = append(data.CompressCertificate.Algorithms, device_utils.Browser_TLSFingerprint_ExtensionData_CompressCertificate_CertificateCompression(algorithm))
+			}
+		case *tls.FakeDelegatedCredentialsExtension:
+			data.DelegatedCredentials = &device_utils.Browser_TLSFingerprint_ExtensionData_DelegatedCredentials{
+				SupportedSignatureAlgorithms: make([]device_utils.Browser_TLSFingerprint_SignatureScheme, 0, len(typedExtension.SupportedSignatureAlgorithms)),
+			}
+			for _, algorithm := range typedExtension.SupportedSignatureAlgorithms {
+				data.DelegatedCredentials.SupportedSignatureAlgorithms = append(data.DelegatedCredentials.SupportedSignatureAlgorithms, device_utils.Browser_TLSFingerprint_SignatureScheme(algorithm))
+			}
+		case *tls.FakeRecordSizeLimitExtension:
+			data.RecordSizeLimit = &device_utils.Browser_TLSFingerprint_ExtensionData_RecordSizeLimit{
+				Limit: uint32(typedExtension.Limit),
+			}
+		case *tls.SupportedVersionsExtension:
+			data.SupportedVersions = &device_utils.Browser_TLSFingerprint_ExtensionData_SupportedVersions{
+				Versions: make([]device_utils.Browser_TLSFingerprint_ProtocolVersion, 0, len(typedExtension.Versions)),
+			}
+			for _, version := range typedExtension.Versions {
+				if isGREASE(version) {
+					continue
+				}
+				data.SupportedVersions.Versions = append(data.SupportedVersions.Versions, device_utils.Browser_TLSFingerprint_ProtocolVersion(version))
+			}
+		case *tls.PSKKeyExchangeModesExtension:
+			data.PskKeyExchangeModes = &device_utils.Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes{
+				Modes: make([]device_utils.Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode, 0, len(typedExtension.Modes)),
+			}
+			for _, mode := range typedExtension.Modes {
+				data.PskKeyExchangeModes.Modes = append(data.PskKeyExchangeModes.Modes, device_utils.Browser_TLSFingerprint_ExtensionData_PSKKeyExchangeModes_Mode(mode))
+			}
+		case *tls.KeyShareExtension:
+			data.KeyShareExtension = &device_utils.Browser_TLSFingerprint_ExtensionData_KeyShareExtension{
+				KeyShares: make([]*device_utils.Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare, 0, len(typedExtension.KeyShares)),
+			}
+			for _, keyShare := range typedExtension.KeyShares {
+				if isGREASE(uint16(keyShare.Group)) {
+					continue
+				}
+				data.KeyShareExtension.KeyShares = append(data.KeyShareExtension.KeyShares, &device_utils.Browser_TLSFingerprint_ExtensionData_KeyShareExtension_KeyShare{
+					Group: device_utils.Browser_TLSFingerprint_EllipticCurve(keyShare.Group),
+					Data:  keyShare.Data,
+				})
+			}
+		case *tls.ApplicationSettingsExtension:
+			data.ExtensionApplicationsSettings = &device_utils.Browser_TLSFingerprint_ExtensionData_ExtensionApplicationsSettings{
+				Protocols: typedExtension.SupportedProtocols,
+			}
+		case *tls.GREASEEncryptedClientHelloExtension:
+			data.ExtensionEncryptedClientHello = &device_utils.Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello{
+				CandidateCipherSuites: make([]*device_utils.Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite, 0, len(typedExtension.CandidateCipherSuites)),
+				CandidatePayloadLens:  make([]uint32, 0, len(typedExtension.CandidatePayloadLens)),
+			}
+			for _, cipherSuite := range typedExtension.CandidateCipherSuites {
+				data.ExtensionEncryptedClientHello.CandidateCipherSuites = append(data.ExtensionEncryptedClientHello.CandidateCipherSuites, &device_utils.Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKESymmetricCipherSuite{
+					KdfId:  device_utils.Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HKDF(cipherSuite.KdfId),
+					AeadId: device_utils.Browser_TLSFingerprint_ExtensionData_ExtensionEncryptedClientHello_HPKEAEAD(cipherSuite.AeadId),
+				})
+			}
+			for _, payloadLen := range typedExtension.CandidatePayloadLens {
+				data.ExtensionEncryptedClientHello.CandidatePayloadLens = append(data.ExtensionEncryptedClientHello.CandidatePayloadLens, uint32(payloadLen))
+			}
+		case *tls.RenegotiationInfoExtension:
+			data.ExtensionRenegotiationInfo = &device_utils.Browser_TLSFingerprint_ExtensionData_ExtensionRenegotiationInfo{
+				RenegotiationSupport: device_utils.Browser_TLSFingerprint_RenegotiationSupport(typedExtension.Renegotiation),
+			}
+		default:
+			// No parameters worth storing
+			continue
+		}
+		fp.ExtensionData = append(fp.ExtensionData, data)
+	}
+
+	return fp, nil
+}
+
+// extensionID Identifies an extension by the type it serializes as, which also covers extensions without a dedicated case here
+func extensionID(extension tls.TLSExtension) (device_utils.Browser_TLSFingerprint_Extension, error) {
+	switch typedExtension := extension.(type) {
+	case *tls.GenericExtension:
+		return device_utils.Browser_TLSFingerprint_Extension(typedExtension.Id), nil
+	case *tls.UtlsPaddingExtension:
+		// Padding serializes to nothing until the ClientHello length is known
+		return device_utils.Browser_TLSFingerprint_PADDING, nil
+	case *tls.UtlsPreSharedKeyExtension, *tls.FakePreSharedKeyExtension:
+		// Without a session pre_shared_key serializes to nothing
+		return device_utils.Browser_TLSFingerprint_PRE_SHARED_KEY, nil
+	case *tls.SessionTicketExtension:
+		return device_utils.Browser_TLSFingerprint_SESSION_TICKET, nil
+	case *tls.SNIExtension:
+		// SNI serializes to nothing until a ServerName is set
+		return device_utils.Browser_TLSFingerprint_SERVER_NAME, nil
+	}
+
+	buf := make([]byte, extension.Len())
+	if len(buf) < 2 {
+		return 0, ErrUnknownExtension
+	}
+	_, _ = extension.Read(buf)
+	return device_utils.Browser_TLSFingerprint_Extension(uint16(buf[0])<<8 | uint16(buf[1])), nil
+}
+
+// isGREASE src: https://datatracker.ietf.org/doc/html/rfc8701#section-2
+func isGREASE(value uint16) bool {
+	return value&0x0f0f == 0x0a0a && value>>8 == value&0xff
+}
@@ -0,0 +1,104 @@
+package utls_adapter
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"testing"
+
+	device_utils "github.com/BRUHItsABunny/go-device-utils"
+	tls "github.com/refraction-networking/utls"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestClientHelloSpec(t *testing.T) {
+	browser, err := device_utils.GetDBBrowser("chrome", "111.0.5563.147")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec, err := BrowserClientHelloSpec(browser)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fp, err := FromClientHelloSpec(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := browser.TlsFingerprint.FormatTLSFingerprint(true)
+	result := fp.FormatTLSFingerprint(true)
+	fmt.Println(result)
+	if result != expected {
+		t.Errorf("round trip mismatch:\n%s\n%s", expected, result)
+	}
+
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+	uConn, err := UClient(client, &tls.Config{ServerName: "example.com"}, browser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = uConn.BuildHandshakeState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(len(uConn.HandshakeState.Hello.Raw))
+
+	// A recorded padding length has to be ignored, it only fits the ClientHello it was recorded from
+	padded := proto.Clone(browser.TlsFingerprint).(*device_utils.Browser_TLSFingerprint)
+	padded.ExtensionData = append(padded.ExtensionData, &device_utils.Browser_TLSFingerprint_ExtensionData{
+		ExtensionId: device_utils.Browser_TLSFingerprint_PADDING,
+		Padding:     &device_utils.Browser_TLSFingerprint_ExtensionData_Padding{Length: 123},
+	})
+	spec, err = ClientHelloSpec(padded, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, extension := range spec.Extensions {
+		if padding, ok := extension.(*tls.UtlsPaddingExtension); ok && padding.GetPaddingLen == nil {
+			t.Error(fmt.Sprintf("padding has the fixed length %d", padding.PaddingLen))
+		}
+	}
+
+	// Without its data an unknown extension would go out empty and malformed
+	unknown := proto.Clone(browser.TlsFingerprint).(*device_utils.Browser_TLSFingerprint)
+	unknown.Extensions = append(unknown.Extensions, device_utils.Browser_TLSFingerprint_HEARTBEAT)
+	_, err = ClientHelloSpec(unknown, true)
+	if !errors.Is(err, ErrUnsupportedExtension) {
+		t.Error(fmt.Sprintf("heartbeat got: %v, expected: %v", err, ErrUnsupportedExtension))
+	}
+}
+
+func TestClientHelloSpecFromPEET(t *testing.T) {
+	for _, sample := range []string{"peet_brave_120.json", "peet_firefox_121.json"} {
+		data, err := os.ReadFile("../_resources/samples/" + sample)
+		if err != nil {
+			t.Fatal(err)
+		}
+		browser := &device_utils.Browser{}
+		err = browser.FromPEETRaw(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		grease := strings.Contains(sample, "brave")
+		spec, err := ClientHelloSpec(browser.TlsFingerprint, grease)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fp, err := FromClientHelloSpec(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fp.FormatPeetprint(grease) != browser.TlsFingerprint.FormatPeetprint(grease) {
+			t.Error(fmt.Sprintf("%s peetprint got: %s, want: %s", sample, fp.FormatPeetprint(grease), browser.TlsFingerprint.FormatPeetprint(grease)))
+		}
+		if fp.FormatJA4() != browser.TlsFingerprint.FormatJA4() {
+			t.Error(fmt.Sprintf("%s JA4 got: %s, want: %s", sample, fp.FormatJA4(), browser.TlsFingerprint.FormatJA4()))
+		}
+	}
+}